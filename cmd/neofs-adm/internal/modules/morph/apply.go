@@ -0,0 +1,549 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/notary"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/policy"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	applyFileFlag   = "file"
+	applyDryRunFlag = "dry-run"
+)
+
+// applyConfig is a desired network state described in a file
+// passed to the `apply` command.
+type applyConfig struct {
+	// Network contains netmap contract config values.
+	Network map[string]string `yaml:"network"`
+	// Policy contains policy contract settings.
+	Policy map[string]uint32 `yaml:"policy"`
+	// Notary describes notary deposits of the alphabet accounts.
+	Notary *struct {
+		// Deposit is a minimal notary deposit in GAS.
+		Deposit string `yaml:"deposit"`
+		// Till is a lifetime (in blocks) of the renewed deposits.
+		Till uint32 `yaml:"till"`
+	} `yaml:"notary"`
+	// Contracts describes NeoFS contracts.
+	Contracts *struct {
+		// Version is a desired version of all NeoFS contracts.
+		Version string `yaml:"version"`
+	} `yaml:"contracts"`
+}
+
+// applyState is the current network state read from the chain.
+type applyState struct {
+	network   map[string]string
+	policy    map[string]int64
+	height    uint32
+	deposits  []notaryDepositState
+	contracts map[string]int64
+}
+
+type notaryDepositState struct {
+	account util.Uint160
+	balance *big.Int
+	till    uint32
+}
+
+type applyChange struct {
+	section string
+	key     string
+	current string
+	desired string
+}
+
+type configPair struct {
+	key string
+	val interface{}
+}
+
+type notaryTopUp struct {
+	index  int
+	amount *big.Int
+	till   uint32
+}
+
+// applyPlan is a set of actions required to bring the network
+// to the desired state.
+type applyPlan struct {
+	changes   []applyChange
+	network   []configPair
+	policy    []configPair
+	deposits  []notaryTopUp
+	contracts int64
+}
+
+func (p *applyPlan) empty() bool {
+	return len(p.changes) == 0
+}
+
+func applyNetworkState(cmd *cobra.Command, _ []string) error {
+	path, _ := cmd.Flags().GetString(applyFileFlag)
+	cfg, err := readApplyConfig(path)
+	if err != nil {
+		return err
+	}
+
+	wCtx, err := newInitializeContext(cmd, viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("initialization error: %w", err)
+	}
+	defer wCtx.close()
+
+	nnsCs, err := wCtx.nnsContractState()
+	if err != nil {
+		return err
+	}
+
+	nmHash, err := nnsResolveHash(wCtx.ReadOnlyInvoker, nnsCs.Hash, netmapContract+".neofs")
+	if err != nil {
+		return fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	st, err := readApplyState(wCtx, nnsCs.Hash, nmHash)
+	if err != nil {
+		return err
+	}
+
+	force, _ := cmd.Flags().GetBool(forceConfigSet)
+	plan, err := planApply(cfg, st, force)
+	if err != nil {
+		return err
+	}
+
+	printApplyPlan(cmd, plan)
+	if plan.empty() {
+		cmd.Println("Network is up to date.")
+		return nil
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool(applyDryRunFlag); dryRun {
+		return nil
+	}
+
+	if plan.contracts != 0 {
+		cmd.Println("Updating contracts.")
+		if err := applyContracts(cmd, wCtx, nnsCs.Hash, plan.contracts); err != nil {
+			return fmt.Errorf("contract update: %w", err)
+		}
+	}
+
+	if len(plan.network) != 0 {
+		bw := io.NewBufBinWriter()
+		for _, p := range plan.network {
+			emit.AppCall(bw.BinWriter, nmHash, "setConfig", callflag.All, nil, p.key, p.val)
+		}
+		if bw.Err != nil {
+			return fmt.Errorf("can't form raw transaction: %w", bw.Err)
+		}
+
+		cmd.Println("Sending network config transaction.")
+		if err := wCtx.sendConsensusTx(bw.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if len(plan.policy) != 0 {
+		bw := io.NewBufBinWriter()
+		for _, p := range plan.policy {
+			emit.AppCall(bw.BinWriter, policy.Hash, "set"+p.key, callflag.All, p.val)
+		}
+		if bw.Err != nil {
+			return fmt.Errorf("can't form raw transaction: %w", bw.Err)
+		}
+
+		cmd.Println("Sending policy transaction.")
+		if err := wCtx.sendCommitteeTx(bw.Bytes(), false); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range plan.deposits {
+		acc := wCtx.Accounts[d.index]
+		act, err := actor.New(wCtx.Client, []actor.SignerAccount{{
+			Signer: transaction.Signer{
+				Account: acc.Contract.ScriptHash(),
+				Scopes:  transaction.Global,
+			},
+			Account: acc,
+		}})
+		if err != nil {
+			return fmt.Errorf("could not create actor: %w", err)
+		}
+
+		cmd.Printf("Sending notary deposit transaction for %s.\n", acc.Address)
+		h, vub, err := gas.New(act).Transfer(acc.Contract.ScriptHash(), notary.Hash, d.amount,
+			[]interface{}{nil, int64(d.till)})
+		if err != nil {
+			return fmt.Errorf("could not send notary deposit tx: %w", err)
+		}
+		wCtx.SentTxs = append(wCtx.SentTxs, hashVUBPair{hash: h, vub: vub})
+	}
+
+	return wCtx.awaitTx()
+}
+
+// applyContracts updates NeoFS contracts and checks that the resulting
+// versions match the desired one.
+func applyContracts(cmd *cobra.Command, wCtx *initializeContext, nnsHash util.Uint160, version int64) error {
+	var err error
+
+	walletDir := config.ResolveHomePath(viper.GetString(alphabetWalletsFlag))
+	wCtx.ContractWallet, err = openContractWallet(viper.GetViper(), cmd, walletDir)
+	if err != nil {
+		return err
+	}
+
+	wCtx.ContractPath, err = cmd.Flags().GetString(contractsInitFlag)
+	if err != nil {
+		return fmt.Errorf("invalid contracts path: %w", err)
+	}
+
+	if err := wCtx.readContracts(fullContractList); err != nil {
+		return err
+	}
+
+	if err := wCtx.deployNNS(updateMethodName); err != nil {
+		return err
+	}
+
+	if err := wCtx.updateContracts(); err != nil {
+		return err
+	}
+
+	versions, err := readContractVersions(wCtx.ReadOnlyInvoker, nnsHash, len(wCtx.Accounts))
+	if err != nil {
+		return err
+	}
+
+	for name, v := range versions {
+		if v != version {
+			return fmt.Errorf("%s contract has version %s after update, expected %s",
+				name, parseContractVersion(stackitem.Make(v)), parseContractVersion(stackitem.Make(version)))
+		}
+	}
+
+	return nil
+}
+
+func readApplyConfig(path string) (*applyConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read network config file: %w", err)
+	}
+
+	var cfg applyConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("can't parse network config file: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+func readApplyState(wCtx *initializeContext, nnsHash, nmHash util.Uint160) (*applyState, error) {
+	var (
+		st  applyState
+		err error
+		inv = wCtx.ReadOnlyInvoker
+	)
+
+	st.network, err = readNetworkConfig(inv, nmHash)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := policy.NewReader(inv)
+	st.policy = make(map[string]int64, 3)
+	for param, get := range map[string]func() (int64, error){
+		execFeeParam:      pr.GetExecFeeFactor,
+		storagePriceParam: pr.GetStoragePrice,
+		setFeeParam:       pr.GetFeePerByte,
+	} {
+		st.policy[param], err = get()
+		if err != nil {
+			return nil, fmt.Errorf("can't get %s policy value: %w", param, err)
+		}
+	}
+
+	st.height, err = wCtx.Client.GetBlockCount()
+	if err != nil {
+		return nil, fmt.Errorf("can't get current height: %w", err)
+	}
+
+	nr := notary.NewReader(inv)
+	st.deposits = make([]notaryDepositState, len(wCtx.Accounts))
+	for i, acc := range wCtx.Accounts {
+		h := acc.Contract.ScriptHash()
+
+		st.deposits[i].account = h
+		st.deposits[i].balance, err = nr.BalanceOf(h)
+		if err != nil {
+			return nil, fmt.Errorf("can't get notary deposit of %s: %w", acc.Address, err)
+		}
+
+		st.deposits[i].till, err = nr.ExpirationOf(h)
+		if err != nil {
+			return nil, fmt.Errorf("can't get notary deposit expiration of %s: %w", acc.Address, err)
+		}
+	}
+
+	st.contracts, err = readContractVersions(inv, nnsHash, len(wCtx.Accounts))
+	if err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
+
+// readNetworkConfig returns netmap contract config values in the
+// same text form as accepted by parseConfigPair.
+func readNetworkConfig(inv *invoker.Invoker, nmHash util.Uint160) (map[string]string, error) {
+	arr, err := unwrap.Array(inv.Call(nmHash, "listConfig"))
+	if err != nil {
+		return nil, errors.New("can't fetch list of network config keys from the netmap contract")
+	}
+
+	res := make(map[string]string, len(arr))
+	for _, param := range arr {
+		tuple, ok := param.Value().([]stackitem.Item)
+		if !ok || len(tuple) != 2 {
+			return nil, errors.New("invalid ListConfig response from netmap contract")
+		}
+
+		k, err := tuple[0].TryBytes()
+		if err != nil {
+			return nil, errors.New("invalid config key from netmap contract")
+		}
+
+		v, err := tuple[1].TryBytes()
+		if err != nil {
+			return nil, invalidConfigValueErr(k)
+		}
+
+		switch string(k) {
+		case netmapAuditFeeKey, netmapBasicIncomeRateKey,
+			netmapContainerFeeKey, netmapContainerAliasFeeKey,
+			netmapEigenTrustIterationsKey,
+			netmapEpochKey, netmapInnerRingCandidateFeeKey,
+			netmapMaxObjectSizeKey, netmapWithdrawFeeKey,
+			netmapReputationRequestsWeightKey, netmapReputationLatencyWeightKey,
+			netmapReputationAvailabilityWeightKey:
+			nbuf := make([]byte, 8)
+			copy(nbuf[:], v)
+			res[string(k)] = strconv.FormatUint(binary.LittleEndian.Uint64(nbuf), 10)
+		case netmapHomomorphicHashDisabledKey, netmapMaintenanceAllowedKey:
+			vBool, err := tuple[1].TryBool()
+			if err != nil {
+				return nil, invalidConfigValueErr(k)
+			}
+			res[string(k)] = strconv.FormatBool(vBool)
+		default:
+			res[string(k)] = string(v)
+		}
+	}
+
+	return res, nil
+}
+
+// readContractVersions returns versions of NeoFS contracts
+// (including all alphabet ones) deployed to the chain.
+func readContractVersions(inv *invoker.Invoker, nnsHash util.Uint160, alphabetSize int) (map[string]int64, error) {
+	domains := make(map[string]string, len(contractList)+alphabetSize)
+	for _, name := range contractList {
+		domains[name] = name + ".neofs"
+	}
+	for i := 0; i < alphabetSize; i++ {
+		domains[alphabetContract+strconv.Itoa(i)] = getAlphabetNNSDomain(i)
+	}
+
+	res := make(map[string]int64, len(domains))
+	for name, domain := range domains {
+		h, err := nnsResolveHash(inv, nnsHash, domain)
+		if err != nil {
+			if errors.Is(err, errMissingNNSRecord) {
+				// not deployed yet, will be deployed on update
+				res[name] = 0
+				continue
+			}
+			return nil, fmt.Errorf("can't resolve %s contract hash: %w", name, err)
+		}
+
+		res[name], err = unwrap.Int64(inv.Call(h, "version"))
+		if err != nil {
+			return nil, fmt.Errorf("can't get %s contract version: %w", name, err)
+		}
+	}
+
+	return res, nil
+}
+
+// planApply compares the desired network state with the current one.
+func planApply(cfg *applyConfig, st *applyState, force bool) (*applyPlan, error) {
+	var plan applyPlan
+
+	for _, k := range sortedKeys(cfg.Network) {
+		key, val, err := parseConfigPair(k+"="+cfg.Network[k], force)
+		if err != nil {
+			return nil, err
+		}
+
+		desired := fmt.Sprint(val)
+		current, ok := st.network[key]
+		if ok && current == desired {
+			continue
+		}
+		if !ok {
+			current = "<unset>"
+		}
+
+		plan.network = append(plan.network, configPair{key: key, val: val})
+		plan.changes = append(plan.changes, applyChange{"network", key, current, desired})
+	}
+
+	for _, k := range sortedKeys(cfg.Policy) {
+		switch k {
+		case execFeeParam, storagePriceParam, setFeeParam:
+		default:
+			return nil, fmt.Errorf("policy parameter must be one of %s, %s and %s, got %s",
+				execFeeParam, storagePriceParam, setFeeParam, k)
+		}
+
+		desired := int64(cfg.Policy[k])
+		if st.policy[k] == desired {
+			continue
+		}
+
+		plan.policy = append(plan.policy, configPair{key: k, val: desired})
+		plan.changes = append(plan.changes, applyChange{"policy", k,
+			strconv.FormatInt(st.policy[k], 10), strconv.FormatInt(desired, 10)})
+	}
+
+	if cfg.Notary != nil {
+		amount, err := parseGASAmount(cfg.Notary.Deposit)
+		if err != nil {
+			return nil, fmt.Errorf("notary deposit: %w", err)
+		}
+
+		lifetime := cfg.Notary.Till
+		if lifetime == 0 {
+			lifetime = defaultNotaryDepositLifetime
+		}
+
+		minBalance := big.NewInt(int64(amount))
+		for i, d := range st.deposits {
+			if d.balance.Cmp(minBalance) >= 0 && d.till > st.height {
+				continue
+			}
+
+			topUp := new(big.Int).Sub(minBalance, d.balance)
+			if topUp.Sign() < 0 {
+				// only the lifetime is to be prolonged
+				topUp.SetInt64(0)
+			}
+
+			till := st.height + lifetime
+			if till < d.till {
+				till = d.till
+			}
+
+			plan.deposits = append(plan.deposits, notaryTopUp{index: i, amount: topUp, till: till})
+			plan.changes = append(plan.changes, applyChange{"notary", address.Uint160ToString(d.account),
+				fmt.Sprintf("%s GAS till %d", fixedn.Fixed8(d.balance.Int64()), d.till),
+				fmt.Sprintf("%s GAS till %d", amount, till)})
+		}
+	}
+
+	if cfg.Contracts != nil && cfg.Contracts.Version != "" {
+		version, err := contractVersionFromString(cfg.Contracts.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range sortedKeys(st.contracts) {
+			current := st.contracts[name]
+			if current == version {
+				continue
+			}
+
+			plan.contracts = version
+			plan.changes = append(plan.changes, applyChange{"contracts", name,
+				parseContractVersion(stackitem.Make(current)), parseContractVersion(stackitem.Make(version))})
+		}
+	}
+
+	return &plan, nil
+}
+
+func printApplyPlan(cmd *cobra.Command, plan *applyPlan) {
+	if plan.empty() {
+		return
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "SECTION\tKEY\tCURRENT\tDESIRED")
+	for _, c := range plan.changes {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", c.section, c.key, c.current, c.desired)
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+}
+
+// contractVersionFromString parses `vX.Y.Z` string into the format returned
+// by the `version` method of NeoFS contracts.
+func contractVersionFromString(s string) (int64, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid contract version %s, expected vX.Y.Z", s)
+	}
+
+	var res int64
+	for _, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 16)
+		if err != nil || n >= 1_000 {
+			return 0, fmt.Errorf("invalid contract version %s, expected vX.Y.Z", s)
+		}
+		res = res*1_000 + int64(n)
+	}
+
+	return res, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
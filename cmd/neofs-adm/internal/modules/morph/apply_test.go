@@ -0,0 +1,146 @@
+package morph
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestReadApplyConfig(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "network.yaml")
+	require.NoError(t, os.WriteFile(p, []byte(`
+network:
+  MaxObjectSize: 67108864
+  HomomorphicHashingDisabled: true
+  EigenTrustAlpha: 0.1
+policy:
+  FeePerByte: 1000
+notary:
+  deposit: "10.5"
+  till: 100
+contracts:
+  version: v0.16.0
+`), 0o600))
+
+	cfg, err := readApplyConfig(p)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		netmapMaxObjectSizeKey:           "67108864",
+		netmapHomomorphicHashDisabledKey: "true",
+		netmapEigenTrustAlphaKey:         "0.1",
+	}, cfg.Network)
+	require.Equal(t, map[string]uint32{setFeeParam: 1000}, cfg.Policy)
+	require.Equal(t, "10.5", cfg.Notary.Deposit)
+	require.EqualValues(t, 100, cfg.Notary.Till)
+	require.Equal(t, "v0.16.0", cfg.Contracts.Version)
+}
+
+func TestPlanApply(t *testing.T) {
+	st := &applyState{
+		network: map[string]string{
+			netmapMaxObjectSizeKey:           "67108864",
+			netmapHomomorphicHashDisabledKey: "false",
+		},
+		policy: map[string]int64{
+			execFeeParam:      30,
+			storagePriceParam: 100000,
+			setFeeParam:       1000,
+		},
+		height: 1000,
+		deposits: []notaryDepositState{
+			{account: util.Uint160{1}, balance: big.NewInt(20_0000_0000), till: 2000},
+			{account: util.Uint160{2}, balance: big.NewInt(5_0000_0000), till: 2000},
+			{account: util.Uint160{3}, balance: big.NewInt(20_0000_0000), till: 900},
+		},
+		contracts: map[string]int64{
+			netmapContract:    16_000,
+			containerContract: 15_000,
+		},
+	}
+
+	t.Run("up to date", func(t *testing.T) {
+		cfg := &applyConfig{
+			Network: map[string]string{netmapMaxObjectSizeKey: "67108864"},
+			Policy:  map[string]uint32{execFeeParam: 30},
+		}
+
+		plan, err := planApply(cfg, st, false)
+		require.NoError(t, err)
+		require.True(t, plan.empty())
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		cfg := new(applyConfig)
+		require.NoError(t, yaml.Unmarshal([]byte(`
+network:
+  MaxObjectSize: 1024
+  HomomorphicHashingDisabled: false
+  MaintenanceModeAllowed: true
+policy:
+  ExecFeeFactor: 30
+  StoragePrice: 1
+notary:
+  deposit: "10"
+  till: 100
+contracts:
+  version: v0.16.0
+`), cfg))
+
+		plan, err := planApply(cfg, st, false)
+		require.NoError(t, err)
+
+		require.Equal(t, []configPair{
+			{netmapMaintenanceAllowedKey, true},
+			{netmapMaxObjectSizeKey, int64(1024)},
+		}, plan.network)
+		require.Equal(t, []configPair{{storagePriceParam, int64(1)}}, plan.policy)
+		require.Len(t, plan.deposits, 2)
+		require.Equal(t, 1, plan.deposits[0].index)
+		require.EqualValues(t, 5_0000_0000, plan.deposits[0].amount.Int64())
+		require.EqualValues(t, 2000, plan.deposits[0].till)
+		require.Equal(t, 2, plan.deposits[1].index)
+		require.Zero(t, plan.deposits[1].amount.Sign())
+		require.EqualValues(t, 1100, plan.deposits[1].till)
+		require.EqualValues(t, 16_000, plan.contracts)
+
+		require.Len(t, plan.changes, 6)
+		require.Equal(t, applyChange{"network", netmapMaintenanceAllowedKey, "<unset>", "true"}, plan.changes[0])
+		require.Equal(t, applyChange{"contracts", containerContract, "v0.15.0", "v0.16.0"}, plan.changes[5])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, cfg := range []*applyConfig{
+			{Network: map[string]string{netmapMaxObjectSizeKey: "abc"}},
+			{Network: map[string]string{"UnknownKey": "value"}},
+			{Policy: map[string]uint32{"Unknown": 1}},
+		} {
+			_, err := planApply(cfg, st, false)
+			require.Error(t, err)
+		}
+
+		plan, err := planApply(&applyConfig{Network: map[string]string{"UnknownKey": "value"}}, st, true)
+		require.NoError(t, err)
+		require.Equal(t, []configPair{{"UnknownKey", "value"}}, plan.network)
+	})
+}
+
+func TestContractVersionFromString(t *testing.T) {
+	for s, v := range map[string]int64{
+		"v0.16.0": 16_000,
+		"1.2.3":   1_002_003,
+	} {
+		actual, err := contractVersionFromString(s)
+		require.NoError(t, err)
+		require.Equal(t, v, actual)
+	}
+
+	for _, s := range []string{"", "v1.2", "v1.2.3.4", "v1.x.3", "v1.1000.0"} {
+		_, err := contractVersionFromString(s)
+		require.Error(t, err, s)
+	}
+}
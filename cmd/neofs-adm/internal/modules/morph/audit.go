@@ -0,0 +1,399 @@
+package morph
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/unwrap"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	auditSDK "github.com/nspcc-dev/neofs-sdk-go/audit"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	auditFromEpochFlag = "from"
+	auditToEpochFlag   = "to"
+	auditFormatFlag    = "format"
+	auditOutputFlag    = "output"
+
+	auditFormatText = "text"
+	auditFormatJSON = "json"
+	auditFormatCSV  = "csv"
+)
+
+var (
+	auditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Section for audit results inspection commands",
+	}
+
+	auditListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List audit results summary for the epoch range",
+		Long: `List audit results stored in the audit contract for the given epoch range.
+Every result is printed as a single record with PoR, PoP and PDP check counters.
+By default, results of the current epoch are listed.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: listAuditResults,
+	}
+
+	auditShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show detailed audit results for the epoch range",
+		Long: `Show audit results stored in the audit contract for the given epoch range
+with per-node PDP check status and passed/failed storage groups.
+By default, results of the current epoch are shown.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: showAuditResults,
+	}
+)
+
+// auditResult is a decoded audit result suitable for export.
+type auditResult struct {
+	Epoch       uint64   `json:"epoch"`
+	Container   string   `json:"container"`
+	Auditor     string   `json:"auditor"`
+	Complete    bool     `json:"complete"`
+	RequestsPoR uint32   `json:"por_requests"`
+	RetriesPoR  uint32   `json:"por_retries"`
+	PassedSG    []string `json:"passed_sg"`
+	FailedSG    []string `json:"failed_sg"`
+	Hits        uint32   `json:"pop_hits"`
+	Misses      uint32   `json:"pop_misses"`
+	Failures    uint32   `json:"pop_failures"`
+	PassedNodes []string `json:"pdp_passed_nodes"`
+	FailedNodes []string `json:"pdp_failed_nodes"`
+}
+
+func newAuditResult(res auditSDK.Result) auditResult {
+	r := auditResult{
+		Epoch:       res.Epoch(),
+		Auditor:     hex.EncodeToString(res.AuditorKey()),
+		Complete:    res.Completed(),
+		RequestsPoR: res.RequestsPoR(),
+		RetriesPoR:  res.RetriesPoR(),
+		Hits:        res.Hits(),
+		Misses:      res.Misses(),
+		Failures:    res.Failures(),
+		PassedSG:    []string{},
+		FailedSG:    []string{},
+		PassedNodes: []string{},
+		FailedNodes: []string{},
+	}
+
+	if cnr, ok := res.Container(); ok {
+		r.Container = cnr.EncodeToString()
+	}
+
+	res.IteratePassedStorageGroups(func(id oid.ID) bool {
+		r.PassedSG = append(r.PassedSG, id.EncodeToString())
+		return true
+	})
+	res.IterateFailedStorageGroups(func(id oid.ID) bool {
+		r.FailedSG = append(r.FailedSG, id.EncodeToString())
+		return true
+	})
+	res.IteratePassedStorageNodes(func(key []byte) bool {
+		r.PassedNodes = append(r.PassedNodes, hex.EncodeToString(key))
+		return true
+	})
+	res.IterateFailedStorageNodes(func(key []byte) bool {
+		r.FailedNodes = append(r.FailedNodes, hex.EncodeToString(key))
+		return true
+	})
+
+	return r
+}
+
+func listAuditResults(cmd *cobra.Command, _ []string) error {
+	return exportAuditResults(cmd, writeAuditSummary)
+}
+
+func showAuditResults(cmd *cobra.Command, _ []string) error {
+	return exportAuditResults(cmd, writeAuditDetails)
+}
+
+func exportAuditResults(cmd *cobra.Command, write func(io.Writer, string, []auditResult) error) (err error) {
+	format, _ := cmd.Flags().GetString(auditFormatFlag)
+	switch format {
+	case auditFormatText, auditFormatJSON, auditFormatCSV:
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	results, err := fetchAuditResults(cmd)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if filename, _ := cmd.Flags().GetString(auditOutputFlag); filename != "" {
+		f, openErr := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if openErr != nil {
+			return fmt.Errorf("can't open output file: %w", openErr)
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("can't close output file: %w", closeErr)
+			}
+		}()
+
+		out = f
+	}
+
+	return write(out, format, results)
+}
+
+func fetchAuditResults(cmd *cobra.Command) ([]auditResult, error) {
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return nil, fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	inv := invoker.New(c, nil)
+
+	nnsCs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return nil, fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	auditHash, err := nnsResolveHash(inv, nnsCs.Hash, auditContract+".neofs")
+	if err != nil {
+		return nil, fmt.Errorf("can't get audit contract hash: %w", err)
+	}
+
+	from, _ := cmd.Flags().GetUint64(auditFromEpochFlag)
+	if !cmd.Flags().Changed(auditFromEpochFlag) {
+		nmHash, err := nnsResolveHash(inv, nnsCs.Hash, netmapContract+".neofs")
+		if err != nil {
+			return nil, fmt.Errorf("can't get netmap contract hash: %w", err)
+		}
+
+		curr, err := unwrap.Int64(inv.Call(nmHash, "epoch"))
+		if err != nil {
+			return nil, fmt.Errorf("can't fetch current epoch from the netmap contract: %w", err)
+		}
+
+		from = uint64(curr)
+	}
+
+	to := from
+	if cmd.Flags().Changed(auditToEpochFlag) {
+		to, _ = cmd.Flags().GetUint64(auditToEpochFlag)
+		if to < from {
+			return nil, fmt.Errorf("invalid epoch range: %d > %d", from, to)
+		}
+	}
+
+	strCIDs, _ := cmd.Flags().GetStringSlice(containerIDsFlag)
+	cnrs := make([][]byte, len(strCIDs))
+	for i := range strCIDs {
+		var id cid.ID
+		if err := id.DecodeString(strCIDs[i]); err != nil {
+			return nil, fmt.Errorf("invalid container ID %s: %w", strCIDs[i], err)
+		}
+
+		cnrs[i] = make([]byte, sha256.Size)
+		id.Encode(cnrs[i])
+	}
+
+	var results []auditResult
+	for epoch := from; epoch <= to; epoch++ {
+		var ids [][]byte
+		if len(cnrs) == 0 {
+			ids, err = getAuditResultIDs(inv, auditHash, "listByEpoch", int64(epoch))
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			for i := range cnrs {
+				cnrIDs, err := getAuditResultIDs(inv, auditHash, "listByCID", int64(epoch), cnrs[i])
+				if err != nil {
+					return nil, err
+				}
+
+				ids = append(ids, cnrIDs...)
+			}
+		}
+
+		for i := range ids {
+			raw, err := unwrap.Bytes(inv.Call(auditHash, "get", ids[i]))
+			if err != nil {
+				return nil, fmt.Errorf("can't get audit result %s: %w", hex.EncodeToString(ids[i]), err)
+			}
+
+			var res auditSDK.Result
+			if err := res.Unmarshal(raw); err != nil {
+				return nil, fmt.Errorf("can't decode audit result %s: %w", hex.EncodeToString(ids[i]), err)
+			}
+
+			results = append(results, newAuditResult(res))
+		}
+	}
+
+	return results, nil
+}
+
+func getAuditResultIDs(inv *invoker.Invoker, auditHash util.Uint160, method string, args ...any) ([][]byte, error) {
+	res, err := inv.Call(auditHash, method, args...)
+	itm, err := unwrap.Item(res, err)
+	if err != nil {
+		return nil, fmt.Errorf("can't list audit results (%s): %w", method, err)
+	}
+	if _, ok := itm.(stackitem.Null); ok {
+		return nil, nil
+	}
+
+	ids, err := unwrap.ArrayOfBytes(res, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response from audit contract (%s): %w", method, err)
+	}
+
+	return ids, nil
+}
+
+var auditSummaryHeader = []string{
+	"epoch", "container", "auditor", "complete",
+	"por_requests", "por_retries", "sg_passed", "sg_failed",
+	"pop_hits", "pop_misses", "pop_failures",
+	"pdp_passed_nodes", "pdp_failed_nodes",
+}
+
+func (r auditResult) summary() []string {
+	return []string{
+		strconv.FormatUint(r.Epoch, 10),
+		r.Container,
+		r.Auditor,
+		strconv.FormatBool(r.Complete),
+		strconv.FormatUint(uint64(r.RequestsPoR), 10),
+		strconv.FormatUint(uint64(r.RetriesPoR), 10),
+		strconv.Itoa(len(r.PassedSG)),
+		strconv.Itoa(len(r.FailedSG)),
+		strconv.FormatUint(uint64(r.Hits), 10),
+		strconv.FormatUint(uint64(r.Misses), 10),
+		strconv.FormatUint(uint64(r.Failures), 10),
+		strconv.Itoa(len(r.PassedNodes)),
+		strconv.Itoa(len(r.FailedNodes)),
+	}
+}
+
+var auditNodesHeader = []string{"epoch", "container", "auditor", "node", "pdp"}
+
+func (r auditResult) nodes() [][]string {
+	rows := make([][]string, 0, len(r.PassedNodes)+len(r.FailedNodes))
+	epoch := strconv.FormatUint(r.Epoch, 10)
+
+	for i := range r.PassedNodes {
+		rows = append(rows, []string{epoch, r.Container, r.Auditor, r.PassedNodes[i], "pass"})
+	}
+	for i := range r.FailedNodes {
+		rows = append(rows, []string{epoch, r.Container, r.Auditor, r.FailedNodes[i], "fail"})
+	}
+
+	return rows
+}
+
+func writeAuditSummary(w io.Writer, format string, results []auditResult) error {
+	if format == auditFormatJSON {
+		return writeAuditJSON(w, results)
+	}
+
+	rows := make([][]string, len(results))
+	for i := range results {
+		rows[i] = results[i].summary()
+	}
+
+	return writeAuditTable(w, format, auditSummaryHeader, rows)
+}
+
+func writeAuditDetails(w io.Writer, format string, results []auditResult) error {
+	switch format {
+	case auditFormatJSON:
+		return writeAuditJSON(w, results)
+	case auditFormatCSV:
+		var rows [][]string
+		for i := range results {
+			rows = append(rows, results[i].nodes()...)
+		}
+
+		return writeAuditTable(w, format, auditNodesHeader, rows)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	for i, r := range results {
+		if i != 0 {
+			_, _ = fmt.Fprintln(tw)
+		}
+
+		_, _ = fmt.Fprintf(tw, "Epoch:\t%d\n", r.Epoch)
+		_, _ = fmt.Fprintf(tw, "Container:\t%s\n", r.Container)
+		_, _ = fmt.Fprintf(tw, "Auditor:\t%s\n", r.Auditor)
+		_, _ = fmt.Fprintf(tw, "Complete:\t%t\n", r.Complete)
+		_, _ = fmt.Fprintf(tw, "PoR:\trequests %d, retries %d\n", r.RequestsPoR, r.RetriesPoR)
+		_, _ = fmt.Fprintf(tw, "PoP:\thits %d, misses %d, failures %d\n", r.Hits, r.Misses, r.Failures)
+		for _, sg := range r.PassedSG {
+			_, _ = fmt.Fprintf(tw, "Storage group:\t%s\tpass\n", sg)
+		}
+		for _, sg := range r.FailedSG {
+			_, _ = fmt.Fprintf(tw, "Storage group:\t%s\tfail\n", sg)
+		}
+		for _, node := range r.nodes() {
+			_, _ = fmt.Fprintf(tw, "PDP:\t%s\t%s\n", node[3], node[4])
+		}
+	}
+
+	return tw.Flush()
+}
+
+func writeAuditJSON(w io.Writer, results []auditResult) error {
+	if results == nil {
+		results = []auditResult{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(results)
+}
+
+func writeAuditTable(w io.Writer, format string, header []string, rows [][]string) error {
+	if format == auditFormatCSV {
+		cw := csv.NewWriter(w)
+		if err := cw.WriteAll(append([][]string{header}, rows...)); err != nil {
+			return fmt.Errorf("can't write CSV: %w", err)
+		}
+
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	for _, row := range append([][]string{header}, rows...) {
+		for i := range row {
+			if i != 0 {
+				_, _ = io.WriteString(tw, "\t")
+			}
+			_, _ = io.WriteString(tw, row[i])
+		}
+		_, _ = io.WriteString(tw, "\n")
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,82 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	auditSDK "github.com/nspcc-dev/neofs-sdk-go/audit"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditResultExport(t *testing.T) {
+	cnr := cidtest.ID()
+	sg := oidtest.ID()
+	auditor := []byte{1, 2, 3}
+	passed := []byte{4, 5, 6}
+	failed := []byte{7, 8, 9}
+
+	var res auditSDK.Result
+	res.ForEpoch(42)
+	res.ForContainer(cnr)
+	res.SetAuditorKey(auditor)
+	res.Complete()
+	res.SetRequestsPoR(3)
+	res.SetRetriesPoR(1)
+	res.SubmitPassedStorageGroup(sg)
+	res.SetHits(10)
+	res.SetMisses(2)
+	res.SetFailures(1)
+	res.SubmitPassedStorageNodes([][]byte{passed})
+	res.SubmitFailedStorageNodes([][]byte{failed})
+
+	r := newAuditResult(res)
+	require.Equal(t, uint64(42), r.Epoch)
+	require.Equal(t, cnr.EncodeToString(), r.Container)
+	require.Equal(t, hex.EncodeToString(auditor), r.Auditor)
+	require.Equal(t, []string{sg.EncodeToString()}, r.PassedSG)
+	require.Empty(t, r.FailedSG)
+
+	t.Run("summary csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeAuditSummary(&buf, auditFormatCSV, []auditResult{r}))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{auditSummaryHeader, {
+			"42", cnr.EncodeToString(), hex.EncodeToString(auditor), "true",
+			"3", "1", "1", "0", "10", "2", "1", "1", "1",
+		}}, records)
+	})
+
+	t.Run("details csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeAuditDetails(&buf, auditFormatCSV, []auditResult{r}))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{auditNodesHeader,
+			{"42", cnr.EncodeToString(), hex.EncodeToString(auditor), hex.EncodeToString(passed), "pass"},
+			{"42", cnr.EncodeToString(), hex.EncodeToString(auditor), hex.EncodeToString(failed), "fail"},
+		}, records)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeAuditDetails(&buf, auditFormatJSON, []auditResult{r}))
+
+		var decoded []auditResult
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		require.Equal(t, []auditResult{r}, decoded)
+	})
+
+	t.Run("empty json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeAuditSummary(&buf, auditFormatJSON, nil))
+		require.JSONEq(t, "[]", buf.String())
+	})
+}
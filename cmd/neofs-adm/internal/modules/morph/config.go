@@ -69,7 +69,9 @@ func dumpNetworkConfig(cmd *cobra.Command, _ []string) error {
 			netmapContainerFeeKey, netmapContainerAliasFeeKey,
 			netmapEigenTrustIterationsKey,
 			netmapEpochKey, netmapInnerRingCandidateFeeKey,
-			netmapMaxObjectSizeKey, netmapWithdrawFeeKey:
+			netmapMaxObjectSizeKey, netmapWithdrawFeeKey,
+			netmapReputationRequestsWeightKey, netmapReputationLatencyWeightKey,
+			netmapReputationAvailabilityWeightKey:
 			nbuf := make([]byte, 8)
 			copy(nbuf[:], v)
 			n := binary.LittleEndian.Uint64(nbuf)
@@ -154,7 +156,9 @@ func parseConfigPair(kvStr string, force bool) (key string, val interface{}, err
 		netmapContainerFeeKey, netmapContainerAliasFeeKey,
 		netmapEigenTrustIterationsKey,
 		netmapEpochKey, netmapInnerRingCandidateFeeKey,
-		netmapMaxObjectSizeKey, netmapWithdrawFeeKey:
+		netmapMaxObjectSizeKey, netmapWithdrawFeeKey,
+		netmapReputationRequestsWeightKey, netmapReputationLatencyWeightKey,
+		netmapReputationAvailabilityWeightKey:
 		val, err = strconv.ParseInt(valRaw, 10, 64)
 		if err != nil {
 			err = fmt.Errorf("invalid value for %s key, expected int, got '%s'", key, valRaw)
@@ -63,6 +63,10 @@ const (
 	netmapHomomorphicHashDisabledKey = "HomomorphicHashingDisabled"
 	netmapMaintenanceAllowedKey      = "MaintenanceModeAllowed"
 
+	netmapReputationRequestsWeightKey     = "ReputationRequestsWeight"
+	netmapReputationLatencyWeightKey      = "ReputationLatencyWeight"
+	netmapReputationAvailabilityWeightKey = "ReputationAvailabilityWeight"
+
 	defaultEigenTrustIterations = 4
 	defaultEigenTrustAlpha      = "0.1"
 )
@@ -0,0 +1,502 @@
+package morph
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	netmapClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	placementCIDFlag     = "cid"
+	placementPolicyFlag  = "policy"
+	placementEpochFlag   = "epoch"
+	placementNetmapFlag  = "netmap"
+	placementAddFlag     = "add"
+	placementRemoveFlag  = "remove"
+	placementObjectsFlag = "objects"
+	placementSamplesFlag = "samples"
+	placementDumpFlag    = "dump"
+)
+
+// placementReport describes the difference between object placement
+// in the base and the target netmaps.
+type placementReport struct {
+	baseNodes   [][]netmap.NodeInfo
+	targetNodes [][]netmap.NodeInfo
+	// load contains the number of objects stored by each node
+	// (hex-encoded public key) in the base and the target netmaps.
+	load    map[string]*[2]int
+	objects int
+	moved   []movedObject
+}
+
+type movedObject struct {
+	id      oid.ID
+	removed []string
+	added   []string
+}
+
+func simulatePlacement(cmd *cobra.Command, _ []string) error {
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	inv := invoker.New(c, nil)
+
+	nnsCs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	var cnrID cid.ID
+	cidStr, _ := cmd.Flags().GetString(placementCIDFlag)
+	if cidStr != "" {
+		if err := cnrID.DecodeString(cidStr); err != nil {
+			return fmt.Errorf("invalid container ID: %w", err)
+		}
+	}
+
+	policy, err := placementPolicy(cmd, inv, nnsCs.Hash, cidStr != "", cnrID)
+	if err != nil {
+		return err
+	}
+
+	if cidStr == "" {
+		// placement depends on the container ID, so the result
+		// is the same for any container with the given policy
+		_, _ = rand.Read(cnrID[:])
+		cmd.Printf("Using random container ID %s.\n", cnrID)
+	}
+
+	base, err := baseNetmap(cmd, inv, nnsCs.Hash)
+	if err != nil {
+		return err
+	}
+
+	if p, _ := cmd.Flags().GetString(placementDumpFlag); p != "" {
+		data, err := json.MarshalIndent(base.Nodes(), "", "  ")
+		if err != nil {
+			return fmt.Errorf("can't encode netmap: %w", err)
+		}
+		if err := os.WriteFile(p, data, 0o644); err != nil {
+			return fmt.Errorf("can't write netmap: %w", err)
+		}
+	}
+
+	target, err := targetNetmap(cmd, base)
+	if err != nil {
+		return err
+	}
+
+	objs, listed, err := placementObjects(cmd)
+	if err != nil {
+		return err
+	}
+
+	rep, err := comparePlacement(*base, *target, policy, cnrID, objs)
+	if err != nil {
+		return err
+	}
+
+	printPlacementReport(cmd, policy, rep, listed)
+	return nil
+}
+
+func placementPolicy(cmd *cobra.Command, inv *invoker.Invoker, nnsHash util.Uint160, withCID bool, id cid.ID) (netmap.PlacementPolicy, error) {
+	var policy netmap.PlacementPolicy
+
+	s, _ := cmd.Flags().GetString(placementPolicyFlag)
+	if s != "" {
+		if data, err := os.ReadFile(s); err == nil {
+			s = string(data)
+		}
+
+		// JSON policy is an object, everything else is treated as QL
+		var err error
+		if strings.HasPrefix(strings.TrimSpace(s), "{") {
+			err = policy.UnmarshalJSON([]byte(s))
+		} else {
+			err = policy.DecodeString(s)
+		}
+		if err != nil {
+			return policy, fmt.Errorf("can't parse placement policy: %w", err)
+		}
+
+		return policy, nil
+	}
+
+	if !withCID {
+		return policy, fmt.Errorf("either --%s or --%s flag must be set", placementCIDFlag, placementPolicyFlag)
+	}
+
+	ch, err := nnsResolveHash(inv, nnsHash, containerContract+".neofs")
+	if err != nil {
+		return policy, fmt.Errorf("can't get container contract hash: %w", err)
+	}
+
+	res, err := inv.Call(ch, "get", id[:])
+	if err != nil {
+		return policy, fmt.Errorf("can't get container: %w", err)
+	}
+	if len(res.Stack) != 1 {
+		return policy, fmt.Errorf("%w: expected 1 item on stack", errInvalidContainerResponse)
+	}
+
+	var raw Container
+	if err := raw.FromStackItem(res.Stack[0]); err != nil {
+		return policy, fmt.Errorf("%w: %v", errInvalidContainerResponse, err)
+	}
+
+	var cnr container.Container
+	if err := cnr.Unmarshal(raw.Value); err != nil {
+		return policy, fmt.Errorf("can't decode container: %w", err)
+	}
+
+	return cnr.PlacementPolicy(), nil
+}
+
+// baseNetmap returns the netmap from the file, if set, or from the netmap
+// contract for the requested (current by default) epoch.
+func baseNetmap(cmd *cobra.Command, inv *invoker.Invoker, nnsHash util.Uint160) (*netmap.NetMap, error) {
+	if p, _ := cmd.Flags().GetString(placementNetmapFlag); p != "" {
+		nodes, err := readNodesFile(p)
+		if err != nil {
+			return nil, err
+		}
+
+		var nm netmap.NetMap
+		nm.SetNodes(nodes)
+		return &nm, nil
+	}
+
+	nmHash, err := nnsResolveHash(inv, nnsHash, netmapContract+".neofs")
+	if err != nil {
+		return nil, fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	epoch, _ := cmd.Flags().GetUint64(placementEpochFlag)
+	if epoch == 0 {
+		current, err := inv.Call(nmHash, "epoch")
+		if err != nil || len(current.Stack) != 1 {
+			return nil, fmt.Errorf("can't get current epoch: %v", err)
+		}
+
+		bi, err := current.Stack[0].TryInteger()
+		if err != nil || !bi.IsUint64() {
+			return nil, errors.New("invalid epoch value from netmap contract")
+		}
+		epoch = bi.Uint64()
+	}
+
+	res, err := inv.Call(nmHash, "snapshotByEpoch", epoch)
+	if err != nil {
+		return nil, fmt.Errorf("can't get netmap snapshot for epoch %d: %w", epoch, err)
+	}
+
+	nm, err := netmapClient.DecodeNetMap(res.Stack)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode netmap snapshot for epoch %d: %w", epoch, err)
+	}
+	if len(nm.Nodes()) == 0 {
+		return nil, fmt.Errorf("netmap snapshot for epoch %d is empty or not stored anymore", epoch)
+	}
+
+	nm.SetEpoch(epoch)
+	cmd.Printf("Using netmap of epoch %d (%d nodes).\n", epoch, len(nm.Nodes()))
+
+	return nm, nil
+}
+
+// targetNetmap returns the base netmap with nodes added and removed
+// according to the command flags.
+func targetNetmap(cmd *cobra.Command, base *netmap.NetMap) (*netmap.NetMap, error) {
+	removeKeys, _ := cmd.Flags().GetStringSlice(placementRemoveFlag)
+
+	remove := make(map[string]struct{}, len(removeKeys))
+	for _, k := range removeKeys {
+		if _, err := hex.DecodeString(k); err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", k, err)
+		}
+		remove[strings.ToLower(k)] = struct{}{}
+	}
+
+	var nodes []netmap.NodeInfo
+	for _, n := range base.Nodes() {
+		k := hex.EncodeToString(n.PublicKey())
+		if _, ok := remove[k]; ok {
+			delete(remove, k)
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+
+	for k := range remove {
+		return nil, fmt.Errorf("node %s is not in the netmap", k)
+	}
+
+	if p, _ := cmd.Flags().GetString(placementAddFlag); p != "" {
+		added, err := readNodesFile(p)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, added...)
+	}
+
+	var nm netmap.NetMap
+	nm.SetEpoch(base.Epoch())
+	nm.SetNodes(nodes)
+
+	return &nm, nil
+}
+
+// readNodesFile reads JSON array of node infos from the file.
+func readNodesFile(p string) ([]netmap.NodeInfo, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("can't read netmap file: %w", err)
+	}
+
+	var nodes []netmap.NodeInfo
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return nil, fmt.Errorf("can't decode netmap file: %w", err)
+	}
+
+	return nodes, nil
+}
+
+// placementObjects returns objects listed in the file or random ones.
+// The second value reports whether objects have been listed explicitly.
+func placementObjects(cmd *cobra.Command) ([]oid.ID, bool, error) {
+	p, _ := cmd.Flags().GetString(placementObjectsFlag)
+	if p == "" {
+		n, _ := cmd.Flags().GetUint(placementSamplesFlag)
+
+		objs := make([]oid.ID, n)
+		for i := range objs {
+			_, _ = rand.Read(objs[i][:])
+		}
+		return objs, false, nil
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, false, fmt.Errorf("can't open objects file: %w", err)
+	}
+	defer f.Close()
+
+	var objs []oid.ID
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+
+		var id oid.ID
+		if err := id.DecodeString(line); err != nil {
+			return nil, false, fmt.Errorf("invalid object ID %s: %w", line, err)
+		}
+		objs = append(objs, id)
+	}
+	if err := s.Err(); err != nil {
+		return nil, false, fmt.Errorf("can't read objects file: %w", err)
+	}
+
+	return objs, true, nil
+}
+
+// comparePlacement calculates placement of the given objects in both netmaps.
+func comparePlacement(base, target netmap.NetMap, policy netmap.PlacementPolicy, cnr cid.ID, objs []oid.ID) (*placementReport, error) {
+	var (
+		err error
+		rep = &placementReport{
+			load:    make(map[string]*[2]int),
+			objects: len(objs),
+		}
+	)
+
+	rep.baseNodes, err = base.ContainerNodes(policy, cnr)
+	if err != nil {
+		return nil, fmt.Errorf("can't build container nodes for the base netmap: %w", err)
+	}
+
+	rep.targetNodes, err = target.ContainerNodes(policy, cnr)
+	if err != nil {
+		return nil, fmt.Errorf("can't build container nodes for the target netmap: %w", err)
+	}
+
+	for _, id := range objs {
+		was, err := objectNodes(base, policy, rep.baseNodes, id)
+		if err != nil {
+			return nil, err
+		}
+
+		now, err := objectNodes(target, policy, rep.targetNodes, id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range was {
+			rep.nodeLoad(k)[0]++
+		}
+		for _, k := range now {
+			rep.nodeLoad(k)[1]++
+		}
+
+		removed, added := diffKeys(was, now)
+		if len(removed) != 0 || len(added) != 0 {
+			rep.moved = append(rep.moved, movedObject{id: id, removed: removed, added: added})
+		}
+	}
+
+	return rep, nil
+}
+
+func (r *placementReport) nodeLoad(key string) *[2]int {
+	l, ok := r.load[key]
+	if !ok {
+		l = new([2]int)
+		r.load[key] = l
+	}
+	return l
+}
+
+// objectNodes returns sorted public keys of the nodes which are to store
+// the object according to the policy replica numbers.
+func objectNodes(nm netmap.NetMap, policy netmap.PlacementPolicy, cnrNodes [][]netmap.NodeInfo, id oid.ID) ([]string, error) {
+	vectors, err := nm.PlacementVectors(cnrNodes, id)
+	if err != nil {
+		return nil, fmt.Errorf("can't build placement vectors for %s: %w", id, err)
+	}
+
+	var res []string
+	for i := range vectors {
+		n := int(policy.ReplicaNumberByIndex(i))
+		if n > len(vectors[i]) {
+			n = len(vectors[i])
+		}
+
+		for j := 0; j < n; j++ {
+			res = append(res, hex.EncodeToString(vectors[i][j].PublicKey()))
+		}
+	}
+
+	sort.Strings(res)
+	return res, nil
+}
+
+func diffKeys(was, now []string) (removed, added []string) {
+	in := func(s []string, k string) bool {
+		i := sort.SearchStrings(s, k)
+		return i < len(s) && s[i] == k
+	}
+
+	for _, k := range was {
+		if !in(now, k) {
+			removed = append(removed, k)
+		}
+	}
+	for _, k := range now {
+		if !in(was, k) {
+			added = append(added, k)
+		}
+	}
+	return
+}
+
+func printPlacementReport(cmd *cobra.Command, policy netmap.PlacementPolicy, rep *placementReport, listObjects bool) {
+	addresses := make(map[string]string)
+	baseKeys := collectNodes(rep.baseNodes, addresses)
+	targetKeys := collectNodes(rep.targetNodes, addresses)
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	for i := range rep.targetNodes {
+		_, _ = fmt.Fprintf(tw, "Descriptor #%d, REP %d:\n", i+1, policy.ReplicaNumberByIndex(i))
+		for _, n := range rep.targetNodes[i] {
+			k := hex.EncodeToString(n.PublicKey())
+			status := "kept"
+			if _, ok := baseKeys[k]; !ok {
+				status = "added"
+			}
+			_, _ = fmt.Fprintf(tw, "\t%s\t%s\t%s\n", k, addresses[k], status)
+		}
+		if i < len(rep.baseNodes) {
+			for _, n := range rep.baseNodes[i] {
+				k := hex.EncodeToString(n.PublicKey())
+				if _, ok := targetKeys[k]; !ok {
+					_, _ = fmt.Fprintf(tw, "\t%s\t%s\t%s\n", k, addresses[k], "removed")
+				}
+			}
+		}
+	}
+	_ = tw.Flush()
+
+	keys := make([]string, 0, len(rep.load))
+	for k := range rep.load {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	_, _ = fmt.Fprintf(buf, "\nExpected load (%d objects):\n", rep.objects)
+	_, _ = fmt.Fprintln(tw, "NODE\tBASE\tTARGET")
+	for _, k := range keys {
+		l := rep.load[k]
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", k, loadString(l[0], rep.objects), loadString(l[1], rep.objects))
+	}
+	_ = tw.Flush()
+
+	_, _ = fmt.Fprintf(buf, "\nObjects to move: %s\n", loadString(len(rep.moved), rep.objects))
+	if listObjects {
+		for _, m := range rep.moved {
+			_, _ = fmt.Fprintf(buf, "%s: -[%s] +[%s]\n", m.id,
+				strings.Join(m.removed, ", "), strings.Join(m.added, ", "))
+		}
+	}
+
+	cmd.Print(buf.String())
+}
+
+// collectNodes returns public keys of all the nodes and saves
+// their first network endpoints to addresses.
+func collectNodes(vectors [][]netmap.NodeInfo, addresses map[string]string) map[string]struct{} {
+	res := make(map[string]struct{})
+	for i := range vectors {
+		for _, n := range vectors[i] {
+			k := hex.EncodeToString(n.PublicKey())
+			res[k] = struct{}{}
+			n.IterateNetworkEndpoints(func(s string) bool {
+				addresses[k] = s
+				return true
+			})
+		}
+	}
+	return res
+}
+
+func loadString(n, total int) string {
+	if total == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%d (%.1f%%)", n, float64(n)*100/float64(total))
+}
@@ -0,0 +1,110 @@
+package morph
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparePlacement(t *testing.T) {
+	const (
+		nodeCount = 6
+		objCount  = 100
+	)
+
+	nodes := make([]netmap.NodeInfo, nodeCount)
+	for i := range nodes {
+		key := make([]byte, 33)
+		_, _ = rand.Read(key)
+		nodes[i].SetPublicKey(key)
+		nodes[i].SetNetworkEndpoints("/ip4/127.0.0.1/tcp/" + string(rune('0'+i)))
+	}
+
+	var base, target netmap.NetMap
+	base.SetNodes(nodes)
+	target.SetNodes(nodes[1:])
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 2"))
+
+	var cnr cid.ID
+	_, _ = rand.Read(cnr[:])
+
+	objs := make([]oid.ID, objCount)
+	for i := range objs {
+		_, _ = rand.Read(objs[i][:])
+	}
+
+	t.Run("same netmap", func(t *testing.T) {
+		rep, err := comparePlacement(base, base, policy, cnr, objs)
+		require.NoError(t, err)
+		require.Empty(t, rep.moved)
+		for _, l := range rep.load {
+			require.Equal(t, l[0], l[1])
+		}
+	})
+
+	t.Run("removed node", func(t *testing.T) {
+		rep, err := comparePlacement(base, target, policy, cnr, objs)
+		require.NoError(t, err)
+
+		removedKey := hex.EncodeToString(nodes[0].PublicKey())
+		require.Zero(t, rep.nodeLoad(removedKey)[1])
+		require.Len(t, rep.moved, rep.nodeLoad(removedKey)[0])
+
+		var baseTotal, targetTotal int
+		for _, l := range rep.load {
+			baseTotal += l[0]
+			targetTotal += l[1]
+		}
+		require.Equal(t, 2*objCount, baseTotal)
+		require.Equal(t, 2*objCount, targetTotal)
+
+		for _, m := range rep.moved {
+			require.Equal(t, []string{removedKey}, m.removed)
+			require.Len(t, m.added, 1)
+		}
+	})
+}
+
+func TestDiffKeys(t *testing.T) {
+	removed, added := diffKeys([]string{"a", "b", "c"}, []string{"b", "c", "d"})
+	require.Equal(t, []string{"a"}, removed)
+	require.Equal(t, []string{"d"}, added)
+
+	removed, added = diffKeys([]string{"a"}, []string{"a"})
+	require.Empty(t, removed)
+	require.Empty(t, added)
+}
+
+func TestPlacementPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		policy string
+		valid  bool
+	}{
+		{policy: "REP 2", valid: true},
+		{policy: `{"replicas":[{"count":2}]}`, valid: true},
+		{policy: "REP"},
+		{policy: `{"replicas":`},
+	} {
+		cmd := &cobra.Command{}
+		cmd.Flags().String(placementPolicyFlag, tc.policy, "")
+
+		_, err := placementPolicy(cmd, nil, util.Uint160{}, false, cid.ID{})
+		if tc.valid {
+			require.NoError(t, err, tc.policy)
+			continue
+		}
+
+		require.ErrorContains(t, err, "can't parse placement policy: ", tc.policy)
+		require.NotNil(t, errors.Unwrap(err), tc.policy)
+	}
+}
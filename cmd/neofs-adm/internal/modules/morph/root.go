@@ -199,6 +199,29 @@ var (
 		RunE: updateContracts,
 	}
 
+	applyCmd = &cobra.Command{
+		Use:   "apply",
+		Short: "Bring network config, policy, notary deposits and contracts to the state described in a file",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: applyNetworkState,
+	}
+
+	placementCmd = &cobra.Command{
+		Use:   "placement",
+		Short: "Simulate container placement on a netmap snapshot",
+		Long: `Simulate container placement on a netmap snapshot.
+Base netmap is taken from the netmap contract (current or historical epoch) or from a file.
+Target netmap is the base one with nodes added and removed. Command reports container
+nodes, expected load of each node and objects that would move to other nodes.`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: simulatePlacement,
+	}
+
 	dumpContainersCmd = &cobra.Command{
 		Use:   "dump-containers",
 		Short: "Dump NeoFS containers to file",
@@ -323,6 +346,41 @@ func init() {
 	updateContractsCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 	updateContractsCmd.Flags().String(contractsInitFlag, "", "Path to archive with compiled NeoFS contracts (default fetched from latest github release)")
 
+	RootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringP(applyFileFlag, "f", "", "Path to YAML file with the desired network state")
+	_ = applyCmd.MarkFlagRequired(applyFileFlag)
+	applyCmd.Flags().Bool(applyDryRunFlag, false, "Only print the difference with the current state")
+	applyCmd.Flags().String(alphabetWalletsFlag, "", "Path to alphabet wallets dir")
+	applyCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	applyCmd.Flags().String(contractsInitFlag, "", "Path to archive with compiled NeoFS contracts (default fetched from latest github release)")
+	applyCmd.Flags().Bool(forceConfigSet, false, "Force setting not well-known configuration key")
+
+	RootCmd.AddCommand(placementCmd)
+	placementCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	placementCmd.Flags().String(placementCIDFlag, "", "Container to take placement policy from")
+	placementCmd.Flags().String(placementPolicyFlag, "", "Placement policy (QL or JSON string or path to file), overrides container one")
+	placementCmd.Flags().Uint64(placementEpochFlag, 0, "Epoch of the base netmap snapshot (default current)")
+	placementCmd.Flags().String(placementNetmapFlag, "", "Path to JSON file with base netmap nodes, overrides netmap from chain")
+	placementCmd.Flags().String(placementAddFlag, "", "Path to JSON file with nodes to add to the target netmap")
+	placementCmd.Flags().StringSlice(placementRemoveFlag, nil, "Public keys of nodes to remove from the target netmap")
+	placementCmd.Flags().String(placementObjectsFlag, "", "Path to file with object IDs (one per line) to check")
+	placementCmd.Flags().Uint(placementSamplesFlag, 1000, "Number of random objects to check if objects file is not set")
+	placementCmd.Flags().String(placementDumpFlag, "", "Save base netmap nodes to JSON file (can be edited and used with --netmap)")
+
+	RootCmd.AddCommand(auditCmd)
+	for _, c := range []*cobra.Command{auditListCmd, auditShowCmd} {
+		ff := c.Flags()
+		ff.StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+		ff.Uint64(auditFromEpochFlag, 0, "First epoch of the range (default current)")
+		ff.Uint64(auditToEpochFlag, 0, "Last epoch of the range (default same as --from)")
+		ff.StringSlice(containerIDsFlag, nil, "Containers to fetch results for (default all)")
+		ff.String(auditFormatFlag, auditFormatText, "Output format: text, json or csv")
+		ff.StringP(auditOutputFlag, "o", "", "File to write results to (default stdout)")
+		_ = c.MarkFlagFilename(auditOutputFlag)
+
+		auditCmd.AddCommand(c)
+	}
+
 	RootCmd.AddCommand(dumpContainersCmd)
 	dumpContainersCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 	dumpContainersCmd.Flags().String(containerDumpFlag, "", "File where to save dumped containers")
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"time"
@@ -20,25 +21,35 @@ var errInvalidEndpoint = errors.New("provided RPC endpoint is incorrect")
 // GetSDKClientByFlag returns default neofs-sdk-go client using the specified flag for the address.
 // On error, outputs to stderr of cmd and exits with non-zero code.
 func GetSDKClientByFlag(ctx context.Context, cmd *cobra.Command, endpointFlag string) *client.Client {
-	cli, err := getSDKClientByFlag(ctx, endpointFlag)
+	return GetSDKClientByFlagWithTLS(ctx, cmd, endpointFlag, nil)
+}
+
+// GetSDKClientByFlagWithTLS is the same as GetSDKClientByFlag but uses provided
+// TLS configuration for TLS endpoints. Nil tlsConfig means default one.
+func GetSDKClientByFlagWithTLS(ctx context.Context, cmd *cobra.Command, endpointFlag string, tlsConfig *tls.Config) *client.Client {
+	cli, err := getSDKClientByFlag(ctx, endpointFlag, tlsConfig)
 	if err != nil {
 		common.ExitOnErr(cmd, "can't create API client: %w", err)
 	}
 	return cli
 }
 
-func getSDKClientByFlag(ctx context.Context, endpointFlag string) (*client.Client, error) {
+func getSDKClientByFlag(ctx context.Context, endpointFlag string, tlsConfig *tls.Config) (*client.Client, error) {
 	var addr network.Address
 
 	err := addr.FromString(viper.GetString(endpointFlag))
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", errInvalidEndpoint, err)
 	}
-	return GetSDKClient(ctx, addr)
+	return getSDKClient(ctx, addr, tlsConfig)
 }
 
 // GetSDKClient returns default neofs-sdk-go client.
 func GetSDKClient(ctx context.Context, addr network.Address) (*client.Client, error) {
+	return getSDKClient(ctx, addr, nil)
+}
+
+func getSDKClient(ctx context.Context, addr network.Address, tlsConfig *tls.Config) (*client.Client, error) {
 	var (
 		prmInit client.PrmInit
 		prmDial client.PrmDial
@@ -46,6 +57,7 @@ func GetSDKClient(ctx context.Context, addr network.Address) (*client.Client, er
 
 	prmDial.SetServerURI(addr.URIAddr())
 	prmDial.SetContext(ctx)
+	prmDial.SetTLSConfig(tlsConfig)
 
 	deadline, ok := ctx.Deadline()
 	if ok {
@@ -0,0 +1,66 @@
+package morph
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	"github.com/spf13/cobra"
+)
+
+// RPCFlag is a flag of the sidechain RPC endpoint used to read the state
+// of the NeoFS contracts.
+const (
+	RPCFlag      = "morph-rpc-endpoint"
+	rpcFlagUsage = "Sidechain RPC node address (as 'ws://<host>:<port>/ws')"
+)
+
+// InitFlags adds sidechain connection flags to the command.
+func InitFlags(cmd *cobra.Command) {
+	cmd.Flags().String(RPCFlag, "", rpcFlagUsage)
+}
+
+// GetClient connects to the sidechain RPC endpoint set by RPCFlag. Contracts
+// are only read, so the client is signed by the random key. Client must be
+// closed by the caller.
+func GetClient(ctx context.Context, cmd *cobra.Command) *client.Client {
+	endpoint, _ := cmd.Flags().GetString(RPCFlag)
+
+	pk, err := keys.NewPrivateKey()
+	common.ExitOnErr(cmd, "can't generate key: %w", err)
+
+	cli, err := client.New(pk,
+		client.WithContext(ctx),
+		client.WithEndpoints([]string{endpoint}),
+	)
+	common.ExitOnErr(cmd, "can't connect to the sidechain: %w", err)
+
+	return cli
+}
+
+// GetContainerClient returns the client of the Container contract resolved
+// through the NNS.
+func GetContainerClient(cmd *cobra.Command, cli *client.Client) *cntClient.Client {
+	addr, err := cli.NNSContractAddress(client.NNSContainerContractName)
+	common.ExitOnErr(cmd, "can't resolve Container contract address: %w", err)
+
+	res, err := cntClient.NewFromMorph(cli, addr, 0)
+	common.ExitOnErr(cmd, "can't create Container contract client: %w", err)
+
+	return res
+}
+
+// GetNetmapClient returns the client of the Netmap contract resolved through
+// the NNS.
+func GetNetmapClient(cmd *cobra.Command, cli *client.Client) *nmClient.Client {
+	addr, err := cli.NNSContractAddress(client.NNSNetmapContractName)
+	common.ExitOnErr(cmd, "can't resolve Netmap contract address: %w", err)
+
+	res, err := nmClient.NewFromMorph(cli, addr, 0)
+	common.ExitOnErr(cmd, "can't create Netmap contract client: %w", err)
+
+	return res
+}
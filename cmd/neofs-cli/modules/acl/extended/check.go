@@ -0,0 +1,748 @@
+package extended
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/util"
+	aclsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
+	eaclV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checkTableFlag     = "table"
+	checkOpFlag        = "op"
+	checkRoleFlag      = "role"
+	checkHeaderFlag    = "header"
+	checkSenderKeyFlag = "sender-key"
+	checkBasicACLFlag  = "basic-acl"
+	checkBearerFlag    = "bearer"
+	checkEpochFlag     = "epoch"
+	checkOwnerFlag     = "owner"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check access to the object operation against extended ACL",
+	Long: `Check access to the object operation against extended ACL offline.
+
+The request is evaluated the same way storage nodes do it:
+  1. basic ACL bits are checked for the operation and role (if --basic-acl is set),
+     for PUT the object owner must match the sender key if the sticky bit is set;
+  2. extended ACL is skipped if the basic ACL has the final bit set;
+  3. bearer token table overrides the container one if the basic ACL allows it,
+     the token is checked for lifetime (--epoch), signature, container (--cid),
+     issuer (--owner) and user (--sender-key) when the corresponding flags are set;
+  4. request and object headers are composed by the storage node header sources,
+     the first record matching the operation, the target and all the filters
+     defines the action, the operation is allowed if no record matches.
+
+Role is one of 'user' (container owner), 'system' or 'container' (container nodes),
+'ir' (Inner Ring nodes) or 'others'.
+
+Headers are given as [<typ>:]<key>=<value> where typ is 'obj' for object headers
+(default) or 'req' for request X-headers. Object headers describe the object the
+node stores (GET, HEAD) or receives (PUT): well-known '$Object:' headers set the
+corresponding header fields, e.g. '$Object:objectType=REGULAR', others are set as
+attributes. Container and object IDs are set by --cid and --oid flags, object ID
+is required for all operations except PUT and SEARCH. As storage nodes do, only
+address headers are checked for DELETE, GETRANGE and GETRANGEHASH operations and
+only container ID for SEARCH.
+
+Table is read from JSON, binary or text rules file (see 'create' command).`,
+	Example: `neofs-cli acl extended check --table eacl.json --op get --role others --cid FQ1G1YoNegqsZ8pHSdhVFh4Ze3C9yKmu2UoR7CpCZ6j3 --oid 49ey11Fr6M32bvVupqKtZPKRzm9E7G3J3hmzaiF7pbLp --header FileName=cat.jpg
+neofs-cli acl extended check --table rules.txt --op put --role others --sender-key 036410abb260bbbda89f61c0cad65a4fa15ac5cb83b3c3abf8aee403856fcf65ed
+neofs-cli acl extended check --basic-acl eacl-public-read --bearer bearer.json --op head --role others --cid FQ1G1YoNegqsZ8pHSdhVFh4Ze3C9yKmu2UoR7CpCZ6j3 --oid 49ey11Fr6M32bvVupqKtZPKRzm9E7G3J3hmzaiF7pbLp --sender-key 036410abb260bbbda89f61c0cad65a4fa15ac5cb83b3c3abf8aee403856fcf65ed`,
+	Args: cobra.NoArgs,
+	Run:  checkEACL,
+}
+
+func init() {
+	flags := checkCmd.Flags()
+
+	flags.StringP(checkTableFlag, "f", "", "Read extended ACL table from file")
+	flags.String(checkOpFlag, "", "Object operation: 'get', 'head', 'put', 'delete', 'search', 'getrange' or 'getrangehash'")
+	flags.String(checkRoleFlag, "", "Request sender role: 'user', 'system', 'container', 'ir' or 'others'")
+	flags.StringArray(checkHeaderFlag, nil, "Request or object header in [<typ>:]<key>=<value> format")
+	flags.String(checkSenderKeyFlag, "", "Hex-encoded public key of the request sender")
+	flags.String(checkBasicACLFlag, "", "Basic ACL of the container in HEX or keyword format")
+	flags.String(checkBearerFlag, "", "Path to the bearer token file")
+	flags.Uint64(checkEpochFlag, 0, "Current epoch to check bearer token lifetime")
+	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	flags.String(commonflags.OIDFlag, "", commonflags.OIDFlagUsage)
+	flags.String(checkOwnerFlag, "", "Container owner to check bearer token issuer")
+
+	_ = checkCmd.MarkFlagRequired(checkOpFlag)
+	_ = checkCmd.MarkFlagRequired(checkRoleFlag)
+	_ = cobra.MarkFlagFilename(flags, checkTableFlag)
+	_ = cobra.MarkFlagFilename(flags, checkBearerFlag)
+}
+
+// checkPrm groups the parameters of the extended ACL check.
+type checkPrm struct {
+	op   eacl.Operation
+	role acl.Role
+
+	senderKey []byte
+
+	cnr   cid.ID
+	objID *oid.ID
+	// object header, nil if unknown
+	obj *objectSDK.Object
+
+	reqHeaders []eacl.Header
+
+	basicACL *acl.Basic
+	table    *eacl.Table
+	bearer   *bearer.Token
+
+	// optional parameters of the bearer token check
+	epoch *uint64
+	owner *user.ID
+	// cnrSet is true if the container ID is set explicitly
+	cnrSet bool
+}
+
+// checkResult describes the result of the extended ACL check.
+type checkResult struct {
+	allowed bool
+	// reason of the decision
+	reason string
+	// index of the matched record, negative if no record matched
+	record int
+	// table which has been evaluated, nil if extended ACL was not applied
+	table *eacl.Table
+	// notes on the skipped checks and ignored parameters
+	notes []string
+}
+
+func checkEACL(cmd *cobra.Command, _ []string) {
+	var (
+		prm checkPrm
+		err error
+	)
+
+	opStr, _ := cmd.Flags().GetString(checkOpFlag)
+	if !prm.op.DecodeString(strings.ToUpper(opStr)) {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid operation: %s", opStr))
+	}
+
+	roleStr, _ := cmd.Flags().GetString(checkRoleFlag)
+	prm.role, err = checkRoleFromString(roleStr)
+	common.ExitOnErr(cmd, "", err)
+
+	if keyStr, _ := cmd.Flags().GetString(checkSenderKeyFlag); keyStr != "" {
+		pub, err := keys.NewPublicKeyFromString(strings.TrimPrefix(keyStr, "0x"))
+		common.ExitOnErr(cmd, "invalid sender key: %w", err)
+
+		prm.senderKey = pub.Bytes()
+	}
+
+	hdrs, _ := cmd.Flags().GetStringArray(checkHeaderFlag)
+	objHeaders, reqHeaders, err := parseCheckHeaders(hdrs)
+	common.ExitOnErr(cmd, "", err)
+
+	prm.reqHeaders = reqHeaders
+
+	if len(objHeaders) > 0 {
+		prm.obj, err = checkObjectFromHeaders(objHeaders)
+		common.ExitOnErr(cmd, "invalid object headers: %w", err)
+	}
+
+	if cidStr, _ := cmd.Flags().GetString(commonflags.CIDFlag); cidStr != "" {
+		common.ExitOnErr(cmd, "invalid container ID: %w", prm.cnr.DecodeString(cidStr))
+
+		prm.cnrSet = true
+	}
+
+	if oidStr, _ := cmd.Flags().GetString(commonflags.OIDFlag); oidStr != "" {
+		var id oid.ID
+		common.ExitOnErr(cmd, "invalid object ID: %w", id.DecodeString(oidStr))
+
+		prm.objID = &id
+	}
+
+	if baclStr, _ := cmd.Flags().GetString(checkBasicACLFlag); baclStr != "" {
+		var bacl acl.Basic
+		common.ExitOnErr(cmd, "unable to parse basic acl: %w", bacl.DecodeString(baclStr))
+
+		prm.basicACL = &bacl
+	}
+
+	if tablePath, _ := cmd.Flags().GetString(checkTableFlag); tablePath != "" {
+		prm.table, err = readCheckTable(tablePath)
+		common.ExitOnErr(cmd, "can't read extended ACL table: %w", err)
+	}
+
+	prm.bearer = common.ReadBearerToken(cmd, checkBearerFlag)
+
+	if prm.table == nil && prm.bearer == nil {
+		common.ExitOnErr(cmd, "", fmt.Errorf("either --%s or --%s must be set", checkTableFlag, checkBearerFlag))
+	}
+
+	if cmd.Flags().Changed(checkEpochFlag) {
+		epoch, _ := cmd.Flags().GetUint64(checkEpochFlag)
+		prm.epoch = &epoch
+	}
+
+	if ownerStr, _ := cmd.Flags().GetString(checkOwnerFlag); ownerStr != "" {
+		var owner user.ID
+		common.ExitOnErr(cmd, "invalid container owner: %w", owner.DecodeString(ownerStr))
+
+		prm.owner = &owner
+	}
+
+	res, err := checkAccess(prm)
+	common.ExitOnErr(cmd, "", err)
+
+	for _, note := range res.notes {
+		cmd.Println("Note:", note)
+	}
+
+	if res.record >= 0 {
+		records := res.table.Records()
+		cmd.Printf("Matched record #%d: %s\n", res.record, recordToString(records[res.record]))
+	}
+
+	if res.allowed {
+		cmd.Printf("Operation is ALLOWED: %s.\n", res.reason)
+	} else {
+		cmd.Printf("Operation is DENIED: %s.\n", res.reason)
+	}
+}
+
+// checkAccess evaluates the request described by the parameters the same way
+// storage nodes do it (see pkg/services/object/acl).
+func checkAccess(prm checkPrm) (checkResult, error) {
+	res := checkResult{record: -1}
+
+	if !prm.cnrSet {
+		res.notes = append(res.notes, "container ID is not set, zero ID is used in the headers")
+	}
+
+	if prm.basicACL == nil {
+		res.notes = append(res.notes, "basic ACL is not set, its bits are not checked")
+	} else {
+		if !prm.basicACL.IsOpAllowed(acl.Op(prm.op), prm.role) {
+			res.reason = "operation is forbidden for the role by basic ACL"
+			return res, nil
+		}
+
+		if prm.op == eacl.OperationPut {
+			if owner := checkObjectOwner(prm.obj); owner == nil {
+				if prm.basicACL.Sticky() {
+					res.notes = append(res.notes, "object owner is not set, sticky bit is not checked")
+				}
+			} else if !aclsvc.CheckStickyBit(*prm.basicACL, prm.role, prm.senderKey, *owner) {
+				res.reason = "object owner differs from the request sender while basic ACL has sticky bit set"
+				return res, nil
+			}
+		}
+
+		if !prm.basicACL.Extendable() {
+			res.allowed = true
+			res.reason = "basic ACL is final, extended ACL is not applied"
+			return res, nil
+		}
+	}
+
+	table := prm.table
+
+	if prm.bearer != nil {
+		if prm.basicACL != nil && !prm.basicACL.AllowedBearerRules(acl.Op(prm.op)) {
+			res.notes = append(res.notes, "bearer token is ignored since basic ACL forbids bearer rules for the operation")
+		} else {
+			if err := checkBearer(prm, &res); err != nil {
+				res.reason = err.Error()
+				return res, nil
+			}
+
+			bTable := prm.bearer.EACLTable()
+			table = &bTable
+
+			res.notes = append(res.notes, "extended ACL table is taken from the bearer token")
+		}
+	}
+
+	if table == nil {
+		res.allowed = true
+		res.reason = "extended ACL is not set"
+		return res, nil
+	}
+
+	res.table = table
+
+	hdrSrc, err := checkHeaderSource(prm, &res)
+	if err != nil {
+		return res, err
+	}
+
+	var eaclRole eacl.Role
+	switch prm.role {
+	default:
+		eaclRole = eacl.Role(prm.role)
+	case acl.RoleOwner:
+		eaclRole = eacl.RoleUser
+	case acl.RoleInnerRing, acl.RoleContainer:
+		eaclRole = eacl.RoleSystem
+	case acl.RoleOthers:
+		eaclRole = eacl.RoleOthers
+	}
+
+	unit := new(eacl.ValidationUnit).
+		WithRole(eaclRole).
+		WithOperation(prm.op).
+		WithContainerID(&prm.cnr).
+		WithSenderKey(prm.senderKey).
+		WithHeaderSource(hdrSrc)
+
+	validator := eacl.NewValidator()
+
+	action, matched := validator.CalculateAction(unit.WithEACLTable(table))
+	if !matched {
+		res.allowed = true
+		res.reason = "no record matched"
+		return res, nil
+	}
+
+	res.allowed = action == eacl.ActionAllow
+	res.reason = "action of the matched record"
+
+	// validator stops on the first matched record, so it is the first one
+	// matched alone
+	records := table.Records()
+
+	for i := range records {
+		single := eacl.NewTable()
+		single.AddRecord(&records[i])
+
+		if _, matched = validator.CalculateAction(unit.WithEACLTable(single)); matched {
+			res.record = i
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// checkHeaderSource returns the source of the request and object headers
+// composed by storage nodes for the request described by the parameters.
+func checkHeaderSource(prm checkPrm, res *checkResult) (eacl.TypedHeaderSource, error) {
+	xHeaders := make([]session.XHeader, len(prm.reqHeaders))
+	for i := range prm.reqHeaders {
+		xHeaders[i].SetKey(prm.reqHeaders[i].Key())
+		xHeaders[i].SetValue(prm.reqHeaders[i].Value())
+	}
+
+	var meta session.RequestMetaHeader
+	meta.SetXHeaders(xHeaders)
+
+	var req interface {
+		eaclV2.Request
+		SetMetaHeader(*session.RequestMetaHeader)
+	}
+
+	var idV2 *refs.ObjectID
+	if prm.objID != nil {
+		idV2 = new(refs.ObjectID)
+		prm.objID.WriteToV2(idV2)
+	}
+
+	switch prm.op {
+	default:
+		return nil, fmt.Errorf("unsupported operation: %s", prm.op)
+	case eacl.OperationGet:
+		req = new(objectV2.GetRequest)
+	case eacl.OperationHead:
+		req = new(objectV2.HeadRequest)
+	case eacl.OperationRange:
+		req = new(objectV2.GetRangeRequest)
+	case eacl.OperationRangeHash:
+		req = new(objectV2.GetRangeHashRequest)
+	case eacl.OperationDelete:
+		req = new(objectV2.DeleteRequest)
+	case eacl.OperationPut:
+		obj := prm.obj
+		if obj == nil {
+			obj = objectSDK.New()
+		}
+
+		var part objectV2.PutObjectPartInit
+		part.SetObjectID(idV2)
+		part.SetHeader(obj.ToV2().GetHeader())
+
+		var body objectV2.PutRequestBody
+		body.SetObjectPart(&part)
+
+		putReq := new(objectV2.PutRequest)
+		putReq.SetBody(&body)
+
+		req = putReq
+	case eacl.OperationSearch:
+		var cnrV2 refs.ContainerID
+		prm.cnr.WriteToV2(&cnrV2)
+
+		var body objectV2.SearchRequestBody
+		body.SetContainerID(&cnrV2)
+
+		searchReq := new(objectV2.SearchRequest)
+		searchReq.SetBody(&body)
+
+		req = searchReq
+	}
+
+	req.SetMetaHeader(&meta)
+
+	switch prm.op {
+	case eacl.OperationGet, eacl.OperationHead:
+		if prm.obj == nil {
+			res.notes = append(res.notes, "object headers are not set, records with object filters are not applied")
+		}
+	case eacl.OperationRange, eacl.OperationRangeHash, eacl.OperationDelete, eacl.OperationSearch:
+		if prm.obj != nil {
+			res.notes = append(res.notes, fmt.Sprintf("only address object headers are checked for %s operation", prm.op))
+		}
+	}
+
+	if prm.op != eacl.OperationPut && prm.op != eacl.OperationSearch && prm.objID == nil {
+		return nil, fmt.Errorf("object ID is required for %s operation", prm.op)
+	}
+
+	hdrSrc, err := eaclV2.NewMessageHeaderSource(
+		eaclV2.WithObjectStorage(checkObjectStorage{prm.obj}),
+		eaclV2.WithServiceRequest(req),
+		eaclV2.WithCID(prm.cnr),
+		eaclV2.WithOID(prm.objID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse headers: %w", err)
+	}
+
+	return hdrSrc, nil
+}
+
+// checkObjectStorage provides the object header passed to the command as the
+// one stored locally by the storage node.
+type checkObjectStorage struct {
+	obj *objectSDK.Object
+}
+
+func (s checkObjectStorage) Head(oid.Address) (*objectSDK.Object, error) {
+	if s.obj == nil {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	return s.obj, nil
+}
+
+// checkObjectOwner returns owner of the object, nil if unknown.
+func checkObjectOwner(obj *objectSDK.Object) *user.ID {
+	if obj == nil {
+		return nil
+	}
+
+	return obj.OwnerID()
+}
+
+// checkObjectFromHeaders builds the object header from the headers in the
+// eACL filter format. Well-known '$Object:' headers set the corresponding
+// fields, others are set as attributes.
+func checkObjectFromHeaders(hdrs []eacl.Header) (*objectSDK.Object, error) {
+	obj := objectSDK.New()
+	attrs := make([]objectSDK.Attribute, 0, len(hdrs))
+
+	for _, h := range hdrs {
+		key, val := h.Key(), h.Value()
+
+		switch key {
+		case v2acl.FilterObjectContainerID:
+			return nil, fmt.Errorf("%s is set by --%s flag", key, commonflags.CIDFlag)
+		case v2acl.FilterObjectID:
+			return nil, fmt.Errorf("%s is set by --%s flag", key, commonflags.OIDFlag)
+		case v2acl.FilterObjectVersion:
+			var major, minor uint32
+			if _, err := fmt.Sscanf(val, "v%d.%d", &major, &minor); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			var ver version.Version
+			ver.SetMajor(major)
+			ver.SetMinor(minor)
+
+			obj.SetVersion(&ver)
+		case v2acl.FilterObjectOwnerID:
+			var owner user.ID
+			if err := owner.DecodeString(val); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			obj.SetOwnerID(&owner)
+		case v2acl.FilterObjectCreationEpoch:
+			epoch, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			obj.SetCreationEpoch(epoch)
+		case v2acl.FilterObjectPayloadLength:
+			size, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			obj.SetPayloadSize(size)
+		case v2acl.FilterObjectType:
+			var typ objectSDK.Type
+			if !typ.DecodeString(val) {
+				return nil, fmt.Errorf("invalid %s: %s", key, val)
+			}
+
+			obj.SetType(typ)
+		case v2acl.FilterObjectPayloadHash:
+			var sum [sha256.Size]byte
+			if err := decodeCheckHash(val, checksum.SHA256, sum[:]); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			var cs checksum.Checksum
+			cs.SetSHA256(sum)
+
+			obj.SetPayloadChecksum(cs)
+		case v2acl.FilterObjectHomomorphicHash:
+			var sum [tz.Size]byte
+			if err := decodeCheckHash(val, checksum.TZ, sum[:]); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			var cs checksum.Checksum
+			cs.SetTillichZemor(sum)
+
+			obj.SetPayloadHomomorphicHash(cs)
+		default:
+			if strings.HasPrefix(key, v2acl.ObjectFilterPrefix) {
+				return nil, fmt.Errorf("unsupported object header: %s", key)
+			}
+
+			var a objectSDK.Attribute
+			a.SetKey(key)
+			a.SetValue(val)
+
+			attrs = append(attrs, a)
+		}
+	}
+
+	obj.SetAttributes(attrs...)
+
+	return obj, nil
+}
+
+// decodeCheckHash decodes hex-encoded hash with optional type prefix (as
+// storage nodes print checksums) to dst.
+func decodeCheckHash(s string, typ checksum.Type, dst []byte) error {
+	s = strings.TrimPrefix(s, typ.String()+":")
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	if len(b) != len(dst) {
+		return fmt.Errorf("invalid length %d, expected %d", len(b), len(dst))
+	}
+
+	copy(dst, b)
+
+	return nil
+}
+
+// checkBearer checks bearer token like storage nodes do it. Checks which
+// require unset parameters are skipped with a note.
+func checkBearer(prm checkPrm, res *checkResult) error {
+	tok := prm.bearer
+
+	if prm.epoch == nil {
+		res.notes = append(res.notes, "epoch is not set, bearer token lifetime is not checked")
+	} else if tok.InvalidAt(*prm.epoch) {
+		return errors.New("bearer token has expired")
+	}
+
+	if !tok.VerifySignature() {
+		return errors.New("bearer token has invalid signature")
+	}
+
+	if cnr, isSet := tok.EACLTable().CID(); isSet {
+		if !prm.cnrSet {
+			res.notes = append(res.notes, "container ID is not set, bearer token container is not checked")
+		} else if !cnr.Equals(prm.cnr) {
+			return errors.New("bearer token was created for another container")
+		}
+	}
+
+	if prm.owner == nil {
+		res.notes = append(res.notes, "container owner is not set, bearer token issuer is not checked")
+	} else if !tok.ResolveIssuer().Equals(*prm.owner) {
+		return errors.New("bearer token is not signed by the container owner")
+	}
+
+	if len(prm.senderKey) == 0 {
+		res.notes = append(res.notes, "sender key is not set, bearer token user is not checked")
+		return nil
+	}
+
+	pub, err := keys.NewPublicKeyFromBytes(prm.senderKey, elliptic.P256())
+	if err != nil {
+		return fmt.Errorf("decode sender public key: %w", err)
+	}
+
+	if !tok.AssertUser(user.ResolveFromECDSAPublicKey(ecdsa.PublicKey(*pub))) {
+		return errors.New("bearer token owner differs from the request sender")
+	}
+
+	return nil
+}
+
+func checkRoleFromString(s string) (acl.Role, error) {
+	switch strings.ToLower(s) {
+	case "user":
+		return acl.RoleOwner, nil
+	case "system", "container":
+		return acl.RoleContainer, nil
+	case "ir":
+		return acl.RoleInnerRing, nil
+	case "others":
+		return acl.RoleOthers, nil
+	default:
+		return 0, fmt.Errorf("invalid role: %s", s)
+	}
+}
+
+// parseCheckHeaders parses headers in [<typ>:]<key>=<value> format to
+// object and request ones.
+func parseCheckHeaders(hdrs []string) ([]eacl.Header, []eacl.Header, error) {
+	var objHeaders, reqHeaders []eacl.Header
+
+	for _, h := range hdrs {
+		dst := &objHeaders
+
+		switch {
+		case strings.HasPrefix(h, "obj:"):
+			h = h[len("obj:"):]
+		case strings.HasPrefix(h, "req:"):
+			h, dst = h[len("req:"):], &reqHeaders
+		}
+
+		i := strings.Index(h, "=")
+		if i <= 0 {
+			return nil, nil, fmt.Errorf("invalid header key-value pair: %s", h)
+		}
+
+		*dst = append(*dst, checkHeader{h[:i], h[i+1:]})
+	}
+
+	return objHeaders, reqHeaders, nil
+}
+
+// readCheckTable reads extended ACL table from the JSON, binary or text
+// rules file.
+func readCheckTable(path string) (*eacl.Table, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	table := eacl.NewTable()
+	if table.UnmarshalJSON(data) == nil {
+		return table, nil
+	}
+
+	table = eacl.NewTable()
+	rules := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if util.ParseEACLRules(table, rules) == nil {
+		return table, nil
+	}
+
+	table = eacl.NewTable()
+	if table.Unmarshal(data) == nil {
+		return table, nil
+	}
+
+	return nil, errors.New("unsupported table format")
+}
+
+// recordToString returns the record in the text rule format accepted by
+// 'create' command.
+func recordToString(r eacl.Record) string {
+	parts := []string{
+		strings.ToLower(r.Action().String()),
+		strings.ToLower(r.Operation().String()),
+	}
+
+	for _, f := range r.Filters() {
+		typ := "req"
+		if f.From() == eacl.HeaderFromObject {
+			typ = "obj"
+		}
+
+		match := "="
+		if f.Matcher() == eacl.MatchStringNotEqual {
+			match = "!="
+		}
+
+		parts = append(parts, typ+":"+f.Key()+match+f.Value())
+	}
+
+	for _, t := range r.Targets() {
+		pubs := t.BinaryKeys()
+		if len(pubs) == 0 {
+			parts = append(parts, strings.ToLower(t.Role().String()))
+			continue
+		}
+
+		strs := make([]string, len(pubs))
+		for i := range pubs {
+			strs[i] = hex.EncodeToString(pubs[i])
+		}
+
+		parts = append(parts, "pubkey:"+strings.Join(strs, ","))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+type checkHeader struct {
+	key, value string
+}
+
+func (h checkHeader) Key() string {
+	return h.key
+}
+
+func (h checkHeader) Value() string {
+	return h.value
+}
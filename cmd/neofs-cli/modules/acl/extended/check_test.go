@@ -0,0 +1,295 @@
+package extended
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/util"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAccess(t *testing.T) {
+	signer := test.RandomSignerRFC6979(t)
+	senderKey := neofscrypto.PublicKeyBytes(signer.Public())
+	objID := oidtest.ID()
+
+	newObject := func(hdrs ...string) *objectSDK.Object {
+		hh := make([]eacl.Header, 0, len(hdrs)/2)
+		for i := 0; i < len(hdrs); i += 2 {
+			hh = append(hh, checkHeader{hdrs[i], hdrs[i+1]})
+		}
+
+		obj, err := checkObjectFromHeaders(hh)
+		require.NoError(t, err)
+
+		return obj
+	}
+
+	newTable := func(rules ...string) *eacl.Table {
+		table := eacl.NewTable()
+		require.NoError(t, util.ParseEACLRules(table, rules))
+		return table
+	}
+
+	newBearer := func(exp uint64, rules ...string) *bearer.Token {
+		var tok bearer.Token
+		tok.SetExp(exp)
+		tok.SetEACLTable(*newTable(rules...))
+		require.NoError(t, tok.Sign(signer))
+		return &tok
+	}
+
+	var withBearer, noBearer acl.Basic
+	noBearer.AllowOp(acl.OpObjectGet, acl.RoleOthers)
+	withBearer.AllowOp(acl.OpObjectGet, acl.RoleOthers)
+	withBearer.AllowBearerRules(acl.OpObjectGet)
+
+	final := withBearer
+	final.DisableExtension()
+
+	var sticky acl.Basic
+	sticky.AllowOp(acl.OpObjectPut, acl.RoleOthers)
+	sticky.MakeSticky()
+
+	sender := signer.UserID()
+	other := usertest.ID(t)
+
+	epoch := uint64(100)
+
+	containerTable := newTable(
+		"deny get obj:a=b others",
+		"deny delete obj:a=b others",
+		"allow get others",
+		"deny get pubkey:"+hex.EncodeToString(senderKey),
+		"deny get others",
+	)
+
+	tests := []struct {
+		name    string
+		prm     checkPrm
+		allowed bool
+		record  int
+	}{
+		{
+			name: "matched by filter",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				objID: &objID, obj: newObject("a", "b"),
+			},
+			allowed: false,
+			record:  0,
+		},
+		{
+			name: "filter mismatch",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				objID: &objID, obj: newObject("a", "c"),
+			},
+			allowed: true,
+			record:  2,
+		},
+		{
+			name: "object headers are not checked for delete",
+			prm: checkPrm{
+				op: eacl.OperationDelete, role: acl.RoleOthers, table: containerTable,
+				objID: &objID, obj: newObject("a", "b"),
+			},
+			allowed: true,
+			record:  -1,
+		},
+		{
+			name: "public key target",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOwner, table: containerTable,
+				objID: &objID, senderKey: senderKey,
+			},
+			allowed: false,
+			record:  3,
+		},
+		{
+			name: "no matched record",
+			prm: checkPrm{
+				op: eacl.OperationPut, role: acl.RoleOthers, table: containerTable,
+			},
+			allowed: true,
+			record:  -1,
+		},
+		{
+			name: "denied by basic ACL",
+			prm: checkPrm{
+				op: eacl.OperationHead, role: acl.RoleOthers, table: containerTable,
+				objID: &objID, basicACL: &withBearer,
+			},
+			allowed: false,
+			record:  -1,
+		},
+		{
+			name: "final basic ACL",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				basicACL: &final, objID: &objID, obj: newObject("a", "b"),
+			},
+			allowed: true,
+			record:  -1,
+		},
+		{
+			name: "bearer overrides table",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				basicACL: &withBearer, bearer: newBearer(epoch, "allow get others"),
+				objID: &objID, obj: newObject("a", "b"), senderKey: senderKey,
+			},
+			allowed: true,
+			record:  0,
+		},
+		{
+			name: "bearer rules are forbidden by basic ACL",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				basicACL: &noBearer, bearer: newBearer(epoch, "allow get others"),
+				objID: &objID, obj: newObject("a", "b"),
+			},
+			allowed: false,
+			record:  0,
+		},
+		{
+			name: "expired bearer",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				objID: &objID, bearer: newBearer(epoch, "allow get others"), epoch: &[]uint64{epoch + 1}[0],
+			},
+			allowed: false,
+			record:  -1,
+		},
+		{
+			name: "unavailable object headers",
+			prm: checkPrm{
+				op: eacl.OperationGet, role: acl.RoleOthers, table: containerTable,
+				objID: &objID,
+			},
+			allowed: true,
+			record:  -1,
+		},
+		{
+			name: "well-known object header",
+			prm: checkPrm{
+				op: eacl.OperationPut, role: acl.RoleOthers,
+				table: newTable("deny put obj:$Object:objectType=LOCK others"),
+				obj:   newObject("$Object:objectType", "LOCK"),
+			},
+			allowed: false,
+			record:  0,
+		},
+		{
+			name: "request header",
+			prm: checkPrm{
+				op: eacl.OperationSearch, role: acl.RoleOthers,
+				table:      newTable("deny search req:a=b others"),
+				reqHeaders: []eacl.Header{checkHeader{"a", "b"}},
+			},
+			allowed: false,
+			record:  0,
+		},
+		{
+			name: "sticky bit, owner is the sender",
+			prm: checkPrm{
+				op: eacl.OperationPut, role: acl.RoleOthers, basicACL: &sticky,
+				obj: newObject("$Object:ownerID", sender.EncodeToString()), senderKey: senderKey,
+			},
+			allowed: true,
+			record:  -1,
+		},
+		{
+			name: "sticky bit, owner is not the sender",
+			prm: checkPrm{
+				op: eacl.OperationPut, role: acl.RoleOthers, basicACL: &sticky,
+				obj: newObject("$Object:ownerID", other.EncodeToString()), senderKey: senderKey,
+			},
+			allowed: false,
+			record:  -1,
+		},
+		{
+			name: "sticky bit, container node",
+			prm: checkPrm{
+				op: eacl.OperationPut, role: acl.RoleContainer, basicACL: &sticky,
+				obj: newObject("$Object:ownerID", other.EncodeToString()), senderKey: senderKey,
+			},
+			allowed: true,
+			record:  -1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := checkAccess(tc.prm)
+			require.NoError(t, err)
+			require.Equal(t, tc.allowed, res.allowed, res.reason)
+			require.Equal(t, tc.record, res.record, res.reason)
+		})
+	}
+}
+
+func TestRecordToString(t *testing.T) {
+	rules := []string{
+		"deny get obj:a=b req:c!=d others",
+		"allow put user system",
+		"deny getrange pubkey:036410abb260bbbda89f61c0cad65a4fa15ac5cb83b3c3abf8aee403856fcf65ed",
+	}
+
+	for _, rule := range rules {
+		table := eacl.NewTable()
+		require.NoError(t, util.ParseEACLRule(table, rule))
+		require.Equal(t, rule, recordToString(table.Records()[0]))
+	}
+}
+
+func TestCheckObjectFromHeaders(t *testing.T) {
+	owner := usertest.ID(t)
+	sum := objectSDK.CalculatePayloadChecksum([]byte("payload"))
+
+	obj, err := checkObjectFromHeaders([]eacl.Header{
+		checkHeader{"$Object:ownerID", owner.EncodeToString()},
+		checkHeader{"$Object:creationEpoch", "10"},
+		checkHeader{"$Object:payloadLength", "7"},
+		checkHeader{"$Object:objectType", "TOMBSTONE"},
+		checkHeader{"$Object:version", "v2.13"},
+		checkHeader{"$Object:payloadHash", sum.String()},
+		checkHeader{"FileName", "cat.jpg"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, &owner, obj.OwnerID())
+	require.EqualValues(t, 10, obj.CreationEpoch())
+	require.EqualValues(t, 7, obj.PayloadSize())
+	require.Equal(t, objectSDK.TypeTombstone, obj.Type())
+	require.Equal(t, "v2.13", obj.Version().String())
+
+	cs, ok := obj.PayloadChecksum()
+	require.True(t, ok)
+	require.Equal(t, sum, cs)
+
+	attrs := obj.Attributes()
+	require.Len(t, attrs, 1)
+	require.Equal(t, "FileName", attrs[0].Key())
+	require.Equal(t, "cat.jpg", attrs[0].Value())
+
+	for _, h := range []checkHeader{
+		{"$Object:containerID", "any"},
+		{"$Object:objectID", "any"},
+		{"$Object:unknown", "any"},
+		{"$Object:creationEpoch", "-1"},
+		{"$Object:objectType", "UNKNOWN"},
+		{"$Object:payloadHash", "SHA256:00"},
+	} {
+		_, err := checkObjectFromHeaders([]eacl.Header{h})
+		require.Error(t, err, h.key)
+	}
+}
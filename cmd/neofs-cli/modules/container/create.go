@@ -1,6 +1,7 @@
 package container
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -26,6 +28,7 @@ var (
 	containerAwait       bool
 	containerName        string
 	containerNoTimestamp bool
+	containerLifecycle   string
 	force                bool
 )
 
@@ -75,6 +78,11 @@ It will be stored in sidechain when inner ring will accepts it.`,
 		err = parseAttributes(&cnr, containerAttributes)
 		common.ExitOnErr(cmd, "", err)
 
+		if containerLifecycle != "" {
+			err = parseLifecycleRules(&cnr, containerLifecycle)
+			common.ExitOnErr(cmd, "", err)
+		}
+
 		var basicACL acl.Basic
 		common.ExitOnErr(cmd, "decode basic ACL string: %w", basicACL.DecodeString(containerACL))
 
@@ -159,6 +167,7 @@ func initContainerCreateCmd() {
 		"Increases default execution timeout to %.0fs", awaitTimeout.Seconds())) // simple %s notation prints 1m0s https://github.com/golang/go/issues/39064
 	flags.StringVar(&containerName, "name", "", "Container name attribute")
 	flags.BoolVar(&containerNoTimestamp, "disable-timestamp", false, "Disable timestamp container attribute")
+	flags.StringVar(&containerLifecycle, "lifecycle", "", "Path to JSON file with object lifecycle rules")
 	flags.BoolVarP(&force, commonflags.ForceFlag, commonflags.ForceFlagShorthand, false,
 		"Skip placement validity check")
 }
@@ -212,3 +221,24 @@ func parseAttributes(dst *container.Container, attributes []string) error {
 
 	return nil
 }
+
+func parseLifecycleRules(dst *container.Container, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("can't read file with lifecycle rules: %w", err)
+	}
+
+	rules, err := containercore.DecodeLifecycleRules(data)
+	if err != nil {
+		return fmt.Errorf("invalid lifecycle rules: %w", err)
+	}
+
+	data, err = json.Marshal(rules)
+	if err != nil {
+		return fmt.Errorf("encode lifecycle rules: %w", err)
+	}
+
+	dst.SetAttribute(containercore.AttributeLifecycle, string(data))
+
+	return nil
+}
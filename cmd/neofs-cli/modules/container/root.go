@@ -45,6 +45,10 @@ func init() {
 		commonflags.InitAPI(containerCommand)
 	}
 
+	// reads the sidechain, so API flags are not needed
+	Cmd.AddCommand(containerUsageCmd)
+	initContainerUsageCmd()
+
 	for _, el := range []struct {
 		cmd  *cobra.Command
 		verb string
@@ -0,0 +1,320 @@
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/morph"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usageEpochFlag = "epoch"
+	usageNodeFlag  = "node"
+)
+
+var containerUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Estimate storage usage of the container",
+	Long: `Estimate storage usage of the container by the sizes announced by the storage
+nodes in the Container contract. Physical size is a sum of the announced sizes,
+i.e. it includes all the replicas, logical size is the physical one divided by
+the number of replicas required by the container placement policy, i.e. it
+assumes that the container is replicated completely.
+Contracts are read from the sidechain RPC node set by --morph-rpc-endpoint.
+With --node flags, current usage reports (object number and size) are requested
+from the Control API of the listed storage nodes instead and summed up. The
+requests are signed by the wallet key which must be authorized by the nodes.
+Current usage of the single storage node is also shown by
+'neofs-cli control container-usage' command.`,
+	Args: cobra.NoArgs,
+	Run:  containerUsage,
+}
+
+func initContainerUsageCmd() {
+	morph.InitFlags(containerUsageCmd)
+
+	flags := containerUsageCmd.Flags()
+	flags.StringVar(&containerID, commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	flags.Uint64(usageEpochFlag, 0, "Epoch of the estimations (defaults to the last finished epoch)")
+	flags.StringSlice(usageNodeFlag, nil, "Control API endpoints of the storage nodes to request current usage from")
+	flags.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, commonflags.WalletPathDefault, commonflags.WalletPathUsage)
+	flags.StringP(commonflags.Account, commonflags.AccountShorthand, commonflags.AccountDefault, commonflags.AccountUsage)
+	flags.Bool(commonflags.JSON, false, "Print usage in JSON format")
+	flags.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+
+	_ = containerUsageCmd.MarkFlagRequired(morph.RPCFlag)
+	containerUsageCmd.MarkFlagsMutuallyExclusive(usageEpochFlag, usageNodeFlag)
+}
+
+// nodeUsage is a container storage usage announced or reported by the
+// storage node.
+type nodeUsage struct {
+	key  []byte
+	size uint64
+	// set for live reports only
+	objects uint64
+}
+
+// containerUsageInfo groups storage usage of the container by nodes.
+type containerUsageInfo struct {
+	container cid.ID
+	replicas  uint32
+
+	// live is set when usage is reported by the storage nodes at the moment,
+	// otherwise nodes are estimations for the epoch
+	live  bool
+	epoch uint64
+
+	nodes []nodeUsage
+}
+
+// containerUsageTotals groups container storage usage summed up over nodes.
+// Physical values include all the replicas.
+type containerUsageTotals struct {
+	physicalSize, logicalSize       uint64
+	physicalObjects, logicalObjects uint64
+}
+
+func containerUsage(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	info := containerUsageInfo{
+		container: parseContainerID(cmd),
+	}
+
+	cli := morph.GetClient(ctx, cmd)
+	defer cli.Close()
+
+	cnrCli := morph.GetContainerClient(cmd, cli)
+
+	cnr, err := cntClient.Get(cnrCli, info.container)
+	common.ExitOnErr(cmd, "can't get container: %w", err)
+
+	policy := cnr.Value.PlacementPolicy()
+	for i := 0; i < policy.NumberOfReplicas(); i++ {
+		info.replicas += policy.ReplicaNumberByIndex(i)
+	}
+
+	if endpoints, _ := cmd.Flags().GetStringSlice(usageNodeFlag); len(endpoints) != 0 {
+		info.live = true
+
+		pk := key.Get(cmd)
+
+		for i := range endpoints {
+			report, err := requestNodeUsage(ctx, pk, endpoints[i], info.container)
+			common.ExitOnErr(cmd, "can't get container usage report: %w", err)
+
+			info.nodes = append(info.nodes, nodeUsage{
+				key:     report.GetPublicKey(),
+				size:    report.GetSize(),
+				objects: report.GetObjects(),
+			})
+		}
+	} else {
+		info.epoch, _ = cmd.Flags().GetUint64(usageEpochFlag)
+		if !cmd.Flags().Changed(usageEpochFlag) {
+			info.epoch, err = morph.GetNetmapClient(cmd, cli).Epoch()
+			common.ExitOnErr(cmd, "can't get current epoch: %w", err)
+
+			if info.epoch == 0 {
+				common.ExitOnErr(cmd, "", errors.New("there are no finished epochs"))
+			}
+
+			info.epoch--
+		}
+
+		estimations, err := cnrCli.ListLoadEstimationsByEpoch(info.epoch)
+		common.ExitOnErr(cmd, "can't list container size estimations: %w", err)
+
+		if e, ok := estimations[info.container]; ok {
+			for i := range e.Values {
+				info.nodes = append(info.nodes, nodeUsage{
+					key:  e.Values[i].Reporter,
+					size: e.Values[i].Size,
+				})
+			}
+		}
+	}
+
+	if isJSON, _ := cmd.Flags().GetBool(commonflags.JSON); isJSON {
+		prettyPrintContainerUsageJSON(cmd, info)
+	} else {
+		prettyPrintContainerUsage(cmd, info)
+	}
+}
+
+// requestNodeUsage requests current container usage from the Control API of
+// the storage node.
+func requestNodeUsage(ctx context.Context, pk *ecdsa.PrivateKey, endpoint string, cnr cid.ID) (*control.ContainerUsageReport, error) {
+	var addr network.Address
+
+	err := addr.FromString(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node endpoint %s: %w", endpoint, err)
+	}
+
+	cli, err := internalclient.GetSDKClient(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", endpoint, err)
+	}
+
+	defer func() { _ = cli.Close() }()
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := &control.GetContainerUsageRequest{
+		Body: &control.GetContainerUsageRequest_Body{
+			ContainerId: rawCID,
+		},
+	}
+
+	err = controlSvc.SignMessage(pk, req)
+	if err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	var resp *control.GetContainerUsageResponse
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetContainerUsage(client, req)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rpc error from %s: %w", endpoint, err)
+	}
+
+	err = controlSvc.VerifyMessage(resp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response from %s: %w", endpoint, err)
+	}
+
+	report := resp.GetBody().GetReport()
+	if !bytes.Equal(report.GetPublicKey(), resp.GetSignature().GetKey()) {
+		return nil, fmt.Errorf("report from %s is signed by another node", endpoint)
+	}
+
+	return report, nil
+}
+
+// totals sums up the container usage over nodes. Logical values are the
+// physical ones divided by the number of replicas.
+func (x containerUsageInfo) totals() containerUsageTotals {
+	var res containerUsageTotals
+
+	for i := range x.nodes {
+		res.physicalSize += x.nodes[i].size
+		res.physicalObjects += x.nodes[i].objects
+	}
+
+	res.logicalSize, res.logicalObjects = res.physicalSize, res.physicalObjects
+	if x.replicas > 0 {
+		res.logicalSize /= uint64(x.replicas)
+		res.logicalObjects /= uint64(x.replicas)
+	}
+
+	return res
+}
+
+func prettyPrintContainerUsageJSON(cmd *cobra.Command, info containerUsageInfo) {
+	totals := info.totals()
+
+	nodes := make([]map[string]any, 0, len(info.nodes))
+	for i := range info.nodes {
+		node := map[string]any{
+			"node": hex.EncodeToString(info.nodes[i].key),
+			"size": info.nodes[i].size,
+		}
+
+		if info.live {
+			node["objects"] = info.nodes[i].objects
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	res := map[string]any{
+		"container":     info.container.EncodeToString(),
+		"replicas":      info.replicas,
+		"physical_size": totals.physicalSize,
+		"logical_size":  totals.logicalSize,
+	}
+
+	if info.live {
+		res["physical_objects"] = totals.physicalObjects
+		res["logical_objects"] = totals.logicalObjects
+		res["reports"] = nodes
+	} else {
+		res["epoch"] = info.epoch
+		res["estimations"] = nodes
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode usage to JSON: %w", enc.Encode(res))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintContainerUsage(cmd *cobra.Command, info containerUsageInfo) {
+	totals := info.totals()
+
+	var sb strings.Builder
+
+	_, _ = fmt.Fprintf(&sb, "Container: %s\n", info.container)
+	if !info.live {
+		_, _ = fmt.Fprintf(&sb, "Epoch: %d\n", info.epoch)
+	}
+	_, _ = fmt.Fprintf(&sb, "Replicas: %d\n", info.replicas)
+	if info.live {
+		_, _ = fmt.Fprintf(&sb, "Physical objects: %d\n", totals.physicalObjects)
+		_, _ = fmt.Fprintf(&sb, "Logical objects: %d\n", totals.logicalObjects)
+	}
+	_, _ = fmt.Fprintf(&sb, "Physical size: %d\n", totals.physicalSize)
+	_, _ = fmt.Fprintf(&sb, "Logical size: %d\n", totals.logicalSize)
+
+	switch {
+	case len(info.nodes) == 0:
+		_, _ = fmt.Fprintln(&sb, "No estimations announced for the epoch.")
+	case info.live:
+		tw := tabwriter.NewWriter(&sb, 0, 2, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "NODE\tOBJECTS\tSIZE")
+
+		for i := range info.nodes {
+			_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\n", hex.EncodeToString(info.nodes[i].key), info.nodes[i].objects, info.nodes[i].size)
+		}
+
+		_ = tw.Flush()
+	default:
+		tw := tabwriter.NewWriter(&sb, 0, 2, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "NODE\tSIZE")
+
+		for i := range info.nodes {
+			_, _ = fmt.Fprintf(tw, "%s\t%d\n", hex.EncodeToString(info.nodes[i].key), info.nodes[i].size)
+		}
+
+		_ = tw.Flush()
+	}
+
+	cmd.Print(sb.String())
+}
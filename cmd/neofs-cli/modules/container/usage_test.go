@@ -0,0 +1,124 @@
+package container
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestContainerUsageInfo_Totals(t *testing.T) {
+	info := containerUsageInfo{
+		replicas: 3,
+		nodes: []nodeUsage{
+			{size: 300, objects: 3},
+			{size: 200, objects: 2},
+			{size: 400, objects: 4},
+		},
+	}
+
+	require.Equal(t, containerUsageTotals{
+		physicalSize:    900,
+		logicalSize:     300,
+		physicalObjects: 9,
+		logicalObjects:  3,
+	}, info.totals())
+
+	// no placement policy replicas
+	info.replicas = 0
+
+	require.Equal(t, containerUsageTotals{
+		physicalSize:    900,
+		logicalSize:     900,
+		physicalObjects: 9,
+		logicalObjects:  9,
+	}, info.totals())
+}
+
+// testControlServer responds to GetContainerUsage requests with the report of
+// the reporter signed by the signer.
+type testControlServer struct {
+	control.UnimplementedControlServiceServer
+
+	signer, reporter *ecdsa.PrivateKey
+	objects, size    uint64
+}
+
+func (x *testControlServer) GetContainerUsage(_ context.Context, req *control.GetContainerUsageRequest) (*control.GetContainerUsageResponse, error) {
+	if err := controlSvc.VerifyMessage(req); err != nil {
+		return nil, err
+	}
+
+	resp := &control.GetContainerUsageResponse{
+		Body: &control.GetContainerUsageResponse_Body{
+			Report: &control.ContainerUsageReport{
+				PublicKey: (*keys.PublicKey)(&x.reporter.PublicKey).Bytes(),
+				Objects:   x.objects,
+				Size:      x.size,
+			},
+		},
+	}
+
+	return resp, controlSvc.SignMessage(x.signer, resp)
+}
+
+func serveTestControl(t *testing.T, srv *testControlServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	control.RegisterControlServiceServer(s, srv)
+
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRequestNodeUsage(t *testing.T) {
+	userKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	nodeKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	cnr := cidtest.ID()
+
+	t.Run("valid", func(t *testing.T) {
+		endpoint := serveTestControl(t, &testControlServer{
+			signer:   &nodeKey.PrivateKey,
+			reporter: &nodeKey.PrivateKey,
+			objects:  10,
+			size:     1024,
+		})
+
+		report, err := requestNodeUsage(context.Background(), &userKey.PrivateKey, endpoint, cnr)
+		require.NoError(t, err)
+		require.Equal(t, nodeKey.PublicKey().Bytes(), report.GetPublicKey())
+		require.EqualValues(t, 10, report.GetObjects())
+		require.EqualValues(t, 1024, report.GetSize())
+	})
+
+	t.Run("report of another node", func(t *testing.T) {
+		endpoint := serveTestControl(t, &testControlServer{
+			signer:   &nodeKey.PrivateKey,
+			reporter: &otherKey.PrivateKey,
+		})
+
+		_, err := requestNodeUsage(context.Background(), &userKey.PrivateKey, endpoint, cnr)
+		require.ErrorContains(t, err, "signed by another node")
+	})
+
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := requestNodeUsage(context.Background(), &userKey.PrivateKey, "not an endpoint", cnr)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package control
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var containerUsageCmd = &cobra.Command{
+	Use:   "container-usage",
+	Short: "Show current usage of the container storage on the storage node",
+	Long: `Show number of the available container objects stored on the storage node
+and their size. Only regular objects are counted, objects split into parts are
+counted once.`,
+	Args: cobra.NoArgs,
+	Run:  containerUsage,
+}
+
+func initContainerUsageCmd() {
+	initControlFlags(containerUsageCmd)
+
+	flags := containerUsageCmd.Flags()
+	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	flags.Bool(commonflags.JSON, false, "Print usage in JSON format")
+
+	_ = containerUsageCmd.MarkFlagRequired(commonflags.CIDFlag)
+}
+
+func containerUsage(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	cidStr, _ := cmd.Flags().GetString(commonflags.CIDFlag)
+
+	var cnr cid.ID
+	err := cnr.DecodeString(cidStr)
+	common.ExitOnErr(cmd, "can't decode container ID value: %w", err)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := &control.GetContainerUsageRequest{
+		Body: &control.GetContainerUsageRequest_Body{
+			ContainerId: rawCID,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(ctx, cmd)
+
+	var resp *control.GetContainerUsageResponse
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.GetContainerUsage(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	report := resp.GetBody().GetReport()
+
+	if isJSON, _ := cmd.Flags().GetBool(commonflags.JSON); isJSON {
+		buf := bytes.NewBuffer(nil)
+		enc := json.NewEncoder(buf)
+		enc.SetIndent("", "  ")
+		common.ExitOnErr(cmd, "cannot encode usage to JSON: %w", enc.Encode(map[string]any{
+			"container": cnr.EncodeToString(),
+			"node":      hex.EncodeToString(report.GetPublicKey()),
+			"objects":   report.GetObjects(),
+			"size":      report.GetSize(),
+		}))
+
+		cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+		return
+	}
+
+	cmd.Printf("Container: %s\n", cnr)
+	cmd.Printf("Node: %s\n", hex.EncodeToString(report.GetPublicKey()))
+	cmd.Printf("Objects: %d\n", report.GetObjects())
+	cmd.Printf("Size: %d\n", report.GetSize())
+}
@@ -0,0 +1,141 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var decommissionCmd = &cobra.Command{
+	Use:   "decommission",
+	Short: "Decommission of the storage node",
+	Long: `Decommission of the storage node.
+Node being decommissioned keeps serving reads but does not store new objects.
+It replicates local objects to other container nodes lacking them. When the
+status reports completion, no local object lacks replicas on other container
+nodes, and the node can be switched off.`,
+}
+
+var decommissionStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start decommission of the storage node",
+	Long:  "Start decommission of the storage node",
+	Args:  cobra.NoArgs,
+	Run:   startDecommission,
+}
+
+var decommissionStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop decommission and return the storage node to normal operation",
+	Long:  "Stop decommission and return the storage node to normal operation",
+	Args:  cobra.NoArgs,
+	Run:   stopDecommission,
+}
+
+var decommissionStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get decommission progress of the storage node",
+	Long:  "Get decommission progress of the storage node",
+	Args:  cobra.NoArgs,
+	Run:   decommissionStatus,
+}
+
+func startDecommission(cmd *cobra.Command, _ []string) {
+	setDecommission(cmd, true)
+	cmd.Println("Decommission has been started.")
+}
+
+func stopDecommission(cmd *cobra.Command, _ []string) {
+	setDecommission(cmd, false)
+	cmd.Println("Decommission has been stopped.")
+}
+
+func setDecommission(cmd *cobra.Command, enabled bool) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	req := &control.SetDecommissionRequest{
+		Body: &control.SetDecommissionRequest_Body{
+			Enabled: enabled,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(ctx, cmd)
+
+	var resp *control.SetDecommissionResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.SetDecommission(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+}
+
+func decommissionStatus(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	req := &control.GetDecommissionStatusRequest{
+		Body: new(control.GetDecommissionStatusRequest_Body),
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(ctx, cmd)
+
+	var resp *control.GetDecommissionStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetDecommissionStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	st := resp.GetBody().GetStatus()
+
+	if !st.GetEnabled() {
+		cmd.Println("Node is not being decommissioned.")
+		return
+	}
+
+	if st.GetPasses() == 0 {
+		cmd.Println("Decommission is in progress, the first pass is not finished yet.")
+	} else {
+		cmd.Printf("Finished passes: %d\n", st.GetPasses())
+		cmd.Println("Last pass:")
+		cmd.Printf("\tObjects: %d\n", st.GetObjects())
+		cmd.Printf("\tReplicated: %d\n", st.GetReplicated())
+		cmd.Printf("\tLacking replicas: %d\n", st.GetFailed())
+	}
+
+	cmd.Printf("Objects processed in the current pass: %d\n", st.GetProcessed())
+
+	if st.GetCompleted() {
+		cmd.Println("Decommission is completed, the node can be switched off.")
+	} else {
+		cmd.Println("Decommission is not completed, the node must not be switched off yet.")
+	}
+}
+
+func initControlDecommissionCmd() {
+	decommissionCmd.AddCommand(decommissionStartCmd)
+	decommissionCmd.AddCommand(decommissionStopCmd)
+	decommissionCmd.AddCommand(decommissionStatusCmd)
+
+	initControlFlags(decommissionStartCmd)
+	initControlFlags(decommissionStopCmd)
+	initControlFlags(decommissionStatusCmd)
+}
@@ -0,0 +1,126 @@
+package control
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const listTrustsEpochFlag = "epoch"
+
+var listTrustsCmd = &cobra.Command{
+	Use:   "trusts",
+	Short: "List local and global trusts of the storage node to its peers",
+	Long: `List local trusts of the storage node to its peers together with the scores
+of the local trust sources they are calculated from and global trusts of the
+peers calculated by the reputation managers.`,
+	Args: cobra.NoArgs,
+	Run:  listTrusts,
+}
+
+func initControlListTrustsCmd() {
+	initControlFlags(listTrustsCmd)
+
+	flags := listTrustsCmd.Flags()
+	flags.Uint64(listTrustsEpochFlag, 0, "Epoch of the trusts (defaults to the last finished epoch)")
+	flags.Bool(commonflags.JSON, false, "Print trusts as a JSON array")
+}
+
+func listTrusts(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	epoch, _ := cmd.Flags().GetUint64(listTrustsEpochFlag)
+
+	req := &control.ListTrustsRequest{
+		Body: &control.ListTrustsRequest_Body{
+			Epoch: epoch,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(ctx, cmd)
+
+	var resp *control.ListTrustsResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ListTrusts(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if isJSON, _ := cmd.Flags().GetBool(commonflags.JSON); isJSON {
+		prettyPrintTrustsJSON(cmd, resp.GetBody())
+	} else {
+		prettyPrintTrusts(cmd, resp.GetBody())
+	}
+}
+
+func prettyPrintTrustsJSON(cmd *cobra.Command, body *control.ListTrustsResponse_Body) {
+	trusts := make([]map[string]any, 0, len(body.GetTrusts()))
+	for _, t := range body.GetTrusts() {
+		scores := make(map[string]float64, len(t.GetScores()))
+		for _, s := range t.GetScores() {
+			scores[s.GetSource()] = s.GetValue()
+		}
+
+		trusts = append(trusts, map[string]any{
+			"peer":         hex.EncodeToString(t.GetPublicKey()),
+			"local_trust":  t.GetLocalTrust(),
+			"scores":       scores,
+			"global_trust": t.GetGlobalTrust(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode trusts to JSON: %w", enc.Encode(map[string]any{
+		"epoch":  body.GetEpoch(),
+		"trusts": trusts,
+	}))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintTrusts(cmd *cobra.Command, body *control.ListTrustsResponse_Body) {
+	var sb strings.Builder
+
+	_, _ = fmt.Fprintf(&sb, "Epoch: %d\n", body.GetEpoch())
+
+	tw := tabwriter.NewWriter(&sb, 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "PEER\tLOCAL\tGLOBAL\tSCORES")
+
+	for _, t := range body.GetTrusts() {
+		scores := make([]string, 0, len(t.GetScores()))
+		for _, s := range t.GetScores() {
+			scores = append(scores, fmt.Sprintf("%s=%g", s.GetSource(), s.GetValue()))
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%g\t%g\t%s\n",
+			hex.EncodeToString(t.GetPublicKey()),
+			t.GetLocalTrust(),
+			t.GetGlobalTrust(),
+			strings.Join(scores, " "),
+		)
+	}
+
+	_ = tw.Flush()
+
+	cmd.Print(sb.String())
+}
@@ -7,23 +7,38 @@ import (
 )
 
 var Cmd = &cobra.Command{
-	Use:   "control",
-	Short: "Operations with storage node",
-	Long:  `Operations with storage node`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		ff := cmd.Flags()
-
-		_ = viper.BindPFlag(commonflags.WalletPath, ff.Lookup(commonflags.WalletPath))
-		_ = viper.BindPFlag(commonflags.Account, ff.Lookup(commonflags.Account))
-		_ = viper.BindPFlag(controlRPC, ff.Lookup(controlRPC))
-		_ = viper.BindPFlag(commonflags.Timeout, ff.Lookup(commonflags.Timeout))
-	},
+	Use:              "control",
+	Short:            "Operations with storage node",
+	Long:             `Operations with storage node`,
+	PersistentPreRun: bindControlFlags,
+}
+
+// bindControlFlags binds flags initialized by initControlFlags to the viper.
+func bindControlFlags(cmd *cobra.Command, _ []string) {
+	ff := cmd.Flags()
+
+	_ = viper.BindPFlag(commonflags.WalletPath, ff.Lookup(commonflags.WalletPath))
+	_ = viper.BindPFlag(commonflags.Account, ff.Lookup(commonflags.Account))
+	_ = viper.BindPFlag(controlRPC, ff.Lookup(controlRPC))
+	_ = viper.BindPFlag(controlTLSCert, ff.Lookup(controlTLSCert))
+	_ = viper.BindPFlag(controlTLSKey, ff.Lookup(controlTLSKey))
+	_ = viper.BindPFlag(controlTLSCA, ff.Lookup(controlTLSCA))
+	_ = viper.BindPFlag(commonflags.Timeout, ff.Lookup(commonflags.Timeout))
 }
 
 const (
 	controlRPC        = "endpoint"
 	controlRPCDefault = ""
 	controlRPCUsage   = "Remote node control address (as 'multiaddr' or '<host>:<port>')"
+
+	controlTLSCert      = "tls-cert"
+	controlTLSCertUsage = "Path to the client TLS certificate to authenticate with at the TLS control endpoint"
+
+	controlTLSKey      = "tls-key"
+	controlTLSKeyUsage = "Path to the client TLS key"
+
+	controlTLSCA      = "tls-ca"
+	controlTLSCAUsage = "Path to CA certificate(s) to verify the TLS control endpoint with instead of the system ones"
 )
 
 func init() {
@@ -33,6 +48,10 @@ func init() {
 		dropObjectsCmd,
 		shardsCmd,
 		synchronizeTreeCmd,
+		settlementReportCmd,
+		listTrustsCmd,
+		decommissionCmd,
+		containerUsageCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -40,4 +59,8 @@ func init() {
 	initControlDropObjectsCmd()
 	initControlShardsCmd()
 	initControlSynchronizeTreeCmd()
+	initControlSettlementReportCmd()
+	initControlListTrustsCmd()
+	initControlDecommissionCmd()
+	initContainerUsageCmd()
 }
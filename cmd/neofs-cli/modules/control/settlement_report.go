@@ -0,0 +1,138 @@
+package control
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mr-tron/base58"
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	ircontrol "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"github.com/spf13/cobra"
+)
+
+const settlementReportEpochFlag = "epoch"
+
+var settlementReportCmd = &cobra.Command{
+	Use:   "settlement-report",
+	Short: "Calculate settlement payments of the epoch on the Inner Ring node",
+	Long: `Calculate basic income and data audit payments for the data stored during
+the given epoch on the Inner Ring node without transferring any assets.
+All amounts and prices are in GASe-12.`,
+	Args: cobra.NoArgs,
+	Run:  settlementReport,
+}
+
+func initControlSettlementReportCmd() {
+	initControlFlags(settlementReportCmd)
+
+	flags := settlementReportCmd.Flags()
+	flags.Uint64(settlementReportEpochFlag, 0, "Epoch to calculate payments for")
+	flags.Bool(commonflags.JSON, false, "Print payments as a JSON array")
+
+	_ = settlementReportCmd.MarkFlagRequired(settlementReportEpochFlag)
+}
+
+func settlementReport(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.Get(cmd)
+
+	epoch, _ := cmd.Flags().GetUint64(settlementReportEpochFlag)
+
+	req := &ircontrol.SettlementReportRequest{
+		Body: &ircontrol.SettlementReportRequest_Body{
+			Epoch: epoch,
+		},
+	}
+
+	err := ircontrolsrv.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	cli := getClient(ctx, cmd)
+
+	var resp *ircontrol.SettlementReportResponse
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = ircontrol.SettlementReport(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if isJSON, _ := cmd.Flags().GetBool(commonflags.JSON); isJSON {
+		prettyPrintPaymentsJSON(cmd, resp.GetBody().GetPayments())
+	} else {
+		prettyPrintPayments(cmd, resp.GetBody().GetPayments())
+	}
+}
+
+func settlementTypeToString(t ircontrol.SettlementType) string {
+	switch t {
+	case ircontrol.SettlementType_BASIC_INCOME_COLLECTION:
+		return "basic-income-collection"
+	case ircontrol.SettlementType_BASIC_INCOME_DISTRIBUTION:
+		return "basic-income-distribution"
+	case ircontrol.SettlementType_AUDIT_REWARD:
+		return "audit-reward"
+	case ircontrol.SettlementType_AUDIT_FEE:
+		return "audit-fee"
+	default:
+		return "unknown"
+	}
+}
+
+func prettyPrintPaymentsJSON(cmd *cobra.Command, pp []*ircontrol.SettlementPayment) {
+	out := make([]map[string]any, 0, len(pp))
+	for _, p := range pp {
+		out = append(out, map[string]any{
+			"type":      settlementTypeToString(p.GetType()),
+			"container": base58.Encode(p.GetContainerId()),
+			"node":      hex.EncodeToString(p.GetNodeKey()),
+			"from":      base58.Encode(p.GetFrom()),
+			"to":        base58.Encode(p.GetTo()),
+			"size":      p.GetSize(),
+			"price":     p.GetPrice(),
+			"amount":    p.GetAmount(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode payments to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintPayments(cmd *cobra.Command, pp []*ircontrol.SettlementPayment) {
+	var sb strings.Builder
+
+	tw := tabwriter.NewWriter(&sb, 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TYPE\tCONTAINER\tNODE\tFROM\tTO\tSIZE\tPRICE\tAMOUNT")
+
+	for _, p := range pp {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\n",
+			settlementTypeToString(p.GetType()),
+			base58.Encode(p.GetContainerId()),
+			hex.EncodeToString(p.GetNodeKey()),
+			base58.Encode(p.GetFrom()),
+			base58.Encode(p.GetTo()),
+			p.GetSize(),
+			p.GetPrice(),
+			p.GetAmount(),
+		)
+	}
+
+	_ = tw.Flush()
+
+	cmd.Print(sb.String())
+}
@@ -3,16 +3,20 @@ package control
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/tls"
 	"errors"
+	"fmt"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tlscert"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func initControlFlags(cmd *cobra.Command) {
@@ -20,6 +24,9 @@ func initControlFlags(cmd *cobra.Command) {
 	ff.StringP(commonflags.WalletPath, commonflags.WalletPathShorthand, commonflags.WalletPathDefault, commonflags.WalletPathUsage)
 	ff.StringP(commonflags.Account, commonflags.AccountShorthand, commonflags.AccountDefault, commonflags.AccountUsage)
 	ff.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	ff.String(controlTLSCert, "", controlTLSCertUsage)
+	ff.String(controlTLSKey, "", controlTLSKeyUsage)
+	ff.String(controlTLSCA, "", controlTLSCAUsage)
 	ff.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
 }
 
@@ -52,5 +59,45 @@ func verifyResponse(cmd *cobra.Command,
 }
 
 func getClient(ctx context.Context, cmd *cobra.Command) *client.Client {
-	return internalclient.GetSDKClientByFlag(ctx, cmd, controlRPC)
+	tlsConfig, err := getTLSConfig()
+	common.ExitOnErr(cmd, "invalid TLS configuration: %w", err)
+
+	return internalclient.GetSDKClientByFlagWithTLS(ctx, cmd, controlRPC, tlsConfig)
+}
+
+// getTLSConfig returns TLS configuration for the control endpoint set by the
+// flags. Returns nil if none of them is set.
+func getTLSConfig() (*tls.Config, error) {
+	certFile, keyFile, caFile := viper.GetString(controlTLSCert), viper.GetString(controlTLSKey), viper.GetString(controlTLSCA)
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return nil, nil
+	}
+
+	res := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("both --%s and --%s must be set", controlTLSCert, controlTLSKey)
+		}
+
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client certificate: %w", err)
+		}
+
+		res.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile != "" {
+		var err error
+
+		res.RootCAs, err = tlscert.ReadCertPool(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA certificates: %w", err)
+		}
+	}
+
+	return res, nil
 }
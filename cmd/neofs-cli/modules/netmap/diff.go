@@ -0,0 +1,234 @@
+package netmap
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-node/cmd/internal/cmdprinter"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/morph"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/spf13/cobra"
+)
+
+const (
+	diffFromFlag = "from"
+	diffToFlag   = "to"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show changes of the network map between two epochs",
+	Long: `Show storage nodes joined and left the network map between two epochs and
+changes of the state, network endpoints and attributes of the remaining ones.
+Network maps are read from the Netmap contract through the sidechain RPC node
+set by --morph-rpc-endpoint.`,
+	Args: cobra.NoArgs,
+	Run:  diffNetmapsCmd,
+}
+
+func initDiffCmd() {
+	morph.InitFlags(diffCmd)
+
+	ff := diffCmd.Flags()
+	ff.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+	ff.Uint64(diffFromFlag, 0, "Epoch of the original network map")
+	ff.Uint64(diffToFlag, 0, "Epoch of the changed network map (defaults to the current epoch)")
+
+	_ = diffCmd.MarkFlagRequired(diffFromFlag)
+	_ = diffCmd.MarkFlagRequired(morph.RPCFlag)
+}
+
+func diffNetmapsCmd(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	from, _ := cmd.Flags().GetUint64(diffFromFlag)
+	to, _ := cmd.Flags().GetUint64(diffToFlag)
+
+	if from == 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("--%s must be positive", diffFromFlag))
+	}
+
+	cli := morph.GetClient(ctx, cmd)
+	defer cli.Close()
+
+	nmCli := morph.GetNetmapClient(cmd, cli)
+
+	nmFrom := getNetmapByEpoch(cmd, nmCli, from)
+	nmTo := getNetmapByEpoch(cmd, nmCli, to)
+
+	d := diffNetmaps(nmFrom, nmTo)
+
+	cmd.Printf("Epochs: %d -> %d\n", nmFrom.Epoch(), nmTo.Epoch())
+
+	if len(d.joined)+len(d.left)+len(d.changed) == 0 {
+		cmd.Println("No changes.")
+		return
+	}
+
+	if len(d.joined) > 0 {
+		cmd.Println("Joined nodes:")
+		for i := range d.joined {
+			cmdprinter.PrettyPrintNodeInfo(cmd, d.joined[i], i, "\t", false)
+		}
+	}
+
+	if len(d.left) > 0 {
+		cmd.Println("Left nodes:")
+		for i := range d.left {
+			cmdprinter.PrettyPrintNodeInfo(cmd, d.left[i], i, "\t", false)
+		}
+	}
+
+	if len(d.changed) > 0 {
+		cmd.Println("Changed nodes:")
+		for i := range d.changed {
+			cmd.Printf("\tNode %d: %s\n", i+1, d.changed[i].key)
+			for _, c := range d.changed[i].changes {
+				cmd.Printf("\t\t%s\n", c)
+			}
+		}
+	}
+}
+
+// netmapDiff groups differences between two network maps.
+type netmapDiff struct {
+	// nodes present only in the changed network map
+	joined []netmap.NodeInfo
+	// nodes present only in the original network map
+	left []netmap.NodeInfo
+	// nodes present in both network maps with different parameters
+	changed []nodeDiff
+}
+
+// nodeDiff describes changes of the storage node.
+type nodeDiff struct {
+	// hex-encoded public key of the node
+	key string
+	// human-readable descriptions of the changes
+	changes []string
+}
+
+// diffNetmaps compares network maps by the public keys of the storage nodes.
+// Nodes in the result keep order of the network maps they are taken from.
+func diffNetmaps(from, to netmap.NetMap) netmapDiff {
+	var res netmapDiff
+
+	fromNodes := from.Nodes()
+	toNodes := to.Nodes()
+
+	fromIndex := make(map[string]int, len(fromNodes))
+	for i := range fromNodes {
+		fromIndex[string(fromNodes[i].PublicKey())] = i
+	}
+
+	toIndex := make(map[string]int, len(toNodes))
+	for i := range toNodes {
+		toIndex[string(toNodes[i].PublicKey())] = i
+	}
+
+	for i := range fromNodes {
+		if _, ok := toIndex[string(fromNodes[i].PublicKey())]; !ok {
+			res.left = append(res.left, fromNodes[i])
+		}
+	}
+
+	for i := range toNodes {
+		j, ok := fromIndex[string(toNodes[i].PublicKey())]
+		if !ok {
+			res.joined = append(res.joined, toNodes[i])
+			continue
+		}
+
+		if changes := nodeChanges(fromNodes[j], toNodes[i]); len(changes) > 0 {
+			res.changed = append(res.changed, nodeDiff{
+				key:     hex.EncodeToString(toNodes[i].PublicKey()),
+				changes: changes,
+			})
+		}
+	}
+
+	return res
+}
+
+// nodeChanges returns descriptions of the changed state, network endpoints
+// and attributes of the storage node.
+func nodeChanges(from, to netmap.NodeInfo) []string {
+	var res []string
+
+	if stFrom, stTo := nodeState(from), nodeState(to); stFrom != stTo {
+		res = append(res, fmt.Sprintf("state: %s -> %s", stFrom, stTo))
+	}
+
+	if epFrom, epTo := nodeEndpoints(from), nodeEndpoints(to); epFrom != epTo {
+		res = append(res, fmt.Sprintf("endpoints: %s -> %s", epFrom, epTo))
+	}
+
+	attrsFrom := nodeAttributes(from)
+	attrsTo := nodeAttributes(to)
+
+	keys := make([]string, 0, len(attrsFrom)+len(attrsTo))
+	for k := range attrsFrom {
+		keys = append(keys, k)
+	}
+	for k := range attrsTo {
+		if _, ok := attrsFrom[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		vFrom, okFrom := attrsFrom[k]
+		vTo, okTo := attrsTo[k]
+
+		switch {
+		case !okFrom:
+			res = append(res, fmt.Sprintf("+%s: %s", k, vTo))
+		case !okTo:
+			res = append(res, fmt.Sprintf("-%s: %s", k, vFrom))
+		case vFrom != vTo:
+			res = append(res, fmt.Sprintf("%s: %s -> %s", k, vFrom, vTo))
+		}
+	}
+
+	return res
+}
+
+func nodeState(node netmap.NodeInfo) string {
+	switch {
+	case node.IsOnline():
+		return "ONLINE"
+	case node.IsOffline():
+		return "OFFLINE"
+	case node.IsMaintenance():
+		return "MAINTENANCE"
+	default:
+		return "STATE_UNSUPPORTED"
+	}
+}
+
+func nodeEndpoints(node netmap.NodeInfo) string {
+	var endpoints []string
+
+	netmap.IterateNetworkEndpoints(node, func(endpoint string) {
+		endpoints = append(endpoints, endpoint)
+	})
+
+	return strings.Join(endpoints, " ")
+}
+
+func nodeAttributes(node netmap.NodeInfo) map[string]string {
+	res := make(map[string]string, node.NumberOfAttributes())
+
+	node.IterateAttributes(func(key, value string) {
+		res[key] = value
+	})
+
+	return res
+}
@@ -0,0 +1,54 @@
+package netmap
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffNetmaps(t *testing.T) {
+	node := func(key byte, endpoint string, attrs ...string) netmap.NodeInfo {
+		var n netmap.NodeInfo
+		n.SetPublicKey([]byte{key})
+		n.SetNetworkEndpoints(endpoint)
+		n.SetOnline()
+		for i := 0; i < len(attrs); i += 2 {
+			n.SetAttribute(attrs[i], attrs[i+1])
+		}
+		return n
+	}
+
+	stable := node(1, "/dns4/s1/tcp/8080", "Country", "Russia")
+	left := node(2, "/dns4/s2/tcp/8080")
+	joined := node(3, "/dns4/s3/tcp/8080")
+
+	changedFrom := node(4, "/dns4/s4/tcp/8080", "Country", "Russia", "Price", "10", "Old", "value")
+	changedTo := node(4, "/dns4/s4/tcp/8081", "Country", "Russia", "Price", "20", "New", "value")
+	changedTo.SetMaintenance()
+
+	var from, to netmap.NetMap
+	from.SetNodes([]netmap.NodeInfo{stable, left, changedFrom})
+	to.SetNodes([]netmap.NodeInfo{changedTo, joined, stable})
+
+	d := diffNetmaps(from, to)
+
+	require.Equal(t, []netmap.NodeInfo{joined}, d.joined)
+	require.Equal(t, []netmap.NodeInfo{left}, d.left)
+	require.Equal(t, []nodeDiff{{
+		key: hex.EncodeToString([]byte{4}),
+		changes: []string{
+			"state: ONLINE -> MAINTENANCE",
+			"endpoints: /dns4/s4/tcp/8080 -> /dns4/s4/tcp/8081",
+			"+New: value",
+			"-Old: value",
+			"Price: 10 -> 20",
+		},
+	}}, d.changed)
+
+	d = diffNetmaps(from, from)
+	require.Empty(t, d.joined)
+	require.Empty(t, d.left)
+	require.Empty(t, d.changed)
+}
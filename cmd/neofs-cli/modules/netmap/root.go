@@ -23,10 +23,12 @@ func init() {
 		nodeInfoCmd,
 		netInfoCmd,
 		snapshotCmd,
+		diffCmd,
 	)
 
 	initGetEpochCmd()
 	initNetInfoCmd()
 	initNodeInfoCmd()
 	initSnapshotCmd()
+	initDiffCmd()
 }
@@ -5,18 +5,36 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/morph"
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/spf13/cobra"
 )
 
+const snapshotEpochFlag = "epoch"
+
 var snapshotCmd = &cobra.Command{
 	Use:   "snapshot",
 	Short: "Request current local snapshot of the network map",
-	Long:  `Request current local snapshot of the network map`,
-	Args:  cobra.NoArgs,
+	Long: `Request current local snapshot of the network map.
+With --epoch flag the network map of the given epoch is read from the Netmap
+contract through the sidechain RPC node set by --morph-rpc-endpoint.`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, _ []string) {
 		ctx, cancel := commonflags.GetCommandContext(cmd)
 		defer cancel()
 
+		if cmd.Flags().Changed(snapshotEpochFlag) {
+			epoch, _ := cmd.Flags().GetUint64(snapshotEpochFlag)
+
+			cli := morph.GetClient(ctx, cmd)
+			defer cli.Close()
+
+			cmdprinter.PrettyPrintNetMap(cmd, getNetmapByEpoch(cmd, morph.GetNetmapClient(cmd, cli), epoch))
+
+			return
+		}
+
 		cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
 
 		var prm internalclient.NetMapSnapshotPrm
@@ -32,4 +50,25 @@ var snapshotCmd = &cobra.Command{
 func initSnapshotCmd() {
 	commonflags.Init(snapshotCmd)
 	commonflags.InitAPI(snapshotCmd)
+	morph.InitFlags(snapshotCmd)
+
+	snapshotCmd.Flags().Uint64(snapshotEpochFlag, 0, "Epoch of the network map (requires --"+morph.RPCFlag+")")
+
+	snapshotCmd.MarkFlagsRequiredTogether(snapshotEpochFlag, morph.RPCFlag)
+}
+
+// getNetmapByEpoch reads network map of the given epoch from the Netmap
+// contract. Zero epoch means the current one.
+func getNetmapByEpoch(cmd *cobra.Command, cli *nmClient.Client, epoch uint64) netmap.NetMap {
+	var err error
+
+	if epoch == 0 {
+		epoch, err = cli.Epoch()
+		common.ExitOnErr(cmd, "can't get current epoch: %w", err)
+	}
+
+	nm, err := cli.GetNetMapByEpoch(epoch)
+	common.ExitOnErr(cmd, "can't get network map: %w", err)
+
+	return *nm
 }
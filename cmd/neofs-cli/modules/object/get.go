@@ -35,18 +35,35 @@ func initObjectGetCmd() {
 	_ = objectGetCmd.MarkFlagRequired(commonflags.CIDFlag)
 
 	flags.String(commonflags.OIDFlag, "", commonflags.OIDFlagUsage)
-	_ = objectGetCmd.MarkFlagRequired(commonflags.OIDFlag)
+	flags.String(prefixFlag, "", "Download all objects with FilePath attribute starting with the prefix")
+	flags.String(toFlag, "", "Directory to restore the file tree of the objects to (with --prefix)")
+	_ = objectGetCmd.MarkFlagDirname(toFlag)
+	flags.Uint(concurrencyFlag, concurrencyDefault, "Number of objects downloaded in parallel (with --prefix)")
 
 	flags.String(fileFlag, "", "File to write object payload to(with -b together with signature and header). Default: stdout.")
 	flags.Bool(rawFlag, false, rawFlagDesc)
 	flags.Bool(noProgressFlag, false, "Do not show progress bar")
 	flags.Bool(binaryFlag, false, "Serialize whole object structure into given file(id + signature + header + payload).")
+
+	objectGetCmd.MarkFlagsMutuallyExclusive(commonflags.OIDFlag, prefixFlag)
+	objectGetCmd.MarkFlagsMutuallyExclusive(fileFlag, prefixFlag)
+	objectGetCmd.MarkFlagsMutuallyExclusive(binaryFlag, prefixFlag)
+	objectGetCmd.MarkFlagsRequiredTogether(prefixFlag, toFlag)
 }
 
 func getObject(cmd *cobra.Command, _ []string) {
 	ctx, cancel := commonflags.GetCommandContext(cmd)
 	defer cancel()
 
+	if cmd.Flags().Changed(prefixFlag) {
+		getDirectory(ctx, cmd)
+		return
+	}
+
+	if cmd.Flag(commonflags.OIDFlag).Value.String() == "" {
+		common.ExitOnErr(cmd, "", fmt.Errorf("one of \"%s\" or \"%s\" flags must be set", commonflags.OIDFlag, prefixFlag))
+	}
+
 	var cnr cid.ID
 	var obj oid.ID
 
@@ -2,6 +2,7 @@ package object
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -44,7 +45,11 @@ func initObjectPutCmd() {
 
 	flags.String(fileFlag, "", "File with object payload")
 	_ = objectPutCmd.MarkFlagFilename(fileFlag)
-	_ = objectPutCmd.MarkFlagRequired(fileFlag)
+	flags.String(recursiveFlag, "", "Directory to upload recursively, relative file paths are stored in FilePath attributes")
+	_ = objectPutCmd.MarkFlagDirname(recursiveFlag)
+	flags.Uint(concurrencyFlag, concurrencyDefault, "Number of files uploaded in parallel (with --recursive)")
+	flags.Bool(resumeFlag, false, "Upload file as independently stored parts and continue interrupted upload if any")
+	flags.String(stateFlag, "", "File to record resumable upload progress to (with --resume). Default: <file>"+stateFileSuffix)
 
 	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
 
@@ -58,6 +63,11 @@ func initObjectPutCmd() {
 	flags.String(notificationFlag, "", "Object notification in the form of *epoch*:*topic*; '-' topic means using default")
 	flags.Bool(binaryFlag, false, "Deserialize object structure from given file.")
 	objectPutCmd.MarkFlagsMutuallyExclusive(commonflags.ExpireAt, commonflags.Lifetime)
+	objectPutCmd.MarkFlagsMutuallyExclusive(fileFlag, recursiveFlag)
+	objectPutCmd.MarkFlagsMutuallyExclusive(binaryFlag, recursiveFlag)
+	objectPutCmd.MarkFlagsMutuallyExclusive(resumeFlag, recursiveFlag)
+	objectPutCmd.MarkFlagsMutuallyExclusive(resumeFlag, binaryFlag)
+	objectPutCmd.MarkFlagsMutuallyExclusive(resumeFlag, commonflags.SessionToken)
 }
 
 func putObject(cmd *cobra.Command, _ []string) {
@@ -70,12 +80,27 @@ func putObject(cmd *cobra.Command, _ []string) {
 	if !binary && cidVal == "" {
 		common.ExitOnErr(cmd, "", fmt.Errorf("required flag \"%s\" not set", commonflags.CIDFlag))
 	}
+
+	if dir, _ := cmd.Flags().GetString(recursiveFlag); dir != "" {
+		putDirectory(ctx, cmd, dir)
+		return
+	}
+
+	filename, _ := cmd.Flags().GetString(fileFlag)
+	if filename == "" {
+		common.ExitOnErr(cmd, "", fmt.Errorf("one of \"%s\" or \"%s\" flags must be set", fileFlag, recursiveFlag))
+	}
+
+	if resume, _ := cmd.Flags().GetBool(resumeFlag); resume {
+		putObjectResumable(ctx, cmd, filename)
+		return
+	}
+
 	pk := key.GetOrGenerate(cmd)
 
 	var ownerID user.ID
 	var cnr cid.ID
 
-	filename, _ := cmd.Flags().GetString(fileFlag)
 	f, err := os.OpenFile(filename, os.O_RDONLY, os.ModePerm)
 	if err != nil {
 		common.ExitOnErr(cmd, "", fmt.Errorf("can't open file '%s': %w", filename, err))
@@ -100,35 +125,8 @@ func putObject(cmd *cobra.Command, _ []string) {
 	attrs, err := parseObjectAttrs(cmd)
 	common.ExitOnErr(cmd, "can't parse object attributes: %w", err)
 
-	expiresOn, _ := cmd.Flags().GetUint64(commonflags.ExpireAt)
-	lifetime, _ := cmd.Flags().GetUint64(commonflags.Lifetime)
-	if lifetime > 0 {
-		endpoint, _ := cmd.Flags().GetString(commonflags.RPC)
-		currEpoch, err := internalclient.GetCurrentEpoch(ctx, endpoint)
-		common.ExitOnErr(cmd, "Request current epoch: %w", err)
-
-		expiresOn = currEpoch + lifetime
-	}
-
-	if expiresOn > 0 {
-		var expAttrFound bool
-		expAttrValue := strconv.FormatUint(expiresOn, 10)
-
-		for i := range attrs {
-			if attrs[i].Key() == object.AttributeExpirationEpoch {
-				attrs[i].SetValue(expAttrValue)
-				expAttrFound = true
-				break
-			}
-		}
-
-		if !expAttrFound {
-			index := len(attrs)
-			attrs = append(attrs, object.Attribute{})
-			attrs[index].SetKey(object.AttributeExpirationEpoch)
-			attrs[index].SetValue(expAttrValue)
-		}
-	}
+	attrs, err = setExpirationAttr(ctx, cmd, attrs)
+	common.ExitOnErr(cmd, "", err)
 
 	obj.SetContainerID(cnr)
 	obj.SetOwnerID(&ownerID)
@@ -201,8 +199,8 @@ func parseObjectAttrs(cmd *cobra.Command) ([]object.Attribute, error) {
 	}
 
 	disableFilename, _ := cmd.Flags().GetBool("disable-filename")
-	if !disableFilename {
-		filename := filepath.Base(cmd.Flag(fileFlag).Value.String())
+	if fileFlagValue := cmd.Flag(fileFlag).Value.String(); !disableFilename && fileFlagValue != "" {
+		filename := filepath.Base(fileFlagValue)
 		index := len(attrs)
 		attrs = append(attrs, object.Attribute{})
 		attrs[index].SetKey(object.AttributeFileName)
@@ -220,6 +218,47 @@ func parseObjectAttrs(cmd *cobra.Command) ([]object.Attribute, error) {
 	return attrs, nil
 }
 
+// setExpirationAttr sets expiration epoch attribute according to the
+// expiration flags of the command. Attributes are returned as is if
+// the expiration is not requested.
+func setExpirationAttr(ctx context.Context, cmd *cobra.Command, attrs []object.Attribute) ([]object.Attribute, error) {
+	expiresOn, _ := cmd.Flags().GetUint64(commonflags.ExpireAt)
+	lifetime, _ := cmd.Flags().GetUint64(commonflags.Lifetime)
+	if lifetime > 0 {
+		endpoint, _ := cmd.Flags().GetString(commonflags.RPC)
+		currEpoch, err := internalclient.GetCurrentEpoch(ctx, endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("request current epoch: %w", err)
+		}
+
+		expiresOn = currEpoch + lifetime
+	}
+
+	if expiresOn > 0 {
+		attrs = setAttribute(attrs, object.AttributeExpirationEpoch, strconv.FormatUint(expiresOn, 10))
+	}
+
+	return attrs, nil
+}
+
+// setAttribute overwrites value of the attribute with the given key or
+// appends a new attribute if there is no such one.
+func setAttribute(attrs []object.Attribute, key, value string) []object.Attribute {
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			attrs[i].SetValue(value)
+			return attrs
+		}
+	}
+
+	index := len(attrs)
+	attrs = append(attrs, object.Attribute{})
+	attrs[index].SetKey(key)
+	attrs[index].SetValue(value)
+
+	return attrs
+}
+
 func parseObjectNotifications(cmd *cobra.Command) (*object.NotificationInfo, error) {
 	const (
 		separator       = ":"
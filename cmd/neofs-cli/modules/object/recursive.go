@@ -0,0 +1,419 @@
+package object
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const (
+	recursiveFlag   = "recursive"
+	prefixFlag      = "prefix"
+	toFlag          = "to"
+	concurrencyFlag = "concurrency"
+
+	concurrencyDefault = 4
+)
+
+// runInParallel calls f for each element of items using the number of workers
+// set by the concurrency flag. Results returned by f are printed line by line,
+// so the output of the workers does not interleave. Returns number of failed
+// items.
+func runInParallel(cmd *cobra.Command, items []string, f func(string) (string, error)) int {
+	workers, _ := cmd.Flags().GetUint(concurrencyFlag)
+	if workers == 0 {
+		workers = 1
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mtx    sync.Mutex
+		failed int
+		ch     = make(chan string)
+	)
+
+	for i := uint(0); i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for item := range ch {
+				res, err := f(item)
+
+				mtx.Lock()
+				if err != nil {
+					failed++
+					cmd.PrintErrf("[%s] %v\n", item, err)
+				} else {
+					cmd.Printf("[%s] %s\n", item, res)
+				}
+				mtx.Unlock()
+			}
+		}()
+	}
+
+	for i := range items {
+		ch <- items[i]
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return failed
+}
+
+// putDirectory uploads all regular files of the directory tree. Relative paths
+// of the files are stored in FilePath attributes. Files already stored in the
+// container with the same path and payload checksum are skipped.
+func putDirectory(ctx context.Context, cmd *cobra.Command, dir string) {
+	var cnr cid.ID
+	readCID(cmd, &cnr)
+
+	pk := key.GetOrGenerate(cmd)
+	ownerID := user.ResolveFromECDSAPublicKey(pk.PublicKey)
+
+	var paths []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			paths = append(paths, filepath.ToSlash(rel))
+		}
+
+		return nil
+	})
+	common.ExitOnErr(cmd, "can't read directory: %w", err)
+
+	attrs, err := parseObjectAttrs(cmd)
+	common.ExitOnErr(cmd, "can't parse object attributes: %w", err)
+
+	attrs, err = setExpirationAttr(ctx, cmd, attrs)
+	common.ExitOnErr(cmd, "", err)
+
+	notificationInfo, err := parseObjectNotifications(cmd)
+	common.ExitOnErr(cmd, "can't parse object notification information: %w", err)
+
+	disableFilename, _ := cmd.Flags().GetBool("disable-filename")
+
+	var putPrm internalclient.PutObjectPrm
+	putPrm.SetPrivateKey(*pk)
+	ReadOrOpenSession(ctx, cmd, &putPrm, pk, cnr, nil)
+	Prepare(cmd, &putPrm)
+
+	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
+
+	failed := runInParallel(cmd, paths, func(path string) (string, error) {
+		localPath := filepath.Join(dir, filepath.FromSlash(path))
+
+		sum, err := fileChecksum(localPath)
+		if err != nil {
+			return "", err
+		}
+
+		id, found, err := findStoredFile(ctx, cmd, cli, pk, cnr, path, sum)
+		if err != nil {
+			return "", fmt.Errorf("can't check stored objects: %w", err)
+		} else if found {
+			return fmt.Sprintf("Object is already stored, skipping\n  OID: %s", id), nil
+		}
+
+		f, err := os.Open(localPath)
+		if err != nil {
+			return "", fmt.Errorf("can't open file: %w", err)
+		}
+		defer f.Close()
+
+		fileAttrs := make([]object.Attribute, len(attrs), len(attrs)+2)
+		copy(fileAttrs, attrs)
+
+		fileAttrs = setAttribute(fileAttrs, object.AttributeFilePath, path)
+		if !disableFilename {
+			fileAttrs = setAttribute(fileAttrs, object.AttributeFileName, filepath.Base(localPath))
+		}
+
+		obj := object.New()
+		obj.SetContainerID(cnr)
+		obj.SetOwnerID(&ownerID)
+		obj.SetAttributes(fileAttrs...)
+
+		if notificationInfo != nil {
+			obj.SetNotification(*notificationInfo)
+		}
+
+		prm := putPrm
+		prm.SetHeader(obj)
+		prm.SetPayloadReader(f)
+
+		res, err := internalclient.PutObject(ctx, prm)
+		if err != nil {
+			return "", fmt.Errorf("rpc error: %w", err)
+		}
+
+		return fmt.Sprintf("Object successfully stored\n  OID: %s", res.ID()), nil
+	})
+
+	cmd.Printf("Processed %d files, %d failed.\n", len(paths), failed)
+	cmd.Printf("  CID: %s\n", cnr)
+
+	if failed > 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("failed to upload %d files", failed))
+	}
+}
+
+// findStoredFile searches for the root object with the given FilePath
+// attribute and SHA256 payload checksum.
+func findStoredFile(ctx context.Context, cmd *cobra.Command, cli *client.Client, pk *ecdsa.PrivateKey, cnr cid.ID, path string, sum []byte) (oid.ID, bool, error) {
+	var filters object.SearchFilters
+	filters.AddRootFilter()
+	filters.AddFilter(object.AttributeFilePath, path, object.MatchStringEqual)
+
+	var searchPrm internalclient.SearchObjectsPrm
+	searchPrm.SetClient(cli)
+	searchPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &searchPrm)
+	searchPrm.SetContainerID(cnr)
+	searchPrm.SetFilters(filters)
+
+	res, err := internalclient.SearchObjects(ctx, searchPrm)
+	if err != nil {
+		return oid.ID{}, false, err
+	}
+
+	ids := res.IDList()
+
+	var headPrm internalclient.HeadObjectPrm
+	headPrm.SetClient(cli)
+	headPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &headPrm)
+
+	for i := range ids {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(ids[i])
+
+		headPrm.SetAddress(addr)
+
+		hdr, err := internalclient.HeadObject(ctx, headPrm)
+		if err != nil {
+			return oid.ID{}, false, err
+		}
+
+		cs, ok := hdr.Header().PayloadChecksum()
+		if ok && cs.Type() == checksum.SHA256 && bytes.Equal(cs.Value(), sum) {
+			return ids[i], true, nil
+		}
+	}
+
+	return oid.ID{}, false, nil
+}
+
+// getDirectory downloads all root objects with FilePath attribute starting
+// with the prefix and restores their file tree in the target directory. Only
+// the newest object is downloaded if there are several objects with the same
+// path. Local files with the same payload checksum are not rewritten.
+func getDirectory(ctx context.Context, cmd *cobra.Command) {
+	var cnr cid.ID
+	readCID(cmd, &cnr)
+
+	prefix, _ := cmd.Flags().GetString(prefixFlag)
+	dir, _ := cmd.Flags().GetString(toFlag)
+
+	pk := key.GetOrGenerate(cmd)
+
+	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
+
+	var filters object.SearchFilters
+	filters.AddRootFilter()
+	filters.AddFilter(object.AttributeFilePath, prefix, object.MatchCommonPrefix)
+
+	var searchPrm internalclient.SearchObjectsPrm
+	searchPrm.SetClient(cli)
+	searchPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &searchPrm)
+	readSessionGlobal(cmd, &searchPrm, pk, cnr)
+	searchPrm.SetContainerID(cnr)
+	searchPrm.SetFilters(filters)
+
+	res, err := internalclient.SearchObjects(ctx, searchPrm)
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	ids := res.IDList()
+
+	cmd.Printf("Found %d objects.\n", len(ids))
+
+	var headPrm internalclient.HeadObjectPrm
+	headPrm.SetClient(cli)
+	headPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &headPrm)
+	readSessionGlobal(cmd, &headPrm, pk, cnr)
+
+	// newest objects by file paths
+	files := make(map[string]*object.Object)
+
+	for i := range ids {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(ids[i])
+
+		headPrm.SetAddress(addr)
+
+		res, err := internalclient.HeadObject(ctx, headPrm)
+		common.ExitOnErr(cmd, "rpc error: %w", err)
+
+		hdr := res.Header()
+		hdr.SetID(ids[i])
+
+		path := attributeValue(hdr, object.AttributeFilePath)
+		if prev, ok := files[path]; !ok || newerObject(hdr, prev) {
+			files[path] = hdr
+		}
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	failed := runInParallel(cmd, paths, func(path string) (string, error) {
+		hdr := files[path]
+		id, _ := hdr.ID()
+
+		localPath, err := localFilePath(dir, path)
+		if err != nil {
+			return "", err
+		}
+
+		if cs, ok := hdr.PayloadChecksum(); ok && cs.Type() == checksum.SHA256 {
+			if sum, err := fileChecksum(localPath); err == nil && bytes.Equal(sum, cs.Value()) {
+				return fmt.Sprintf("File is up to date, skipping\n  OID: %s", id), nil
+			}
+		}
+
+		err = os.MkdirAll(filepath.Dir(localPath), 0755)
+		if err != nil {
+			return "", fmt.Errorf("can't create directory: %w", err)
+		}
+
+		f, err := os.OpenFile(localPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return "", fmt.Errorf("can't open file: %w", err)
+		}
+
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(id)
+
+		var prm internalclient.GetObjectPrm
+		prm.SetClient(cli)
+		prm.SetPrivateKey(*pk)
+		Prepare(cmd, &prm)
+		readSession(cmd, &prm, pk, cnr, id)
+		prm.SetAddress(addr)
+		prm.SetPayloadWriter(f)
+
+		_, err = internalclient.GetObject(ctx, prm)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			_ = os.Remove(localPath)
+			return "", fmt.Errorf("rpc error: %w", err)
+		}
+
+		return fmt.Sprintf("Object successfully saved\n  OID: %s", id), nil
+	})
+
+	cmd.Printf("Processed %d files, %d failed.\n", len(paths), failed)
+
+	if failed > 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("failed to download %d files", failed))
+	}
+}
+
+// localFilePath returns path of the file with the given FilePath attribute
+// value in the directory. Paths leading outside the directory are rejected.
+func localFilePath(dir, path string) (string, error) {
+	res := filepath.Join(dir, filepath.FromSlash(path))
+
+	rel, err := filepath.Rel(dir, res)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.New("file path points outside the target directory")
+	}
+
+	return res, nil
+}
+
+// newerObject checks whether the object is created after the other one
+// according to creation epochs and timestamp attributes.
+func newerObject(obj, other *object.Object) bool {
+	if obj.CreationEpoch() != other.CreationEpoch() {
+		return obj.CreationEpoch() > other.CreationEpoch()
+	}
+
+	ts, _ := strconv.ParseInt(attributeValue(obj, object.AttributeTimestamp), 10, 64)
+	otherTS, _ := strconv.ParseInt(attributeValue(other, object.AttributeTimestamp), 10, 64)
+
+	return ts > otherTS
+}
+
+func attributeValue(obj *object.Object, key string) string {
+	attrs := obj.Attributes()
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			return attrs[i].Value()
+		}
+	}
+
+	return ""
+}
+
+func fileChecksum(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't open file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return nil, fmt.Errorf("can't read file: %w", err)
+	}
+
+	return h.Sum(nil), nil
+}
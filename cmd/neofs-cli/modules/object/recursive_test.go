@@ -0,0 +1,131 @@
+package object
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalFilePath(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, tc := range []struct {
+		path     string
+		expected string
+	}{
+		{path: "cat.jpg", expected: "cat.jpg"},
+		{path: "a/b/cat.jpg", expected: "a/b/cat.jpg"},
+		{path: "a/../cat.jpg", expected: "cat.jpg"},
+		{path: "/a/cat.jpg", expected: "a/cat.jpg"},
+		{path: "./a/./cat.jpg", expected: "a/cat.jpg"},
+		{path: ""},
+		{path: "."},
+		{path: ".."},
+		{path: "../cat.jpg"},
+		{path: "../../etc/passwd"},
+		{path: "a/../../cat.jpg"},
+		{path: "a/b/../../.."},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			res, err := localFilePath(dir, tc.path)
+			if tc.expected == "" {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(dir, filepath.FromSlash(tc.expected)), res)
+		})
+	}
+}
+
+func TestRunInParallel(t *testing.T) {
+	for _, workers := range []uint{0, 1, 4} {
+		cmd := &cobra.Command{}
+		cmd.Flags().Uint(concurrencyFlag, workers, "")
+
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+
+		items := []string{"a", "b", "c", "d", "e"}
+
+		failed := runInParallel(cmd, items, func(item string) (string, error) {
+			if item == "b" || item == "d" {
+				return "", errors.New("failure")
+			}
+			return "done", nil
+		})
+
+		require.Equal(t, 2, failed, workers)
+
+		out := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.ElementsMatch(t, []string{"[a] done", "[c] done", "[e] done"}, out, workers)
+
+		errOut := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+		require.ElementsMatch(t, []string{"[b] failure", "[d] failure"}, errOut, workers)
+	}
+}
+
+func TestNewerObject(t *testing.T) {
+	newObject := func(epoch uint64, ts string) *object.Object {
+		obj := object.New()
+		obj.SetCreationEpoch(epoch)
+
+		if ts != "" {
+			var a object.Attribute
+			a.SetKey(object.AttributeTimestamp)
+			a.SetValue(ts)
+
+			obj.SetAttributes(a)
+		}
+
+		return obj
+	}
+
+	for _, tc := range []struct {
+		name       string
+		obj, other *object.Object
+		newer      bool
+	}{
+		{
+			name:  "later epoch",
+			obj:   newObject(2, "1"),
+			other: newObject(1, "2"),
+			newer: true,
+		},
+		{
+			name:  "same epoch, later timestamp",
+			obj:   newObject(1, "2"),
+			other: newObject(1, "1"),
+			newer: true,
+		},
+		{
+			name:  "same epoch, no timestamp",
+			obj:   newObject(1, "1"),
+			other: newObject(1, ""),
+			newer: true,
+		},
+		{
+			name:  "equal",
+			obj:   newObject(1, "1"),
+			other: newObject(1, "1"),
+		},
+		{
+			name:  "invalid timestamp",
+			obj:   newObject(1, "abc"),
+			other: newObject(1, ""),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.newer, newerObject(tc.obj, tc.other))
+			require.False(t, newerObject(tc.other, tc.obj) && tc.newer)
+		})
+	}
+}
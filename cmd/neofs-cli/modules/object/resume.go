@@ -0,0 +1,419 @@
+package object
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+)
+
+const (
+	resumeFlag = "resume"
+	stateFlag  = "state"
+
+	stateFileSuffix = ".neofs-upload"
+)
+
+// uploadState is a progress of the resumable upload stored in the local
+// state file between the command runs.
+type uploadState struct {
+	Container string `json:"container"`
+	Size      int64  `json:"size"`
+	ModTime   int64  `json:"mod_time"`
+
+	SplitID       string `json:"split_id"`
+	PartSize      uint64 `json:"part_size"`
+	CreationEpoch uint64 `json:"creation_epoch"`
+	Homomorphic   bool   `json:"homomorphic"`
+
+	// Marshaled state of the SHA256 hash of the uploaded payload.
+	PayloadHash []byte `json:"payload_hash"`
+
+	Parts []uploadedPart `json:"parts"`
+
+	// Binary parent header. Set when the last part is formed.
+	Parent []byte `json:"parent,omitempty"`
+}
+
+type uploadedPart struct {
+	ID              string `json:"id"`
+	Size            uint64 `json:"size"`
+	HomomorphicHash []byte `json:"homomorphic_hash,omitempty"`
+}
+
+func (x uploadState) uploaded() uint64 {
+	var res uint64
+	for i := range x.Parts {
+		res += x.Parts[i].Size
+	}
+
+	return res
+}
+
+func readUploadState(path string) (*uploadState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("can't read state file: %w", err)
+	}
+
+	var st uploadState
+
+	err = json.Unmarshal(data, &st)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode state file: %w", err)
+	}
+
+	return &st, nil
+}
+
+// writeUploadState atomically replaces the state file.
+func writeUploadState(path string, st *uploadState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("can't encode upload state: %w", err)
+	}
+
+	tmp := path + ".tmp"
+
+	err = os.WriteFile(tmp, data, 0600)
+	if err != nil {
+		return fmt.Errorf("can't write state file: %w", err)
+	}
+
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return fmt.Errorf("can't write state file: %w", err)
+	}
+
+	return nil
+}
+
+// putObjectResumable uploads the file as a chain of independently stored
+// child objects finished by the linking object. Progress is recorded in the
+// state file after each stored part, so the interrupted upload continues from
+// the first part which has not been stored yet.
+func putObjectResumable(ctx context.Context, cmd *cobra.Command, filename string) {
+	var cnr cid.ID
+	readCID(cmd, &cnr)
+
+	statePath, _ := cmd.Flags().GetString(stateFlag)
+	if statePath == "" {
+		statePath = filename + stateFileSuffix
+	}
+
+	f, err := os.Open(filename)
+	common.ExitOnErr(cmd, "can't open file: %w", err)
+	defer f.Close()
+
+	fi, err := f.Stat()
+	common.ExitOnErr(cmd, "can't get file info: %w", err)
+
+	pk := key.GetOrGenerate(cmd)
+	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
+
+	st, err := readUploadState(statePath)
+	common.ExitOnErr(cmd, "", err)
+
+	if st != nil {
+		if st.Container != cnr.EncodeToString() || st.Size != fi.Size() || st.ModTime != fi.ModTime().UnixNano() {
+			common.ExitOnErr(cmd, "", fmt.Errorf("state file '%s' belongs to another upload, remove it to start over", statePath))
+		}
+
+		cmd.Printf("Resuming upload: %d of %d bytes are already stored\n", st.uploaded(), st.Size)
+	} else {
+		st, err = newUploadState(ctx, cli, cnr, fi)
+		common.ExitOnErr(cmd, "", err)
+
+		if uint64(st.Size) <= st.PartSize {
+			common.ExitOnErr(cmd, "", errors.New("file fits into a single object, upload it without --resume"))
+		}
+
+		err = writeUploadState(statePath, st)
+		common.ExitOnErr(cmd, "", err)
+	}
+
+	var splitID object.SplitID
+
+	err = splitID.Parse(st.SplitID)
+	common.ExitOnErr(cmd, "invalid split ID in state file: %w", err)
+
+	payloadHash := sha256.New()
+
+	err = payloadHash.(encoding.BinaryUnmarshaler).UnmarshalBinary(st.PayloadHash)
+	common.ExitOnErr(cmd, "invalid payload hash in state file: %w", err)
+
+	ownerID := user.ResolveFromECDSAPublicKey(pk.PublicKey)
+	signer := user.NewAutoIDSigner(*pk)
+
+	newChild := func() *object.Object {
+		obj := newUploadHeader(st, cnr, ownerID)
+		obj.SetSplitID(&splitID)
+
+		return obj
+	}
+
+	var putPrm internalclient.PutObjectPrm
+	putPrm.SetClient(cli)
+	putPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &putPrm)
+
+	offset := st.uploaded()
+
+	_, err = f.Seek(int64(offset), io.SeekStart)
+	common.ExitOnErr(cmd, "can't seek file: %w", err)
+
+	buf := make([]byte, st.PartSize)
+
+	for offset < uint64(st.Size) {
+		n := st.PartSize
+		if rest := uint64(st.Size) - offset; rest < n {
+			n = rest
+		}
+
+		_, err = io.ReadFull(f, buf[:n])
+		common.ExitOnErr(cmd, "can't read file: %w", err)
+
+		payload := buf[:n]
+		payloadHash.Write(payload)
+
+		child := newChild()
+		child.SetPayload(payload)
+		child.SetPayloadSize(n)
+		child.CalculateAndSetPayloadChecksum()
+
+		var part uploadedPart
+		part.Size = n
+
+		if st.Homomorphic {
+			var cs checksum.Checksum
+			cs.SetTillichZemor(tz.Sum(payload))
+
+			child.SetPayloadHomomorphicHash(cs)
+			part.HomomorphicHash = cs.Value()
+		}
+
+		if len(st.Parts) > 0 {
+			child.SetPreviousID(partID(cmd, st.Parts[len(st.Parts)-1]))
+		}
+
+		if offset+n == uint64(st.Size) {
+			st.Parts = append(st.Parts, part)
+
+			parent, err := formParentHeader(ctx, cmd, st, payloadHash, newUploadHeader(st, cnr, ownerID), signer)
+			st.Parts = st.Parts[:len(st.Parts)-1]
+			common.ExitOnErr(cmd, "can't form parent header: %w", err)
+
+			st.Parent, err = parent.Marshal()
+			common.ExitOnErr(cmd, "can't encode parent header: %w", err)
+
+			setParent(child, parent)
+		}
+
+		err = child.SetIDWithSignature(signer)
+		common.ExitOnErr(cmd, "can't sign object part: %w", err)
+
+		prm := putPrm
+		prm.SetHeader(child)
+
+		_, err = internalclient.PutObject(ctx, prm)
+		common.ExitOnErr(cmd, fmt.Sprintf("can't store part #%d: %%w", len(st.Parts)), err)
+
+		id, _ := child.ID()
+		part.ID = id.EncodeToString()
+
+		st.Parts = append(st.Parts, part)
+
+		st.PayloadHash, err = payloadHash.(encoding.BinaryMarshaler).MarshalBinary()
+		common.ExitOnErr(cmd, "can't encode payload hash: %w", err)
+
+		err = writeUploadState(statePath, st)
+		common.ExitOnErr(cmd, "", err)
+
+		offset += n
+
+		common.PrintVerbose(cmd, "Part #%d stored: %s (%d of %d bytes)", len(st.Parts)-1, id, offset, st.Size)
+	}
+
+	var parent object.Object
+
+	err = parent.Unmarshal(st.Parent)
+	common.ExitOnErr(cmd, "invalid parent header in state file: %w", err)
+
+	children := make([]oid.ID, len(st.Parts))
+	for i := range st.Parts {
+		children[i] = partID(cmd, st.Parts[i])
+	}
+
+	link := newChild()
+	link.SetChildren(children...)
+	link.CalculateAndSetPayloadChecksum()
+	setParent(link, &parent)
+
+	err = link.SetIDWithSignature(signer)
+	common.ExitOnErr(cmd, "can't sign linking object: %w", err)
+
+	prm := putPrm
+	prm.SetHeader(link)
+
+	_, err = internalclient.PutObject(ctx, prm)
+	common.ExitOnErr(cmd, "can't store linking object: %w", err)
+
+	err = os.Remove(statePath)
+	if err != nil {
+		cmd.PrintErrf("Failed to remove state file: %v\n", err)
+	}
+
+	parentID, _ := parent.ID()
+
+	cmd.Printf("[%s] Object successfully stored\n", filename)
+	cmd.Printf("  OID: %s\n  CID: %s\n", parentID, cnr)
+}
+
+func newUploadState(ctx context.Context, cli *client.Client, cnr cid.ID, fi os.FileInfo) (*uploadState, error) {
+	var netPrm internalclient.NetworkInfoPrm
+	netPrm.SetClient(cli)
+
+	netRes, err := internalclient.NetworkInfo(ctx, netPrm)
+	if err != nil {
+		return nil, fmt.Errorf("can't get network info: %w", err)
+	}
+
+	var cnrPrm internalclient.GetContainerPrm
+	cnrPrm.SetClient(cli)
+	cnrPrm.SetContainer(cnr)
+
+	cnrRes, err := internalclient.GetContainer(ctx, cnrPrm)
+	if err != nil {
+		return nil, fmt.Errorf("can't get container: %w", err)
+	}
+
+	payloadHash, err := sha256.New().(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("can't encode payload hash: %w", err)
+	}
+
+	ni := netRes.NetworkInfo()
+
+	return &uploadState{
+		Container:     cnr.EncodeToString(),
+		Size:          fi.Size(),
+		ModTime:       fi.ModTime().UnixNano(),
+		SplitID:       object.NewSplitID().String(),
+		PartSize:      ni.MaxObjectSize(),
+		CreationEpoch: ni.CurrentEpoch(),
+		Homomorphic:   !ni.HomomorphicHashingDisabled() && !cnrRes.Container().IsHomomorphicHashingDisabled(),
+		PayloadHash:   payloadHash,
+	}, nil
+}
+
+// formParentHeader forms signed header of the uploaded object. All parts must
+// be already accounted in the state and the hash.
+func formParentHeader(ctx context.Context, cmd *cobra.Command, st *uploadState, payloadHash hash.Hash, parent *object.Object, signer user.Signer) (*object.Object, error) {
+	attrs, err := parseObjectAttrs(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse object attributes: %w", err)
+	}
+
+	attrs, err = setExpirationAttr(ctx, cmd, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationInfo, err := parseObjectNotifications(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse object notification information: %w", err)
+	}
+
+	parent.SetAttributes(attrs...)
+	parent.SetPayloadSize(uint64(st.Size))
+
+	if notificationInfo != nil {
+		parent.SetNotification(*notificationInfo)
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], payloadHash.Sum(nil))
+
+	var cs checksum.Checksum
+	cs.SetSHA256(sum)
+	parent.SetPayloadChecksum(cs)
+
+	if st.Homomorphic {
+		hs := make([][]byte, len(st.Parts))
+		for i := range st.Parts {
+			hs[i] = st.Parts[i].HomomorphicHash
+		}
+
+		h, err := tz.Concat(hs)
+		if err != nil {
+			return nil, fmt.Errorf("can't calculate homomorphic hash: %w", err)
+		}
+
+		var tzSum [tz.Size]byte
+		copy(tzSum[:], h)
+
+		cs.SetTillichZemor(tzSum)
+		parent.SetPayloadHomomorphicHash(cs)
+	}
+
+	err = parent.SetIDWithSignature(signer)
+	if err != nil {
+		return nil, fmt.Errorf("can't sign parent header: %w", err)
+	}
+
+	return parent, nil
+}
+
+// newUploadHeader returns header with the fields common for all objects of
+// the upload.
+func newUploadHeader(st *uploadState, cnr cid.ID, owner user.ID) *object.Object {
+	ver := version.Current()
+
+	obj := object.New()
+	obj.SetVersion(&ver)
+	obj.SetContainerID(cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetCreationEpoch(st.CreationEpoch)
+	obj.SetType(object.TypeRegular)
+
+	return obj
+}
+
+func setParent(child *object.Object, parent *object.Object) {
+	id, _ := parent.ID()
+
+	child.SetParentID(id)
+	child.SetParent(parent)
+}
+
+func partID(cmd *cobra.Command, part uploadedPart) oid.ID {
+	var id oid.ID
+
+	err := id.DecodeString(part.ID)
+	common.ExitOnErr(cmd, "invalid part ID in state file: %w", err)
+
+	return id
+}
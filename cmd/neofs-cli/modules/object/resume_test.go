@@ -0,0 +1,144 @@
+package object
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file"+stateFileSuffix)
+
+	st, err := readUploadState(path)
+	require.NoError(t, err)
+	require.Nil(t, st)
+
+	exp := &uploadState{
+		Container: cidtest.ID().EncodeToString(),
+		Size:      10,
+		SplitID:   object.NewSplitID().String(),
+		PartSize:  4,
+		Parts: []uploadedPart{
+			{ID: "first", Size: 4},
+			{ID: "second", Size: 4},
+		},
+	}
+
+	require.NoError(t, writeUploadState(path, exp))
+
+	st, err = readUploadState(path)
+	require.NoError(t, err)
+	require.Equal(t, exp, st)
+	require.EqualValues(t, 8, st.uploaded())
+
+	require.NoError(t, os.WriteFile(path, []byte("not a JSON"), 0600))
+
+	_, err = readUploadState(path)
+	require.Error(t, err)
+}
+
+func testUploadCommand() *cobra.Command {
+	cmd := &cobra.Command{}
+
+	flags := cmd.Flags()
+	flags.String(fileFlag, "", "")
+	flags.StringSlice("attributes", nil, "")
+	flags.Bool("disable-filename", false, "")
+	flags.Bool("disable-timestamp", true, "")
+	flags.Uint64(commonflags.ExpireAt, 0, "")
+	flags.Uint64(commonflags.Lifetime, 0, "")
+	flags.String(notificationFlag, "", "")
+
+	return cmd
+}
+
+func TestFormParentHeader(t *testing.T) {
+	payload := []byte("payload uploaded in several parts")
+
+	const partSize = 10
+
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := user.NewAutoIDSigner(pk.PrivateKey)
+	owner := user.ResolveFromECDSAPublicKey(pk.PrivateKey.PublicKey)
+	cnr := cidtest.ID()
+
+	st := &uploadState{
+		Size:        int64(len(payload)),
+		PartSize:    partSize,
+		Homomorphic: true,
+	}
+
+	st.PayloadHash, err = sha256.New().(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	// every part is hashed in the separate "run" restored from the state
+	for off := 0; off < len(payload); off += partSize {
+		end := off + partSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		h := sha256.New()
+		require.NoError(t, h.(encoding.BinaryUnmarshaler).UnmarshalBinary(st.PayloadHash))
+
+		h.Write(payload[off:end])
+
+		st.PayloadHash, err = h.(encoding.BinaryMarshaler).MarshalBinary()
+		require.NoError(t, err)
+
+		tzHash := tz.Sum(payload[off:end])
+		st.Parts = append(st.Parts, uploadedPart{Size: uint64(end - off), HomomorphicHash: tzHash[:]})
+	}
+
+	payloadHash := sha256.New()
+	require.NoError(t, payloadHash.(encoding.BinaryUnmarshaler).UnmarshalBinary(st.PayloadHash))
+
+	cmd := testUploadCommand()
+	require.NoError(t, cmd.Flags().Set(fileFlag, "/tmp/file.txt"))
+	require.NoError(t, cmd.Flags().Set("attributes", "Type=test"))
+	require.NoError(t, cmd.Flags().Set(commonflags.ExpireAt, "100"))
+
+	parent, err := formParentHeader(context.Background(), cmd, st, payloadHash, newUploadHeader(st, cnr, owner), signer)
+	require.NoError(t, err)
+	require.NoError(t, parent.CheckHeaderVerificationFields())
+	require.EqualValues(t, len(payload), parent.PayloadSize())
+
+	cs, ok := parent.PayloadChecksum()
+	require.True(t, ok)
+	require.Equal(t, checksum.SHA256, cs.Type())
+
+	sum := sha256.Sum256(payload)
+	require.Equal(t, sum[:], cs.Value())
+
+	cs, ok = parent.PayloadHomomorphicHash()
+	require.True(t, ok)
+
+	tzSum := tz.Sum(payload)
+	require.Equal(t, tzSum[:], cs.Value())
+
+	attrs := make(map[string]string)
+	for _, a := range parent.Attributes() {
+		attrs[a.Key()] = a.Value()
+	}
+
+	require.Equal(t, map[string]string{
+		"Type":                          "test",
+		object.AttributeFileName:        "file.txt",
+		object.AttributeExpirationEpoch: "100",
+	}, attrs)
+}
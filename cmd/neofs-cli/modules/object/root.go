@@ -27,7 +27,8 @@ func init() {
 		objectHeadCmd,
 		objectHashCmd,
 		objectRangeCmd,
-		objectLockCmd}
+		objectLockCmd,
+		objectSyncCmd}
 
 	Cmd.AddCommand(objectNodesCmd)
 	Cmd.AddCommand(objectRPCs...)
@@ -46,4 +47,5 @@ func init() {
 	initObjectRangeCmd()
 	initCommandObjectLock()
 	initObjectNodesCmd()
+	initObjectSyncCmd()
 }
@@ -0,0 +1,356 @@
+package object
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const (
+	syncFromFlag   = "from"
+	syncToFlag     = "to"
+	syncDeleteFlag = "delete"
+	syncDryRunFlag = "dry-run"
+)
+
+// syncSkippedAttributes are system attributes bound to the particular upload
+// of the source object, they are neither copied nor compared. Other system
+// attributes (expiration and tick epochs, tick topic) are kept, so copies
+// expire and are ticked the same way as the originals.
+var syncSkippedAttributes = map[string]struct{}{
+	v2object.SysAttributeUploadID: {},
+}
+
+var objectSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Synchronize objects between containers",
+	Long: `Synchronize objects between containers of the same or different NeoFS networks.
+Containers are specified in the form of <cid>@<rpc-endpoint>. Root objects are compared
+by payload checksum and attributes. Missing objects are copied to the destination
+container with their attributes including expiration epoch, objects are owned by the user of the given key.
+Extraneous objects of the destination container are removed if requested.`,
+	Example: `neofs-cli object sync -w wallet.json --from 5HqniP5vq5xXr3FdijTSekrQJHu1WnADt2uLg7KSViZM@s01.neofs.devenv:8080 --to 8pDVBbhDzgVw7AZdGzZYmmWBSbeE5RTFadD5UAxRmVky@grpcs://st1.backup.example:8082 --delete`,
+	Args:    cobra.NoArgs,
+	Run:     syncObjects,
+}
+
+func initObjectSyncCmd() {
+	commonflags.InitWithoutRPC(objectSyncCmd)
+
+	flags := objectSyncCmd.Flags()
+
+	flags.DurationP(commonflags.Timeout, commonflags.TimeoutShorthand, commonflags.TimeoutDefault, commonflags.TimeoutUsage)
+	flags.String(syncFromFlag, "", "Source container in the form of <cid>@<rpc-endpoint>")
+	_ = objectSyncCmd.MarkFlagRequired(syncFromFlag)
+	flags.String(syncToFlag, "", "Destination container in the form of <cid>@<rpc-endpoint>")
+	_ = objectSyncCmd.MarkFlagRequired(syncToFlag)
+	flags.Bool(syncDeleteFlag, false, "Remove destination objects missing in the source container")
+	flags.Bool(syncDryRunFlag, false, "Print required changes without applying them")
+	flags.Uint(concurrencyFlag, concurrencyDefault, "Number of objects processed in parallel")
+}
+
+// syncEndpoint is a container served by the particular NeoFS endpoint.
+type syncEndpoint struct {
+	cnr cid.ID
+	cli *client.Client
+
+	// root objects by the comparison keys
+	objects map[string]*object.Object
+}
+
+func syncObjects(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	pk := key.GetOrGenerate(cmd)
+
+	src := readSyncEndpoint(ctx, cmd, syncFromFlag)
+	dst := readSyncEndpoint(ctx, cmd, syncToFlag)
+
+	if cmd.Flag(syncFromFlag).Value.String() == cmd.Flag(syncToFlag).Value.String() {
+		common.ExitOnErr(cmd, "", errors.New("source and destination are the same"))
+	}
+
+	collectSyncObjects(ctx, cmd, pk, &src)
+	collectSyncObjects(ctx, cmd, pk, &dst)
+
+	cmd.Printf("Source: %d objects, destination: %d objects.\n", len(src.objects), len(dst.objects))
+
+	var missing, extraneous []string
+
+	for k := range src.objects {
+		if _, ok := dst.objects[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+
+	removeExtraneous, _ := cmd.Flags().GetBool(syncDeleteFlag)
+	if removeExtraneous {
+		for k := range dst.objects {
+			if _, ok := src.objects[k]; !ok {
+				extraneous = append(extraneous, k)
+			}
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(extraneous)
+
+	dryRun, _ := cmd.Flags().GetBool(syncDryRunFlag)
+	if dryRun {
+		for _, k := range missing {
+			id, _ := src.objects[k].ID()
+			cmd.Printf("[%s] Object will be copied\n", id)
+		}
+
+		for _, k := range extraneous {
+			id, _ := dst.objects[k].ID()
+			cmd.Printf("[%s] Object will be removed\n", id)
+		}
+
+		cmd.Printf("To copy: %d, to remove: %d.\n", len(missing), len(extraneous))
+
+		return
+	}
+
+	ownerID := user.ResolveFromECDSAPublicKey(pk.PublicKey)
+
+	var putPrm internalclient.PutObjectPrm
+	putPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &putPrm)
+
+	if len(missing) > 0 {
+		OpenSessionViaClient(ctx, cmd, &putPrm, dst.cli, pk, dst.cnr, nil)
+	}
+
+	srcByID := make(map[string]*object.Object, len(missing))
+	missingIDs := make([]string, 0, len(missing))
+
+	for _, k := range missing {
+		id, _ := src.objects[k].ID()
+		srcByID[id.EncodeToString()] = src.objects[k]
+		missingIDs = append(missingIDs, id.EncodeToString())
+	}
+
+	failedCopy := runInParallel(cmd, missingIDs, func(id string) (string, error) {
+		newID, err := copySyncObject(ctx, cmd, pk, src, dst, putPrm, ownerID, srcByID[id])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("Object copied\n  OID: %s", newID), nil
+	})
+
+	extraneousIDs := make([]string, 0, len(extraneous))
+
+	for _, k := range extraneous {
+		id, _ := dst.objects[k].ID()
+		extraneousIDs = append(extraneousIDs, id.EncodeToString())
+	}
+
+	failedRemove := runInParallel(cmd, extraneousIDs, func(idStr string) (string, error) {
+		var id oid.ID
+		if err := id.DecodeString(idStr); err != nil {
+			return "", err
+		}
+
+		var addr oid.Address
+		addr.SetContainer(dst.cnr)
+		addr.SetObject(id)
+
+		var prm internalclient.DeleteObjectPrm
+		prm.SetPrivateKey(*pk)
+		Prepare(cmd, &prm)
+
+		OpenSessionViaClient(ctx, cmd, &prm, dst.cli, pk, dst.cnr, &id)
+
+		prm.SetAddress(addr)
+
+		_, err := internalclient.DeleteObject(ctx, prm)
+		if err != nil {
+			return "", fmt.Errorf("rpc error: %w", err)
+		}
+
+		return "Object removed", nil
+	})
+
+	cmd.Printf("Copied: %d, removed: %d, failed: %d.\n",
+		len(missing)-failedCopy, len(extraneous)-failedRemove, failedCopy+failedRemove)
+
+	if failedCopy+failedRemove > 0 {
+		common.ExitOnErr(cmd, "", fmt.Errorf("failed to synchronize %d objects", failedCopy+failedRemove))
+	}
+}
+
+func readSyncEndpoint(ctx context.Context, cmd *cobra.Command, flag string) syncEndpoint {
+	var res syncEndpoint
+
+	v, _ := cmd.Flags().GetString(flag)
+
+	cnrStr, endpoint, found := strings.Cut(v, "@")
+	if !found {
+		common.ExitOnErr(cmd, "", fmt.Errorf("invalid --%s value, expected <cid>@<rpc-endpoint>", flag))
+	}
+
+	err := res.cnr.DecodeString(cnrStr)
+	common.ExitOnErr(cmd, fmt.Sprintf("invalid --%s container ID: %%w", flag), err)
+
+	var addr network.Address
+
+	err = addr.FromString(endpoint)
+	common.ExitOnErr(cmd, fmt.Sprintf("invalid --%s endpoint: %%w", flag), err)
+
+	res.cli, err = internalclient.GetSDKClient(ctx, addr)
+	common.ExitOnErr(cmd, fmt.Sprintf("can't connect to --%s endpoint: %%w", flag), err)
+
+	return res
+}
+
+// collectSyncObjects reads headers of all regular root objects of the
+// endpoint container.
+func collectSyncObjects(ctx context.Context, cmd *cobra.Command, pk *ecdsa.PrivateKey, e *syncEndpoint) {
+	var filters object.SearchFilters
+	filters.AddRootFilter()
+	filters.AddTypeFilter(object.MatchStringEqual, object.TypeRegular)
+
+	var searchPrm internalclient.SearchObjectsPrm
+	searchPrm.SetClient(e.cli)
+	searchPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &searchPrm)
+	searchPrm.SetContainerID(e.cnr)
+	searchPrm.SetFilters(filters)
+
+	res, err := internalclient.SearchObjects(ctx, searchPrm)
+	common.ExitOnErr(cmd, fmt.Sprintf("search objects in %s: %%w", e.cnr), err)
+
+	var headPrm internalclient.HeadObjectPrm
+	headPrm.SetClient(e.cli)
+	headPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &headPrm)
+
+	ids := res.IDList()
+	e.objects = make(map[string]*object.Object, len(ids))
+
+	for i := range ids {
+		var addr oid.Address
+		addr.SetContainer(e.cnr)
+		addr.SetObject(ids[i])
+
+		headPrm.SetAddress(addr)
+
+		res, err := internalclient.HeadObject(ctx, headPrm)
+		common.ExitOnErr(cmd, fmt.Sprintf("head object %s: %%w", addr), err)
+
+		hdr := res.Header()
+		hdr.SetID(ids[i])
+
+		e.objects[syncObjectKey(hdr)] = hdr
+	}
+}
+
+// syncObjectKey returns key objects are compared by: payload checksum and
+// sorted attributes returned by syncAttributes.
+func syncObjectKey(hdr *object.Object) string {
+	var sb strings.Builder
+
+	if cs, ok := hdr.PayloadChecksum(); ok {
+		sb.WriteString(cs.Type().String())
+		sb.WriteString(":")
+		sb.WriteString(hex.EncodeToString(cs.Value()))
+	}
+
+	attrs := syncAttributes(hdr)
+
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].Key() != attrs[j].Key() {
+			return attrs[i].Key() < attrs[j].Key()
+		}
+
+		return attrs[i].Value() < attrs[j].Value()
+	})
+
+	for i := range attrs {
+		sb.WriteString("\x00")
+		sb.WriteString(attrs[i].Key())
+		sb.WriteString("=")
+		sb.WriteString(attrs[i].Value())
+	}
+
+	return sb.String()
+}
+
+// syncAttributes returns attributes of the object that are copied and
+// compared, see syncSkippedAttributes.
+func syncAttributes(hdr *object.Object) []object.Attribute {
+	attrs := hdr.Attributes()
+	res := make([]object.Attribute, 0, len(attrs))
+
+	for i := range attrs {
+		if _, ok := syncSkippedAttributes[attrs[i].Key()]; !ok {
+			res = append(res, attrs[i])
+		}
+	}
+
+	return res
+}
+
+// copySyncObject streams payload of the source object into the new object of
+// the destination container.
+func copySyncObject(ctx context.Context, cmd *cobra.Command, pk *ecdsa.PrivateKey, src, dst syncEndpoint, putPrm internalclient.PutObjectPrm, owner user.ID, hdr *object.Object) (oid.ID, error) {
+	id, _ := hdr.ID()
+
+	var addr oid.Address
+	addr.SetContainer(src.cnr)
+	addr.SetObject(id)
+
+	pr, pw := io.Pipe()
+
+	var getPrm internalclient.GetObjectPrm
+	getPrm.SetClient(src.cli)
+	getPrm.SetPrivateKey(*pk)
+	Prepare(cmd, &getPrm)
+	getPrm.SetAddress(addr)
+	getPrm.SetPayloadWriter(pw)
+
+	go func() {
+		_, err := internalclient.GetObject(ctx, getPrm)
+		_ = pw.CloseWithError(err)
+	}()
+
+	obj := object.New()
+	obj.SetContainerID(dst.cnr)
+	obj.SetOwnerID(&owner)
+	obj.SetAttributes(syncAttributes(hdr)...)
+
+	putPrm.SetHeader(obj)
+	putPrm.SetPayloadReader(pr)
+
+	res, err := internalclient.PutObject(ctx, putPrm)
+
+	// unblock payload reading if the writing failed
+	_ = pr.CloseWithError(io.ErrClosedPipe)
+
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	return res.ID(), nil
+}
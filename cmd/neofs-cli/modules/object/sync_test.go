@@ -0,0 +1,85 @@
+package object
+
+import (
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyncHeader(payload string, kv ...string) *object.Object {
+	attrs := make([]object.Attribute, 0, len(kv)/2)
+
+	for i := 0; i < len(kv); i += 2 {
+		var a object.Attribute
+		a.SetKey(kv[i])
+		a.SetValue(kv[i+1])
+
+		attrs = append(attrs, a)
+	}
+
+	obj := object.New()
+	obj.SetPayloadChecksum(object.CalculatePayloadChecksum([]byte(payload)))
+	obj.SetAttributes(attrs...)
+
+	return obj
+}
+
+func TestSyncAttributes(t *testing.T) {
+	hdr := testSyncHeader("payload",
+		object.AttributeFileName, "cat.jpg",
+		object.AttributeExpirationEpoch, "100",
+		v2object.SysAttributeTickEpoch, "90",
+		v2object.SysAttributeTickTopic, "topic",
+		v2object.SysAttributeUploadID, "upload",
+	)
+
+	attrs := syncAttributes(hdr)
+
+	keys := make([]string, 0, len(attrs))
+	for i := range attrs {
+		keys = append(keys, attrs[i].Key())
+	}
+
+	require.Equal(t, []string{
+		object.AttributeFileName,
+		object.AttributeExpirationEpoch,
+		v2object.SysAttributeTickEpoch,
+		v2object.SysAttributeTickTopic,
+	}, keys)
+}
+
+func TestSyncObjectKey(t *testing.T) {
+	key := syncObjectKey(testSyncHeader("payload",
+		object.AttributeFileName, "cat.jpg",
+		object.AttributeExpirationEpoch, "100",
+	))
+
+	// attribute order and upload ID do not matter
+	require.Equal(t, key, syncObjectKey(testSyncHeader("payload",
+		object.AttributeExpirationEpoch, "100",
+		v2object.SysAttributeUploadID, "upload",
+		object.AttributeFileName, "cat.jpg",
+	)))
+
+	for _, hdr := range []*object.Object{
+		testSyncHeader("other payload",
+			object.AttributeFileName, "cat.jpg",
+			object.AttributeExpirationEpoch, "100",
+		),
+		testSyncHeader("payload",
+			object.AttributeFileName, "dog.jpg",
+			object.AttributeExpirationEpoch, "100",
+		),
+		testSyncHeader("payload",
+			object.AttributeFileName, "cat.jpg",
+			object.AttributeExpirationEpoch, "200",
+		),
+		testSyncHeader("payload",
+			object.AttributeFileName, "cat.jpg",
+		),
+	} {
+		require.NotEqual(t, key, syncObjectKey(hdr))
+	}
+}
@@ -278,9 +278,9 @@ func OpenSessionViaClient(ctx context.Context, cmd *cobra.Command, dst SessionPr
 	const sessionLifetime = 10 // in NeoFS epochs
 
 	common.PrintVerbose(cmd, "Opening remote session with the node...")
-	endpoint, _ := cmd.Flags().GetString(commonflags.RPC)
-	currEpoch, err := internal.GetCurrentEpoch(ctx, endpoint)
+	ni, err := cli.NetworkInfo(ctx, client.PrmNetworkInfo{})
 	common.ExitOnErr(cmd, "can't fetch current epoch: %w", err)
+	currEpoch := ni.CurrentEpoch()
 	exp := currEpoch + sessionLifetime
 	err = sessionCli.CreateSession(ctx, &tok, cli, *key, exp, currEpoch)
 	common.ExitOnErr(cmd, "open remote session: %w", err)
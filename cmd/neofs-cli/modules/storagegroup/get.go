@@ -2,12 +2,15 @@ package storagegroup
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
 	objectCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/object"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	storagegroupSDK "github.com/nspcc-dev/neofs-sdk-go/storagegroup"
@@ -47,16 +50,36 @@ func getSG(cmd *cobra.Command, _ []string) {
 
 	addr := readObjectAddress(cmd, &cnr, &obj)
 	pk := key.GetOrGenerate(cmd)
-	buf := bytes.NewBuffer(nil)
 
 	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
 
+	raw, _ := cmd.Flags().GetBool(sgRawFlag)
+
+	sg := readStorageGroup(ctx, cmd, cli, pk, addr, raw)
+
+	cmd.Printf("The last active epoch: %d\n", sg.ExpirationEpoch())
+	cmd.Printf("Group size: %d\n", sg.ValidationDataSize())
+	common.PrintChecksum(cmd, "Group hash", sg.ValidationDataHash)
+
+	if members := sg.Members(); len(members) > 0 {
+		cmd.Println("Members:")
+
+		for i := range members {
+			cmd.Printf("\t%s\n", members[i].String())
+		}
+	}
+}
+
+// readStorageGroup reads storage group from the object with the given address.
+// On error, outputs to stderr of cmd and exits with non-zero code.
+func readStorageGroup(ctx context.Context, cmd *cobra.Command, cli *client.Client, pk *ecdsa.PrivateKey,
+	addr oid.Address, raw bool) storagegroupSDK.StorageGroup {
+	buf := bytes.NewBuffer(nil)
+
 	var prm internalclient.GetObjectPrm
 	objectCli.Prepare(cmd, &prm)
 	prm.SetClient(cli)
 	prm.SetPrivateKey(*pk)
-
-	raw, _ := cmd.Flags().GetBool(sgRawFlag)
 	prm.SetRawFlag(raw)
 	prm.SetAddress(addr)
 	prm.SetPayloadWriter(buf)
@@ -72,15 +95,5 @@ func getSG(cmd *cobra.Command, _ []string) {
 	err = storagegroupSDK.ReadFromObject(&sg, *rawObj)
 	common.ExitOnErr(cmd, "could not read storage group from the obj: %w", err)
 
-	cmd.Printf("The last active epoch: %d\n", sg.ExpirationEpoch())
-	cmd.Printf("Group size: %d\n", sg.ValidationDataSize())
-	common.PrintChecksum(cmd, "Group hash", sg.ValidationDataHash)
-
-	if members := sg.Members(); len(members) > 0 {
-		cmd.Println("Members:")
-
-		for i := range members {
-			cmd.Printf("\t%s\n", members[i].String())
-		}
-	}
+	return sg
 }
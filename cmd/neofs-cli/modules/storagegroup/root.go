@@ -30,6 +30,7 @@ func init() {
 		sgGetCmd,
 		sgListCmd,
 		sgDelCmd,
+		sgVerifyCmd,
 	}
 
 	Cmd.AddCommand(storageGroupChildCommands...)
@@ -43,4 +44,5 @@ func init() {
 	initSGGetCmd()
 	initSGListCmd()
 	initSGDeleteCmd()
+	initSGVerifyCmd()
 }
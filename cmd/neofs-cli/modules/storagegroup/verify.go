@@ -0,0 +1,615 @@
+package storagegroup
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	objectCli "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/modules/object"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+)
+
+const sgReplicateFlag = "replicate"
+
+var sgVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify storage group members",
+	Long: `Verify storage group members: check that every member is stored on the
+nodes of the container placement and its payload matches the header checksum,
+recompute the total size and the homomorphic hash of the members and compare
+them with the values declared in the storage group. With --replicate flag
+missing copies of the members are restored from the healthy ones.`,
+	Args: cobra.NoArgs,
+	Run:  verifySG,
+}
+
+func initSGVerifyCmd() {
+	commonflags.Init(sgVerifyCmd)
+
+	flags := sgVerifyCmd.Flags()
+
+	flags.String(commonflags.CIDFlag, "", commonflags.CIDFlagUsage)
+	_ = sgVerifyCmd.MarkFlagRequired(commonflags.CIDFlag)
+
+	flags.String(sgIDFlag, "", "Storage group identifier")
+	_ = sgVerifyCmd.MarkFlagRequired(sgIDFlag)
+
+	flags.Bool(sgReplicateFlag, false, "Restore missing copies of the members from the healthy ones")
+}
+
+type copyStatus uint8
+
+const (
+	copyOK copyStatus = iota
+	copyMissing
+	copyCorrupted
+	copyUnavailable
+	copyRestored
+)
+
+func (s copyStatus) String() string {
+	switch s {
+	case copyOK:
+		return "OK"
+	case copyMissing:
+		return "MISSING"
+	case copyCorrupted:
+		return "CORRUPTED"
+	case copyUnavailable:
+		return "UNAVAILABLE"
+	case copyRestored:
+		return "RESTORED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// memberCopy describes the state of the member copy on the placement node.
+type memberCopy struct {
+	node   netmap.NodeInfo
+	status copyStatus
+	err    error
+}
+
+// memberReport describes the state of the storage group member.
+type memberReport struct {
+	id oid.ID
+
+	// header of any healthy copy, nil if there is no such copy
+	header *object.Object
+	holder netmap.NodeInfo
+
+	reps    []uint32
+	vectors [][]memberCopy
+}
+
+// healthy returns number of healthy copies in i-th placement vector.
+func (r memberReport) healthy(i int) uint32 {
+	var n uint32
+
+	for _, c := range r.vectors[i] {
+		if c.status == copyOK || c.status == copyRestored {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (r memberReport) status() string {
+	if r.header == nil {
+		return "MISSING"
+	}
+
+	for i := range r.vectors {
+		if r.healthy(i) < r.reps[i] {
+			return "UNDER-REPLICATED"
+		}
+
+		for _, c := range r.vectors[i] {
+			if c.status == copyCorrupted {
+				return "CORRUPTED COPIES"
+			}
+		}
+	}
+
+	return "OK"
+}
+
+// memberStorage provides access to the member copies stored on the
+// container nodes.
+type memberStorage interface {
+	// head returns header of the object stored on the node.
+	head(node netmap.NodeInfo, addr oid.Address) (*object.Object, error)
+	// hash returns checksum of the first ln bytes of the object payload
+	// stored on the node.
+	hash(node netmap.NodeInfo, addr oid.Address, ln uint64, homomorphic bool) ([]byte, error)
+	// get reads the object stored on the node. Header is passed to the
+	// handler before the payload is written to w.
+	get(node netmap.NodeInfo, addr oid.Address, hdrHandler func(*object.Object), w io.Writer) error
+	// put saves the object with the payload read from r on the node.
+	put(node netmap.NodeInfo, hdr *object.Object, r io.Reader) error
+}
+
+type sgVerifier struct {
+	storage memberStorage
+
+	homomorphic bool
+}
+
+func verifySG(cmd *cobra.Command, _ []string) {
+	ctx, cancel := commonflags.GetCommandContext(cmd)
+	defer cancel()
+
+	var cnr cid.ID
+	var obj oid.ID
+
+	addr := readObjectAddress(cmd, &cnr, &obj)
+	pk := key.GetOrGenerate(cmd)
+
+	cli := internalclient.GetSDKClientByFlag(ctx, cmd, commonflags.RPC)
+
+	sg := readStorageGroup(ctx, cmd, cli, pk, addr, false)
+
+	var prmCnr internalclient.GetContainerPrm
+	prmCnr.SetClient(cli)
+	prmCnr.SetContainer(cnr)
+
+	resCnr, err := internalclient.GetContainer(ctx, prmCnr)
+	common.ExitOnErr(cmd, "could not get container: %w", err)
+
+	var prmSnap internalclient.NetMapSnapshotPrm
+	prmSnap.SetClient(cli)
+
+	resSnap, err := internalclient.NetMapSnapshot(ctx, prmSnap)
+	common.ExitOnErr(cmd, "could not get netmap snapshot: %w", err)
+
+	nm := resSnap.NetMap()
+	policy := resCnr.Container().PlacementPolicy()
+
+	cnrNodes, err := nm.ContainerNodes(policy, cnr)
+	common.ExitOnErr(cmd, "could not build container nodes: %w", err)
+
+	storage := &networkStorage{
+		ctx:     ctx,
+		cmd:     cmd,
+		key:     pk,
+		clients: make(map[string]*client.Client),
+	}
+	defer storage.close()
+
+	v := &sgVerifier{
+		storage:     storage,
+		homomorphic: !resCnr.Container().IsHomomorphicHashingDisabled(),
+	}
+
+	replicate, _ := cmd.Flags().GetBool(sgReplicateFlag)
+
+	members := sg.Members()
+	reports := make([]memberReport, 0, len(members))
+
+	for _, member := range members {
+		vectors, err := nm.PlacementVectors(cnrNodes, member)
+		common.ExitOnErr(cmd, "could not build placement of the member: %w", err)
+
+		r := v.checkMember(cnr, member, policy, vectors)
+		if replicate && r.header != nil {
+			v.restoreMember(cnr, &r)
+		}
+
+		reports = append(reports, r)
+	}
+
+	failed := printMemberReports(cmd, reports)
+
+	if !compareValidationData(cmd, v.homomorphic, sg.ValidationDataSize(), sg.ValidationDataHash, reports) {
+		failed = true
+	}
+
+	if failed {
+		common.ExitOnErr(cmd, "", errors.New("storage group verification failed"))
+	}
+
+	cmd.Println("Storage group is healthy")
+}
+
+func (v *sgVerifier) checkMember(cnr cid.ID, member oid.ID, policy netmap.PlacementPolicy, vectors [][]netmap.NodeInfo) memberReport {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(member)
+
+	r := memberReport{
+		id:      member,
+		reps:    make([]uint32, len(vectors)),
+		vectors: make([][]memberCopy, len(vectors)),
+	}
+
+	for i := range vectors {
+		r.reps[i] = policy.ReplicaNumberByIndex(i)
+		r.vectors[i] = make([]memberCopy, 0, len(vectors[i]))
+
+		for _, node := range vectors[i] {
+			hdr, c := v.checkCopy(addr, node)
+			if c.status == copyOK && r.header == nil {
+				r.header = hdr
+				r.holder = node
+			}
+
+			r.vectors[i] = append(r.vectors[i], c)
+		}
+	}
+
+	return r
+}
+
+// checkCopy checks member copy stored on the node. Header is returned
+// if the copy is healthy.
+func (v *sgVerifier) checkCopy(addr oid.Address, node netmap.NodeInfo) (*object.Object, memberCopy) {
+	res := memberCopy{node: node}
+
+	hdr, err := v.storage.head(node, addr)
+	if err != nil {
+		if errors.Is(err, apistatus.ErrObjectNotFound) || errors.Is(err, apistatus.ErrObjectAlreadyRemoved) {
+			res.status = copyMissing
+		} else {
+			res.status = copyUnavailable
+		}
+
+		res.err = err
+
+		return nil, res
+	}
+
+	var (
+		cs    checksum.Checksum
+		csSet bool
+	)
+
+	if v.homomorphic {
+		cs, csSet = hdr.PayloadHomomorphicHash()
+	} else {
+		cs, csSet = hdr.PayloadChecksum()
+	}
+
+	if !csSet {
+		res.status, res.err = copyCorrupted, errors.New("missing payload checksum in the header")
+		return nil, res
+	}
+
+	if sz := hdr.PayloadSize(); sz > 0 {
+		h, err := v.storage.hash(node, addr, sz, v.homomorphic)
+		if err != nil {
+			res.status, res.err = copyCorrupted, fmt.Errorf("could not hash payload: %w", err)
+			return nil, res
+		}
+
+		if !bytes.Equal(h, cs.Value()) {
+			res.status, res.err = copyCorrupted, errors.New("payload does not match the header checksum")
+			return nil, res
+		}
+	}
+
+	res.status = copyOK
+
+	return hdr, res
+}
+
+// restoreMember copies the member from the healthy holder to the nodes
+// missing it until the number of healthy copies in each placement vector
+// is satisfied.
+func (v *sgVerifier) restoreMember(cnr cid.ID, r *memberReport) {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(r.id)
+
+	for i := range r.vectors {
+		need := int(r.reps[i]) - int(r.healthy(i))
+
+		for j := range r.vectors[i] {
+			if need <= 0 {
+				break
+			}
+
+			c := &r.vectors[i][j]
+			if c.status != copyMissing {
+				continue
+			}
+
+			err := v.copyMember(addr, r.holder, c.node)
+			if err != nil {
+				c.err = fmt.Errorf("could not restore: %w", err)
+				continue
+			}
+
+			c.status, c.err = copyRestored, nil
+			need--
+		}
+	}
+}
+
+// copyMember streams the member from one node to another without buffering
+// its payload.
+func (v *sgVerifier) copyMember(addr oid.Address, from, to netmap.NodeInfo) error {
+	var (
+		getErr error
+		hdrCh  = make(chan *object.Object, 1)
+		pr, pw = io.Pipe()
+	)
+
+	go func() {
+		defer close(hdrCh)
+
+		getErr = v.storage.get(from, addr, func(hdr *object.Object) { hdrCh <- hdr }, pw)
+
+		_ = pw.CloseWithError(getErr)
+	}()
+
+	hdr, ok := <-hdrCh
+	if !ok {
+		return fmt.Errorf("could not get the member: %w", getErr)
+	}
+
+	err := v.storage.put(to, hdr, pr)
+
+	// unblock the reading routine if the payload was not read completely
+	_ = pr.Close()
+	<-hdrCh
+
+	// put failure closes the pipe, so reading error is secondary then
+	if err != nil {
+		return fmt.Errorf("could not put the member: %w", err)
+	}
+
+	if getErr != nil {
+		return fmt.Errorf("could not get the member: %w", getErr)
+	}
+
+	return nil
+}
+
+// networkStorage is memberStorage accessing the container nodes over the
+// network.
+type networkStorage struct {
+	ctx context.Context
+	cmd *cobra.Command
+	key *ecdsa.PrivateKey
+
+	clients map[string]*client.Client
+}
+
+func (s *networkStorage) close() {
+	for _, c := range s.clients {
+		_ = c.Close()
+	}
+}
+
+// nodeClient returns client connected to any of the node's network endpoints.
+func (s *networkStorage) nodeClient(node netmap.NodeInfo) (*client.Client, error) {
+	strKey := string(node.PublicKey())
+
+	if c, ok := s.clients[strKey]; ok {
+		return c, nil
+	}
+
+	var (
+		c       *client.Client
+		lastErr = errors.New("no network endpoints")
+	)
+
+	node.IterateNetworkEndpoints(func(endpoint string) bool {
+		var addr network.Address
+
+		err := addr.FromString(endpoint)
+		if err == nil {
+			c, err = internalclient.GetSDKClient(s.ctx, addr)
+			if err == nil {
+				return true
+			}
+		}
+
+		lastErr = fmt.Errorf("%s: %w", endpoint, err)
+
+		return false
+	})
+
+	if c == nil {
+		return nil, fmt.Errorf("could not connect to the node: %w", lastErr)
+	}
+
+	s.clients[strKey] = c
+
+	return c, nil
+}
+
+func (s *networkStorage) head(node netmap.NodeInfo, addr oid.Address) (*object.Object, error) {
+	cli, err := s.nodeClient(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.HeadObjectPrm
+	objectCli.Prepare(s.cmd, &prm)
+	prm.SetTTL(1)
+	prm.SetRawFlag(true)
+	prm.SetClient(cli)
+	prm.SetPrivateKey(*s.key)
+	prm.SetAddress(addr)
+
+	res, err := internalclient.HeadObject(s.ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Header(), nil
+}
+
+func (s *networkStorage) hash(node netmap.NodeInfo, addr oid.Address, ln uint64, homomorphic bool) ([]byte, error) {
+	cli, err := s.nodeClient(node)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := object.NewRange()
+	rng.SetLength(ln)
+
+	var prm internalclient.HashPayloadRangesPrm
+	objectCli.Prepare(s.cmd, &prm)
+	prm.SetTTL(1)
+	prm.SetClient(cli)
+	prm.SetPrivateKey(*s.key)
+	prm.SetAddress(addr)
+	prm.SetRanges([]*object.Range{rng})
+
+	if homomorphic {
+		prm.TZ()
+	}
+
+	res, err := internalclient.HashPayloadRanges(s.ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := res.HashList()
+	if len(hs) != 1 {
+		return nil, fmt.Errorf("wrong number of hashes %d", len(hs))
+	}
+
+	return hs[0], nil
+}
+
+func (s *networkStorage) get(node netmap.NodeInfo, addr oid.Address, hdrHandler func(*object.Object), w io.Writer) error {
+	cli, err := s.nodeClient(node)
+	if err != nil {
+		return err
+	}
+
+	var prm internalclient.GetObjectPrm
+	objectCli.Prepare(s.cmd, &prm)
+	prm.SetTTL(1)
+	prm.SetRawFlag(true)
+	prm.SetClient(cli)
+	prm.SetPrivateKey(*s.key)
+	prm.SetAddress(addr)
+	prm.SetHeaderCallback(hdrHandler)
+	prm.SetPayloadWriter(w)
+
+	_, err = internalclient.GetObject(s.ctx, prm)
+
+	return err
+}
+
+func (s *networkStorage) put(node netmap.NodeInfo, hdr *object.Object, r io.Reader) error {
+	cli, err := s.nodeClient(node)
+	if err != nil {
+		return err
+	}
+
+	var prm internalclient.PutObjectPrm
+	objectCli.Prepare(s.cmd, &prm)
+	prm.SetTTL(1)
+	prm.SetClient(cli)
+	prm.SetPrivateKey(*s.key)
+	prm.SetHeader(hdr)
+	prm.SetPayloadReader(r)
+
+	_, err = internalclient.PutObject(s.ctx, prm)
+
+	return err
+}
+
+// printMemberReports prints reports of the members and returns true
+// if any of them has a problem.
+func printMemberReports(cmd *cobra.Command, reports []memberReport) bool {
+	var failed bool
+
+	for _, r := range reports {
+		st := r.status()
+		if st != "OK" {
+			failed = true
+		}
+
+		cmd.Printf("Member %s: %s\n", r.id, st)
+
+		for i := range r.vectors {
+			cmd.Printf("\tDescriptor #%d, REP %d, healthy %d:\n", i+1, r.reps[i], r.healthy(i))
+
+			for _, c := range r.vectors[i] {
+				cmd.Printf("\t\t%s %s", hex.EncodeToString(c.node.PublicKey()), c.status)
+
+				if c.err != nil {
+					cmd.Printf(": %v", c.err)
+				}
+
+				cmd.Println()
+			}
+		}
+	}
+
+	return failed
+}
+
+// compareValidationData recomputes total size and homomorphic hash of
+// the members and compares them with the declared ones. Returns false
+// on mismatch or if the values can not be recomputed.
+func compareValidationData(cmd *cobra.Command, homomorphic bool, size uint64, hash func() (checksum.Checksum, bool), reports []memberReport) bool {
+	var (
+		sumSize uint64
+		hashes  = make([][]byte, 0, len(reports))
+	)
+
+	for _, r := range reports {
+		if r.header == nil {
+			cmd.Println("Total size and hash can not be recomputed: some members are missing")
+			return false
+		}
+
+		sumSize += r.header.PayloadSize()
+
+		if homomorphic {
+			cs, _ := r.header.PayloadHomomorphicHash()
+			hashes = append(hashes, cs.Value())
+		}
+	}
+
+	ok := sumSize == size
+
+	cmd.Printf("Size: declared %d, actual %d\n", size, sumSize)
+
+	if !homomorphic {
+		return ok
+	}
+
+	declared, set := hash()
+	if !set {
+		cmd.Println("Homomorphic hash is not declared")
+		return false
+	}
+
+	sumHash, err := tz.Concat(hashes)
+	if err != nil {
+		cmd.Printf("Homomorphic hash can not be recomputed: %v\n", err)
+		return false
+	}
+
+	cmd.Printf("Homomorphic hash: declared %s, actual %s\n",
+		hex.EncodeToString(declared.Value()), hex.EncodeToString(sumHash))
+
+	return ok && bytes.Equal(declared.Value(), sumHash)
+}
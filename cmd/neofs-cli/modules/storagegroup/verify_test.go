@@ -0,0 +1,257 @@
+package storagegroup
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// testStorage stores single member copy per node, nodes are identified by
+// the first byte of the public key.
+type testStorage struct {
+	copies map[byte]*object.Object
+
+	getErr, putErr error
+}
+
+func (x *testStorage) copyOf(node netmap.NodeInfo) (*object.Object, error) {
+	obj, ok := x.copies[node.PublicKey()[0]]
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	return obj, nil
+}
+
+func (x *testStorage) head(node netmap.NodeInfo, _ oid.Address) (*object.Object, error) {
+	obj, err := x.copyOf(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.CutPayload(), nil
+}
+
+func (x *testStorage) hash(node netmap.NodeInfo, _ oid.Address, ln uint64, homomorphic bool) ([]byte, error) {
+	obj, err := x.copyOf(node)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := obj.Payload()[:ln]
+
+	if homomorphic {
+		h := tz.Sum(payload)
+		return h[:], nil
+	}
+
+	return object.CalculatePayloadChecksum(payload).Value(), nil
+}
+
+func (x *testStorage) get(node netmap.NodeInfo, _ oid.Address, hdrHandler func(*object.Object), w io.Writer) error {
+	obj, err := x.copyOf(node)
+	if err != nil {
+		return err
+	}
+
+	if x.getErr != nil {
+		return x.getErr
+	}
+
+	hdrHandler(obj.CutPayload())
+
+	_, err = w.Write(obj.Payload())
+
+	return err
+}
+
+func (x *testStorage) put(node netmap.NodeInfo, hdr *object.Object, r io.Reader) error {
+	if x.putErr != nil {
+		return x.putErr
+	}
+
+	payload, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	obj := *hdr
+	obj.SetPayload(payload)
+
+	x.copies[node.PublicKey()[0]] = &obj
+
+	return nil
+}
+
+func testNode(id byte) netmap.NodeInfo {
+	var n netmap.NodeInfo
+	n.SetPublicKey([]byte{id})
+	return n
+}
+
+func testMember(payload []byte) *object.Object {
+	var tzCS checksum.Checksum
+	tzCS.SetTillichZemor(tz.Sum(payload))
+
+	obj := object.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetID(oidtest.ID())
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(object.CalculatePayloadChecksum(payload))
+	obj.SetPayloadHomomorphicHash(tzCS)
+
+	return obj
+}
+
+func testPolicy(rep uint32) netmap.PlacementPolicy {
+	var r netmap.ReplicaDescriptor
+	r.SetNumberOfObjects(rep)
+
+	var p netmap.PlacementPolicy
+	p.AddReplicas(r)
+
+	return p
+}
+
+func copyStatuses(r memberReport) []copyStatus {
+	res := make([]copyStatus, 0, len(r.vectors[0]))
+
+	for _, c := range r.vectors[0] {
+		res = append(res, c.status)
+	}
+
+	return res
+}
+
+func TestSGVerifier_checkMember(t *testing.T) {
+	member := testMember([]byte("member payload"))
+
+	corrupted := *member
+	corrupted.SetPayload([]byte("member PAYLOAD"))
+
+	cnr, _ := member.ContainerID()
+	id, _ := member.ID()
+	vectors := [][]netmap.NodeInfo{{testNode(0), testNode(1), testNode(2)}}
+
+	for _, homomorphic := range []bool{true, false} {
+		s := &testStorage{copies: map[byte]*object.Object{
+			0: &corrupted,
+			1: member,
+		}}
+
+		v := &sgVerifier{storage: s, homomorphic: homomorphic}
+
+		r := v.checkMember(cnr, id, testPolicy(2), vectors)
+
+		require.Equal(t, []copyStatus{copyCorrupted, copyOK, copyMissing}, copyStatuses(r))
+		require.EqualError(t, r.vectors[0][0].err, "payload does not match the header checksum")
+		require.Equal(t, byte(1), r.holder.PublicKey()[0])
+		require.Equal(t, member.PayloadSize(), r.header.PayloadSize())
+		require.EqualValues(t, 1, r.healthy(0))
+		require.Equal(t, "UNDER-REPLICATED", r.status())
+	}
+}
+
+func TestSGVerifier_restoreMember(t *testing.T) {
+	member := testMember([]byte("member payload"))
+
+	cnr, _ := member.ContainerID()
+	id, _ := member.ID()
+	vectors := [][]netmap.NodeInfo{{testNode(0), testNode(1), testNode(2), testNode(3)}}
+
+	t.Run("restored", func(t *testing.T) {
+		s := &testStorage{copies: map[byte]*object.Object{0: member}}
+		v := &sgVerifier{storage: s, homomorphic: true}
+
+		r := v.checkMember(cnr, id, testPolicy(3), vectors)
+		v.restoreMember(cnr, &r)
+
+		require.Equal(t, []copyStatus{copyOK, copyRestored, copyRestored, copyMissing}, copyStatuses(r))
+		require.Equal(t, "OK", r.status())
+
+		for _, n := range []byte{1, 2} {
+			require.Equal(t, member.Payload(), s.copies[n].Payload())
+		}
+
+		require.NotContains(t, s.copies, byte(3))
+
+		// restored copies pass the check
+		r = v.checkMember(cnr, id, testPolicy(3), vectors)
+		require.Equal(t, []copyStatus{copyOK, copyOK, copyOK, copyMissing}, copyStatuses(r))
+	})
+
+	for _, tc := range []struct {
+		name string
+		s    *testStorage
+		err  string
+	}{
+		{
+			name: "get failure",
+			s:    &testStorage{getErr: errors.New("any get error")},
+			err:  "could not restore: could not get the member: any get error",
+		},
+		{
+			name: "put failure",
+			s:    &testStorage{putErr: errors.New("any put error")},
+			err:  "could not restore: could not put the member: any put error",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.s.copies = map[byte]*object.Object{0: member}
+			v := &sgVerifier{storage: tc.s, homomorphic: true}
+
+			r := v.checkMember(cnr, id, testPolicy(2), vectors)
+			v.restoreMember(cnr, &r)
+
+			require.Equal(t, []copyStatus{copyOK, copyMissing, copyMissing, copyMissing}, copyStatuses(r))
+			require.EqualError(t, r.vectors[0][1].err, tc.err)
+			require.Equal(t, "UNDER-REPLICATED", r.status())
+		})
+	}
+}
+
+func TestCompareValidationData(t *testing.T) {
+	m1 := testMember([]byte("first member"))
+	m2 := testMember([]byte("second member"))
+
+	h1, _ := m1.PayloadHomomorphicHash()
+	h2, _ := m2.PayloadHomomorphicHash()
+
+	sumHash, err := tz.Concat([][]byte{h1.Value(), h2.Value()})
+	require.NoError(t, err)
+
+	reports := []memberReport{{header: m1}, {header: m2}}
+	size := m1.PayloadSize() + m2.PayloadSize()
+
+	declaredHash := func(v []byte) func() (checksum.Checksum, bool) {
+		return func() (checksum.Checksum, bool) {
+			var h [tz.Size]byte
+			copy(h[:], v)
+
+			var cs checksum.Checksum
+			cs.SetTillichZemor(h)
+			return cs, true
+		}
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(io.Discard)
+
+	require.True(t, compareValidationData(cmd, true, size, declaredHash(sumHash), reports))
+	require.False(t, compareValidationData(cmd, true, size+1, declaredHash(sumHash), reports))
+	require.False(t, compareValidationData(cmd, true, size, declaredHash(h1.Value()), reports))
+	require.True(t, compareValidationData(cmd, false, size, declaredHash(h1.Value()), reports))
+	require.False(t, compareValidationData(cmd, true, size, declaredHash(sumHash), append(reports, memberReport{})))
+}
@@ -50,11 +50,19 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("morph.dial_timeout", 15*time.Second)
 	cfg.SetDefault("morph.reconnections_number", 5)
 	cfg.SetDefault("morph.reconnections_delay", 5*time.Second)
+	cfg.SetDefault("morph.health.interval", 30*time.Second)
+	cfg.SetDefault("morph.health.max_lag", 10)
+	cfg.SetDefault("morph.health.max_error_rate", 0.5)
+	cfg.SetDefault("morph.health.max_latency", 0)
 	cfg.SetDefault("morph.validators", []string{})
 
 	cfg.SetDefault("mainnet.dial_timeout", 15*time.Second)
 	cfg.SetDefault("mainnet.reconnections_number", 5)
 	cfg.SetDefault("mainnet.reconnections_delay", 5*time.Second)
+	cfg.SetDefault("mainnet.health.interval", 30*time.Second)
+	cfg.SetDefault("mainnet.health.max_lag", 10)
+	cfg.SetDefault("mainnet.health.max_error_rate", 0.5)
+	cfg.SetDefault("mainnet.health.max_latency", 0)
 
 	cfg.SetDefault("wallet.path", "")     // inner ring node NEP-6 wallet
 	cfg.SetDefault("wallet.address", "")  // account address
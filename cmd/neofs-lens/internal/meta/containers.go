@@ -0,0 +1,61 @@
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var containersCMD = &cobra.Command{
+	Use:   "containers",
+	Short: "Container listing",
+	Long:  `List all the containers which objects are stored in the metabase with their estimated sizes, largest first.`,
+	Args:  cobra.NoArgs,
+	Run:   containersFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(containersCMD, &vPath)
+}
+
+func containersFunc(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	list, err := db.Containers()
+	common.ExitOnErr(cmd, common.Errf("could not list containers: %w", err))
+
+	sizes := make(map[cid.ID]uint64, len(list))
+
+	var total uint64
+	for _, cnr := range list {
+		size, err := db.ContainerSize(cnr)
+		common.ExitOnErr(cmd, common.Errf(fmt.Sprintf("could not get size of container %s: %%w", cnr), err))
+
+		sizes[cnr] = size
+		total += size
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if sizes[list[i]] != sizes[list[j]] {
+			return sizes[list[i]] > sizes[list[j]]
+		}
+
+		return list[i].EncodeToString() < list[j].EncodeToString()
+	})
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "CONTAINER\tSIZE")
+
+	for _, cnr := range list {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\n", cnr, sizes[cnr])
+	}
+
+	_ = tw.Flush()
+
+	cmd.Printf("Total: %d containers, %d bytes\n", len(list), total)
+}
@@ -0,0 +1,314 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
+	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
+	peapodconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/peapod"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	blobstorcommon "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/peapod"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	flagShard = "shard"
+	flagOut   = "out"
+)
+
+var rebuildCMD = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Rebuild metabase from the BLOB storage",
+	Long: `Rebuild metabase of the shard from the objects stored in its BLOB storage. The shard
+is selected from the node config by the path of its directory or any of its components.
+Every sub-storage is iterated, object indexes, graveyard for tombstones, lock records and
+storage IDs are written to the new metabase file. Objects that can not be parsed are reported.
+Objects in the write-cache are indexed too, without storage IDs, the same way the shard
+stores them on PUT. The metabase is written to the configured path unless --out is specified,
+the file must not exist. Node must be stopped.`,
+	Args: cobra.NoArgs,
+	Run:  rebuildFunc,
+}
+
+func init() {
+	common.AddConfigFileFlag(rebuildCMD, &vConfig)
+
+	flags := rebuildCMD.Flags()
+
+	flags.StringVar(&vShard, flagShard, "", "Path to the shard directory or any of its components")
+	_ = rebuildCMD.MarkFlagRequired(flagShard)
+	flags.StringVar(&vOut, flagOut, "", "Path to the new metabase file (defaults to the configured one)")
+	_ = rebuildCMD.MarkFlagFilename(flagOut)
+}
+
+func rebuildFunc(cmd *cobra.Command, _ []string) {
+	sc := findShard(cmd)
+
+	out := vOut
+	if out == "" {
+		out = sc.Metabase().Path()
+	}
+
+	_, err := os.Stat(out)
+	if err == nil {
+		common.ExitOnErr(cmd, fmt.Errorf("metabase file %s already exists, remove it or use --%s", out, flagOut))
+	} else if !errors.Is(err, os.ErrNotExist) {
+		common.ExitOnErr(cmd, common.Errf("could not check metabase file: %w", err))
+	}
+
+	cc := compression.Config{
+		Enabled:                    sc.Compress(),
+		UncompressableContentTypes: sc.UncompressableContentTypes(),
+	}
+	common.ExitOnErr(cmd, common.Errf("could not init compression: %w", cc.Init()))
+
+	defer func() { _ = cc.Close() }()
+
+	storages := shardSubStorages(cmd, sc)
+	for i := range storages {
+		storages[i].SetCompressor(&cc)
+	}
+
+	var wcPath string
+	if sc.WriteCache().Enabled() {
+		wcPath = sc.WriteCache().Path()
+	}
+
+	db := meta.New(
+		meta.WithPath(out),
+		meta.WithPermissions(sc.Metabase().BoltDB().Perm()),
+		meta.WithBoltDBOptions(&bbolt.Options{
+			Timeout: 100 * time.Millisecond,
+		}),
+		meta.WithEpochState(epochState{}),
+	)
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(false)))
+	common.ExitOnErr(cmd, common.Errf("could not init metabase: %w", db.Init()))
+
+	defer db.Close()
+
+	res, err := rebuildMetabase(db, storages, wcPath, func(obj string, err error) {
+		cmd.Printf("Object %s: %s\n", obj, err)
+	})
+	common.ExitOnErr(cmd, err)
+
+	cmd.Printf("Metabase %s rebuilt: %d objects indexed, %d removed objects skipped, %d objects failed.\n",
+		out, res.indexed, res.removed, res.failed)
+}
+
+// rebuildRes groups numbers of objects processed by rebuildMetabase.
+type rebuildRes struct {
+	indexed, removed, failed int
+}
+
+// rebuildMetabase indexes all objects from the given BLOB sub-storages and
+// the write-cache located at wcPath (if set) in the metabase. Objects that
+// can not be indexed are passed to report.
+func rebuildMetabase(db *meta.DB, storages []blobstorcommon.Storage, wcPath string, report func(string, error)) (rebuildRes, error) {
+	var res rebuildRes
+
+	fail := func(obj string, err error) {
+		res.failed++
+		report(obj, err)
+	}
+
+	index := func(obj *objectSDK.Object, storageID []byte) {
+		err := db.IndexObject(obj, storageID)
+		switch {
+		case err == nil:
+			res.indexed++
+		case meta.IsErrRemoved(err), errors.Is(err, meta.ErrObjectIsExpired):
+			res.removed++
+		default:
+			fail(objectcore.AddressOf(obj).EncodeToString(), err)
+		}
+	}
+
+	var prm blobstorcommon.IteratePrm
+	prm.IgnoreErrors = true
+	prm.ErrorHandler = func(addr oid.Address, err error) error {
+		fail(addr.EncodeToString(), err)
+		return nil
+	}
+	prm.Handler = func(elem blobstorcommon.IterationElement) error {
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(elem.ObjectData); err != nil {
+			fail(elem.Address.EncodeToString(), fmt.Errorf("could not unmarshal object: %w", err))
+			return nil
+		}
+
+		index(obj, elem.StorageID)
+
+		return nil
+	}
+
+	for _, st := range storages {
+		if err := st.Open(true); err != nil {
+			return res, fmt.Errorf("could not open %s sub-storage: %w", st.Type(), err)
+		}
+		if err := st.Init(); err != nil {
+			_ = st.Close()
+			return res, fmt.Errorf("could not init %s sub-storage: %w", st.Type(), err)
+		}
+
+		_, err := st.Iterate(prm)
+		_ = st.Close()
+		if err != nil {
+			return res, fmt.Errorf("could not iterate over %s sub-storage: %w", st.Type(), err)
+		}
+	}
+
+	if wcPath != "" {
+		err := iterateWriteCache(wcPath, func(obj *objectSDK.Object) {
+			// objects that have already been flushed keep their storage IDs
+			var existsPrm meta.ExistsPrm
+			existsPrm.SetAddress(objectcore.AddressOf(obj))
+
+			if r, err := db.Exists(existsPrm); err == nil && r.Exists() {
+				return
+			}
+
+			// write-cache objects are indexed without storage ID like
+			// the shard does on PUT
+			index(obj, nil)
+		}, fail)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	if err := db.SyncCounters(); err != nil {
+		return res, fmt.Errorf("could not sync object counters: %w", err)
+	}
+
+	return res, nil
+}
+
+// iterateWriteCache passes all objects stored in the write-cache located at
+// path to f. Objects that can not be read are passed to report. Missing
+// write-cache is considered empty.
+func iterateWriteCache(path string, f func(*objectSDK.Object), report func(string, error)) error {
+	wc := writecache.New(writecache.WithPath(path))
+
+	err := wc.SetMode(mode.ReadOnly)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("could not open write-cache: %w", err)
+	}
+
+	defer func() { _ = wc.Close() }()
+
+	var prm writecache.IterationPrm
+	prm.WithIgnoreErrors(true)
+	prm.WithHandler(func(data []byte) error {
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(data); err != nil {
+			report("from write-cache", fmt.Errorf("could not unmarshal object: %w", err))
+			return nil
+		}
+
+		f(obj)
+
+		return nil
+	})
+
+	err = wc.Iterate(prm)
+	if err != nil {
+		return fmt.Errorf("could not iterate over write-cache: %w", err)
+	}
+
+	return nil
+}
+
+// findShard returns config of the shard located at vShard path.
+func findShard(cmd *cobra.Command) *shardconfig.Config {
+	appCfg := config.New(config.Prm{}, config.WithConfigFile(vConfig))
+	shardPath := filepath.Clean(vShard)
+
+	var res []*shardconfig.Config
+
+	err := engineconfig.IterateShards(appCfg, false, func(sc *shardconfig.Config) error {
+		paths := []string{sc.Metabase().Path()}
+		for _, s := range sc.BlobStor().Storages() {
+			paths = append(paths, s.Path())
+		}
+
+		for _, p := range paths {
+			p = filepath.Clean(p)
+			if p == shardPath || strings.HasPrefix(p, shardPath+string(filepath.Separator)) {
+				res = append(res, sc)
+				break
+			}
+		}
+
+		return nil
+	})
+	common.ExitOnErr(cmd, common.Errf("could not read shards config: %w", err))
+
+	switch len(res) {
+	case 0:
+		common.ExitOnErr(cmd, fmt.Errorf("shard with path %s not found in config", vShard))
+	case 1:
+	default:
+		common.ExitOnErr(cmd, fmt.Errorf("path %s matches %d shards", vShard, len(res)))
+	}
+
+	return res[0]
+}
+
+// shardSubStorages constructs BLOB sub-storages of the shard.
+func shardSubStorages(cmd *cobra.Command, sc *shardconfig.Config) []blobstorcommon.Storage {
+	storagesCfg := sc.BlobStor().Storages()
+	res := make([]blobstorcommon.Storage, 0, len(storagesCfg))
+
+	for i := range storagesCfg {
+		switch storagesCfg[i].Type() {
+		case blobovniczatree.Type:
+			sub := blobovniczaconfig.From((*config.Config)(storagesCfg[i]))
+
+			res = append(res, blobovniczatree.NewBlobovniczaTree(
+				blobovniczatree.WithRootPath(storagesCfg[i].Path()),
+				blobovniczatree.WithPermissions(storagesCfg[i].Perm()),
+				blobovniczatree.WithBlobovniczaSize(sub.Size()),
+				blobovniczatree.WithBlobovniczaShallowDepth(sub.ShallowDepth()),
+				blobovniczatree.WithBlobovniczaShallowWidth(sub.ShallowWidth()),
+				blobovniczatree.WithOpenedCacheSize(sub.OpenedCacheSize())))
+		case fstree.Type:
+			sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
+
+			res = append(res, fstree.New(
+				fstree.WithPath(storagesCfg[i].Path()),
+				fstree.WithPerm(storagesCfg[i].Perm()),
+				fstree.WithDepth(sub.Depth())))
+		case peapod.Type:
+			sub := peapodconfig.From((*config.Config)(storagesCfg[i]))
+
+			res = append(res, peapod.New(storagesCfg[i].Path(), storagesCfg[i].Perm(), sub.FlushInterval()))
+		default:
+			common.ExitOnErr(cmd, fmt.Errorf("invalid storage type: %s", storagesCfg[i].Type()))
+		}
+	}
+
+	return res
+}
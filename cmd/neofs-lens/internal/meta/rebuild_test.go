@@ -0,0 +1,134 @@
+package meta
+
+import (
+	"path/filepath"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	blobstorcommon "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestObject(t *testing.T, cnr cid.ID, typ objectSDK.Type, payload []byte) *objectSDK.Object {
+	owner := usertest.ID(t)
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetID(oidtest.ID())
+	obj.SetOwnerID(&owner)
+	obj.SetType(typ)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(objectSDK.CalculatePayloadChecksum(payload))
+
+	return obj
+}
+
+func putTestObjects(t *testing.T, st interface {
+	Put(blobstorcommon.PutPrm) (blobstorcommon.PutRes, error)
+}, objs ...*objectSDK.Object) {
+	for _, obj := range objs {
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		_, err = st.Put(blobstorcommon.PutPrm{
+			Address: objectcore.AddressOf(obj),
+			Object:  obj,
+			RawData: data,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestRebuildMetabase(t *testing.T) {
+	dir := t.TempDir()
+	cnr := cidtest.ID()
+
+	regular := newTestObject(t, cnr, objectSDK.TypeRegular, []byte("regular"))
+	removed := newTestObject(t, cnr, objectSDK.TypeRegular, []byte("removed"))
+	locked := newTestObject(t, cnr, objectSDK.TypeRegular, []byte("locked"))
+	flushed := newTestObject(t, cnr, objectSDK.TypeRegular, []byte("flushed"))
+	cached := newTestObject(t, cnr, objectSDK.TypeRegular, []byte("cached"))
+
+	removedID, _ := removed.ID()
+	lockedID, _ := locked.ID()
+
+	tomb := objectSDK.NewTombstone()
+	tomb.SetMembers([]oid.ID{removedID})
+	tombData, err := tomb.Marshal()
+	require.NoError(t, err)
+	tombstone := newTestObject(t, cnr, objectSDK.TypeTombstone, tombData)
+
+	var lock objectSDK.Lock
+	lock.WriteMembers([]oid.ID{lockedID})
+	lockObj := newTestObject(t, cnr, objectSDK.TypeLock, lock.Marshal())
+
+	// populate BLOB storage
+	fsPath := filepath.Join(dir, "fstree")
+
+	fsTree := fstree.New(fstree.WithPath(fsPath))
+	require.NoError(t, fsTree.Open(false))
+	require.NoError(t, fsTree.Init())
+	putTestObjects(t, fsTree, tombstone, regular, removed, locked, lockObj, flushed)
+	require.NoError(t, fsTree.Close())
+
+	// populate write-cache, one of the objects is already flushed
+	wcPath := filepath.Join(dir, "writecache")
+
+	wc := writecache.New(writecache.WithPath(wcPath))
+	require.NoError(t, wc.Open(false))
+	putTestObjects(t, wc, flushed, cached)
+	require.NoError(t, wc.Close())
+
+	db := meta.New(
+		meta.WithPath(filepath.Join(dir, "meta")),
+		meta.WithEpochState(epochState{}),
+	)
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	var reported []string
+
+	res, err := rebuildMetabase(db, []blobstorcommon.Storage{fstree.New(fstree.WithPath(fsPath))}, wcPath, func(obj string, err error) {
+		reported = append(reported, obj)
+	})
+	require.NoError(t, err)
+	require.Empty(t, reported)
+	require.Zero(t, res.failed)
+	// tombstone is processed first or last depending on the iteration order,
+	// so the removed object is either skipped or indexed and then inhumed
+	require.Equal(t, 7, res.indexed+res.removed)
+
+	for _, obj := range []*objectSDK.Object{regular, locked, lockObj, flushed, cached, tombstone} {
+		var prm meta.ExistsPrm
+		prm.SetAddress(objectcore.AddressOf(obj))
+
+		r, err := db.Exists(prm)
+		require.NoError(t, err)
+		require.True(t, r.Exists())
+	}
+
+	var prm meta.ExistsPrm
+	prm.SetAddress(objectcore.AddressOf(removed))
+
+	_, err = db.Exists(prm)
+	require.True(t, meta.IsErrRemoved(err))
+
+	var lockedPrm meta.IsLockedPrm
+	lockedPrm.SetAddress(objectcore.AddressOf(locked))
+
+	lockedRes, err := db.IsLocked(lockedPrm)
+	require.NoError(t, err)
+	require.True(t, lockedRes.Locked())
+}
@@ -14,6 +14,9 @@ var (
 	vAddress  string
 	vPath     string
 	vInputObj string
+	vConfig   string
+	vShard    string
+	vOut      string
 )
 
 type epochState struct{}
@@ -34,6 +37,9 @@ func init() {
 		listGraveyardCMD,
 		listGarbageCMD,
 		writeObjectCMD,
+		statsCMD,
+		containersCMD,
+		rebuildCMD,
 	)
 }
 
@@ -44,8 +50,9 @@ func openMeta(cmd *cobra.Command, readOnly bool) *meta.DB {
 	db := meta.New(
 		meta.WithPath(vPath),
 		meta.WithBoltDBOptions(&bbolt.Options{
-			ReadOnly: readOnly,
-			Timeout:  100 * time.Millisecond,
+			ReadOnly:        readOnly,
+			Timeout:         100 * time.Millisecond,
+			PreLoadFreelist: true,
 		}),
 		meta.WithEpochState(epochState{}),
 	)
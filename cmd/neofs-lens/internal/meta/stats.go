@@ -0,0 +1,76 @@
+package meta
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+var statsCMD = &cobra.Command{
+	Use:   "stats",
+	Short: "Metabase statistics",
+	Long: `Print object counters, per-container object counts by type, graveyard, garbage
+and locked objects counts, bucket sizes and page usage of the metabase file.`,
+	Args: cobra.NoArgs,
+	Run:  statsFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(statsCMD, &vPath)
+}
+
+func statsFunc(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	st, err := db.Stats()
+	common.ExitOnErr(cmd, common.Errf("could not collect metabase statistics: %w", err))
+
+	cmd.Printf("Objects: %d physical, %d logical\n", st.Counters.Phy(), st.Counters.Logic())
+	cmd.Printf("Graveyard: %d, garbage: %d, locked: %d\n", st.Graveyard, st.Garbage, st.Locked)
+
+	freePages := "unknown"
+	if st.FreePages >= 0 {
+		freePages = fmt.Sprint(st.FreePages)
+	}
+
+	cmd.Printf("File: %d bytes, %d pages of %d bytes, %s free\n", st.Size, st.Pages, st.PageSize, freePages)
+
+	kinds := make([]string, 0, len(st.Buckets))
+	for k := range st.Buckets {
+		kinds = append(kinds, k)
+	}
+
+	sort.Strings(kinds)
+
+	cmd.Println()
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "BUCKET\tCOUNT\tKEYS\tALLOC\tIN USE")
+
+	for _, k := range kinds {
+		b := st.Buckets[k]
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", k, b.Buckets, b.Keys, b.Alloc, b.InUse)
+	}
+
+	_ = tw.Flush()
+
+	sort.Slice(st.Containers, func(i, j int) bool {
+		return st.Containers[i].ID.EncodeToString() < st.Containers[j].ID.EncodeToString()
+	})
+
+	cmd.Println()
+
+	tw = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "CONTAINER\tREGULAR\tTOMBSTONE\tSTORAGE GROUP\tLOCK\tSIZE")
+
+	for _, c := range st.Containers {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\n",
+			c.ID, c.Regular, c.Tombstone, c.StorageGroup, c.Lock, c.Size)
+	}
+
+	_ = tw.Flush()
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/spf13/cobra"
+)
+
+var vRepair bool
+
+var storageFsckCMD = &cobra.Command{
+	Use:   "fsck",
+	Short: "Check consistency of the NeoFS node's storage",
+	Long: `Check consistency of every shard of the NeoFS node's storage: each metabase record
+must refer to the stored object, each stored object must be indexed in the metabase,
+object checksums and signatures must be valid. Dangling records, orphan and corrupted
+objects are reported. With --repair, dangling records and corrupted objects are removed,
+orphan objects are indexed. Node must be stopped.`,
+	Args: cobra.NoArgs,
+	Run:  fsck,
+}
+
+func init() {
+	common.AddConfigFileFlag(storageFsckCMD, &vConfig)
+	storageFsckCMD.Flags().BoolVar(&vRepair, "repair", false, "Fix found inconsistencies")
+}
+
+func fsck(cmd *cobra.Command, _ []string) {
+	storage := openEngine(cmd)
+	defer storage.Close()
+
+	var unresolved int
+
+	for _, info := range storage.DumpInfo().Shards {
+		if vRepair {
+			err := storage.SetShardMode(info.ID, mode.ReadWrite, false)
+			common.ExitOnErr(cmd, common.Errf(fmt.Sprintf("could not switch shard %s to read-write mode: %%w", info.ID), err))
+		}
+
+		var prm shard.FsckPrm
+		prm.WithRepair(vRepair)
+		prm.WithIssueHandler(func(issue shard.FsckIssue) {
+			if !issue.Repaired {
+				unresolved++
+			}
+
+			cmd.Printf("[%s] %s %s", info.ID, issue.Type, issue.Address)
+			if issue.Err != nil {
+				cmd.Printf(": %s", issue.Err)
+			}
+			if issue.Repaired {
+				cmd.Print(" (repaired)")
+			}
+			cmd.Println()
+		})
+
+		res, err := storage.FsckShard(info.ID, prm)
+		common.ExitOnErr(cmd, common.Errf(fmt.Sprintf("could not check shard %s: %%w", info.ID), err))
+
+		cmd.Printf("Shard %s: checked %d records, dangling records: %d, orphan objects: %d, corrupted objects: %d, repaired: %d.\n",
+			info.ID, res.Checked(), res.DanglingRecords(), res.OrphanObjects(), res.CorruptedObjects(), res.Repaired())
+	}
+
+	if unresolved > 0 {
+		common.ExitOnErr(cmd, fmt.Errorf("%d inconsistencies are not repaired", unresolved))
+	}
+}
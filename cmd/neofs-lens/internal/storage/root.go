@@ -45,6 +45,8 @@ var Root = &cobra.Command{
 func init() {
 	Root.AddCommand(
 		storageInspectObjCMD,
+		storageFsckCMD,
+		storageShellCMD,
 	)
 }
 
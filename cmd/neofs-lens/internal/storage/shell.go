@@ -0,0 +1,756 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/chzyer/readline"
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	"github.com/spf13/cobra"
+)
+
+var storageShellCMD = &cobra.Command{
+	Use:   "shell",
+	Short: "Browse the NeoFS node's storage interactively",
+	Long: `Open the storage engine in read-only mode once and browse it interactively:
+list containers and their objects, view headers, dump payloads, follow split
+chains and inspect tombstones, locks and storage groups. Type "help" in the
+shell for the list of commands. Node must be stopped.`,
+	Args: cobra.NoArgs,
+	Run:  shellFunc,
+}
+
+// shellCommand describes a single command of the storage shell.
+type shellCommand struct {
+	usage string
+	help  string
+	run   func(sh *shell, args []string) error
+}
+
+// shellCommands is initialized in init to avoid initialization cycle.
+var shellCommands map[string]shellCommand
+
+func init() {
+	common.AddConfigFileFlag(storageShellCMD, &vConfig)
+
+	shellCommands = map[string]shellCommand{
+		"help": {
+			help: "Print this help",
+			run:  (*shell).help,
+		},
+		"containers": {
+			help: "List containers with their estimated sizes",
+			run:  (*shell).containers,
+		},
+		"cd": {
+			usage: "<cid>|..",
+			help:  "Set or reset the current container",
+			run:   (*shell).cd,
+		},
+		"ls": {
+			usage: "[cid] [root] [phy] [key=value] [key!=value] [key^=prefix]",
+			help:  "List objects of the container matching all the filters",
+			run:   (*shell).ls,
+		},
+		"head": {
+			usage: "<oid|address>",
+			help:  "Print object header, split information for virtual objects",
+			run:   (*shell).head,
+		},
+		"cat": {
+			usage: "<oid|address> [file]",
+			help:  "Write object payload (assembled for virtual objects) to stdout or file",
+			run:   (*shell).cat,
+		},
+		"dump": {
+			usage: "<oid|address> <file>",
+			help:  "Write the whole binary object to file",
+			run:   (*shell).dump,
+		},
+		"split": {
+			usage: "<oid|address>",
+			help:  "Follow the split chain of the virtual object or its part",
+			run:   (*shell).split,
+		},
+		"members": {
+			usage: "<oid|address>",
+			help:  "List members of the tombstone, lock or storage group",
+			run:   (*shell).members,
+		},
+		"status": {
+			usage: "<oid|address>",
+			help:  "Print object status: available, removed, missing, locked",
+			run:   (*shell).status,
+		},
+		"exit": {
+			help: "Exit the shell (also Ctrl-D)",
+			run:  (*shell).exit,
+		},
+		"quit": {
+			help: "Same as \"exit\"",
+			run:  (*shell).exit,
+		},
+	}
+}
+
+// errShellExit is returned by the command which terminates the shell.
+var errShellExit = errors.New("exit")
+
+// shell is a state of the interactive storage browser.
+type shell struct {
+	cmd    *cobra.Command
+	engine *engine.StorageEngine
+
+	// current container, nil if not set
+	cnr *cid.ID
+}
+
+func shellFunc(cmd *cobra.Command, _ []string) {
+	storage := openEngine(cmd)
+	defer storage.Close()
+
+	sh := &shell{
+		cmd:    cmd,
+		engine: storage,
+	}
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:          sh.prompt(),
+		AutoComplete:    sh.completer(),
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+	})
+	common.ExitOnErr(cmd, common.Errf("could not init terminal: %w", err))
+
+	defer rl.Close()
+
+	for {
+		line, err := rl.Readline()
+		if errors.Is(err, readline.ErrInterrupt) {
+			continue
+		} else if err != nil {
+			// io.EOF on Ctrl-D
+			return
+		}
+
+		if !sh.exec(line) {
+			return
+		}
+
+		rl.SetPrompt(sh.prompt())
+	}
+}
+
+// exec runs the command line, returns false if the shell must be terminated.
+func (sh *shell) exec(line string) bool {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return true
+	}
+
+	c, ok := shellCommands[args[0]]
+	if !ok {
+		sh.cmd.Printf("Unknown command %q, type \"help\" for the list of commands.\n", args[0])
+		return true
+	}
+
+	err := c.run(sh, args[1:])
+	if errors.Is(err, errShellExit) {
+		return false
+	} else if err != nil {
+		sh.cmd.PrintErrf("Error: %s\n", err)
+	}
+
+	return true
+}
+
+func (sh *shell) exit([]string) error {
+	return errShellExit
+}
+
+func (sh *shell) prompt() string {
+	if sh.cnr == nil {
+		return "storage> "
+	}
+
+	return fmt.Sprintf("storage:%s> ", sh.cnr)
+}
+
+func (sh *shell) completer() readline.AutoCompleter {
+	listContainers := func(string) []string {
+		ids, err := engine.ListContainers(sh.engine)
+		if err != nil {
+			return nil
+		}
+
+		res := make([]string, 0, len(ids)+1)
+		for i := range ids {
+			res = append(res, ids[i].EncodeToString())
+		}
+
+		return append(res, "..")
+	}
+
+	items := make([]readline.PrefixCompleterInterface, 0, len(shellCommands))
+	for _, name := range sortedShellCommands() {
+		if name == "cd" || name == "ls" {
+			items = append(items, readline.PcItem(name, readline.PcItemDynamic(listContainers)))
+			continue
+		}
+
+		items = append(items, readline.PcItem(name))
+	}
+
+	return readline.NewPrefixCompleter(items...)
+}
+
+func sortedShellCommands() []string {
+	names := make([]string, 0, len(shellCommands))
+	for name := range shellCommands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (sh *shell) help([]string) error {
+	w := tabwriter.NewWriter(sh.cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+
+	for _, name := range sortedShellCommands() {
+		c := shellCommands[name]
+		_, _ = fmt.Fprintf(w, "%s %s\t%s\n", name, c.usage, c.help)
+	}
+
+	_, _ = fmt.Fprintln(w, "\nObjects can be referenced by ID in the current container or by full address.")
+
+	return w.Flush()
+}
+
+func (sh *shell) containers([]string) error {
+	ids, err := engine.ListContainers(sh.engine)
+	if err != nil {
+		return fmt.Errorf("could not list containers: %w", err)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].EncodeToString() < ids[j].EncodeToString()
+	})
+
+	w := tabwriter.NewWriter(sh.cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CONTAINER\tSIZE")
+
+	for i := range ids {
+		size, err := engine.ContainerSize(sh.engine, ids[i])
+		if err != nil {
+			return fmt.Errorf("could not get size of %s container: %w", ids[i], err)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\n", ids[i], size)
+	}
+
+	return w.Flush()
+}
+
+func (sh *shell) cd(args []string) error {
+	if len(args) != 1 {
+		return errors.New("container ID is required")
+	}
+
+	if args[0] == ".." {
+		sh.cnr = nil
+		return nil
+	}
+
+	var cnr cid.ID
+	if err := cnr.DecodeString(args[0]); err != nil {
+		return fmt.Errorf("invalid container ID: %w", err)
+	}
+
+	sh.cnr = &cnr
+
+	return nil
+}
+
+func (sh *shell) ls(args []string) error {
+	var cnr cid.ID
+
+	if len(args) > 0 && cnr.DecodeString(args[0]) == nil {
+		args = args[1:]
+	} else if sh.cnr != nil {
+		cnr = *sh.cnr
+	} else {
+		return errors.New("container is not set, use \"cd\" or pass container ID")
+	}
+
+	fs, err := parseSearchFilters(args)
+	if err != nil {
+		return err
+	}
+
+	addrs, err := engine.Select(sh.engine, cnr, fs)
+	if err != nil {
+		return fmt.Errorf("could not select objects: %w", err)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Object().EncodeToString() < addrs[j].Object().EncodeToString()
+	})
+
+	w := tabwriter.NewWriter(sh.cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "OBJECT\tTYPE\tSIZE\tNAME")
+
+	for i := range addrs {
+		hdr, err := engine.Head(sh.engine, addrs[i])
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "%s\t<%s>\t\t\n", addrs[i].Object(), err)
+			continue
+		}
+
+		var name string
+		for _, a := range hdr.Attributes() {
+			if a.Key() == objectSDK.AttributeFileName {
+				name = a.Value()
+				break
+			}
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", addrs[i].Object(), hdr.Type(), hdr.PayloadSize(), name)
+	}
+
+	_, _ = fmt.Fprintf(w, "\nTotal: %d objects\n", len(addrs))
+
+	return w.Flush()
+}
+
+// parseSearchFilters converts shell arguments to the object search filters.
+func parseSearchFilters(args []string) (objectSDK.SearchFilters, error) {
+	var fs objectSDK.SearchFilters
+
+	for _, arg := range args {
+		switch arg {
+		case "root":
+			fs.AddRootFilter()
+			continue
+		case "phy":
+			fs.AddPhyFilter()
+			continue
+		}
+
+		var (
+			key, value string
+			op         objectSDK.SearchMatchType
+		)
+
+		if i := strings.Index(arg, "!="); i > 0 {
+			key, value, op = arg[:i], arg[i+2:], objectSDK.MatchStringNotEqual
+		} else if i := strings.Index(arg, "^="); i > 0 {
+			key, value, op = arg[:i], arg[i+2:], objectSDK.MatchCommonPrefix
+		} else if i := strings.Index(arg, "="); i > 0 {
+			key, value, op = arg[:i], arg[i+1:], objectSDK.MatchStringEqual
+		} else {
+			return nil, fmt.Errorf("invalid filter %q", arg)
+		}
+
+		fs.AddFilter(key, value, op)
+	}
+
+	return fs, nil
+}
+
+// address resolves the object referenced by the shell argument.
+func (sh *shell) address(args []string) (oid.Address, error) {
+	var addr oid.Address
+
+	if len(args) == 0 {
+		return addr, errors.New("object is required")
+	}
+
+	if strings.Contains(args[0], "/") {
+		err := addr.DecodeString(args[0])
+		if err != nil {
+			return addr, fmt.Errorf("invalid object address: %w", err)
+		}
+
+		return addr, nil
+	}
+
+	if sh.cnr == nil {
+		return addr, errors.New("container is not set, use \"cd\" or pass full object address")
+	}
+
+	var id oid.ID
+	if err := id.DecodeString(args[0]); err != nil {
+		return addr, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	addr.SetContainer(*sh.cnr)
+	addr.SetObject(id)
+
+	return addr, nil
+}
+
+func (sh *shell) head(args []string) error {
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	hdr, err := engine.HeadRaw(sh.engine, addr, true)
+	if err != nil {
+		var siErr *objectSDK.SplitInfoError
+		if !errors.As(err, &siErr) {
+			return fmt.Errorf("could not read object header: %w", err)
+		}
+
+		sh.cmd.Println("Virtual object, split info:")
+		printSplitInfo(sh.cmd, siErr.SplitInfo())
+
+		hdr, err = engine.Head(sh.engine, addr)
+		if err != nil {
+			return fmt.Errorf("could not read parent header: %w", err)
+		}
+	}
+
+	common.PrintObjectHeader(sh.cmd, *hdr)
+
+	if splitID := hdr.SplitID(); splitID != nil {
+		sh.cmd.Println("SplitID:", splitID)
+	}
+
+	if id, ok := hdr.ParentID(); ok {
+		sh.cmd.Println("Parent:", id)
+	}
+
+	if id, ok := hdr.PreviousID(); ok {
+		sh.cmd.Println("Previous:", id)
+	}
+
+	if children := hdr.Children(); len(children) > 0 {
+		sh.cmd.Println("Children:")
+		for i := range children {
+			sh.cmd.Println(" ", children[i])
+		}
+	}
+
+	return nil
+}
+
+func printSplitInfo(cmd *cobra.Command, si *objectSDK.SplitInfo) {
+	if splitID := si.SplitID(); splitID != nil {
+		cmd.Println("  SplitID:", splitID)
+	}
+
+	if id, ok := si.LastPart(); ok {
+		cmd.Println("  Last part:", id)
+	}
+
+	if id, ok := si.Link(); ok {
+		cmd.Println("  Link:", id)
+	}
+}
+
+func (sh *shell) cat(args []string) error {
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = sh.cmd.OutOrStdout()
+
+	if len(args) > 1 {
+		f, err := os.Create(args[1])
+		if err != nil {
+			return fmt.Errorf("could not create file: %w", err)
+		}
+
+		defer f.Close()
+
+		out = f
+	}
+
+	if len(args) == 1 {
+		// separate the payload from the next prompt
+		defer sh.cmd.Println()
+	}
+
+	obj, err := engine.Get(sh.engine, addr)
+	if err == nil {
+		_, err = out.Write(obj.Payload())
+		return err
+	}
+
+	var siErr *objectSDK.SplitInfoError
+	if !errors.As(err, &siErr) {
+		return fmt.Errorf("could not read object: %w", err)
+	}
+
+	parts, err := sh.splitChain(addr.Container(), siErr.SplitInfo())
+	if err != nil {
+		return err
+	}
+
+	var partAddr oid.Address
+	partAddr.SetContainer(addr.Container())
+
+	for i := range parts {
+		partAddr.SetObject(parts[i])
+
+		part, err := engine.Get(sh.engine, partAddr)
+		if err != nil {
+			return fmt.Errorf("could not read part %s: %w", parts[i], err)
+		}
+
+		_, err = out.Write(part.Payload())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sh *shell) dump(args []string) error {
+	if len(args) != 2 {
+		return errors.New("object and file are required")
+	}
+
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	obj, err := engine.Get(sh.engine, addr)
+	if err != nil {
+		return fmt.Errorf("could not read object: %w", err)
+	}
+
+	data, err := obj.Marshal()
+	if err != nil {
+		return fmt.Errorf("could not marshal object: %w", err)
+	}
+
+	err = os.WriteFile(args[1], data, 0o644)
+	if err != nil {
+		return fmt.Errorf("could not write file: %w", err)
+	}
+
+	sh.cmd.Printf("Object %s written to %s.\n", addr, args[1])
+
+	return nil
+}
+
+func (sh *shell) split(args []string) error {
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	hdr, err := engine.HeadRaw(sh.engine, addr, true)
+	if err == nil {
+		// part of the virtual object, follow the chain of its parent
+		parentID, ok := hdr.ParentID()
+		if !ok {
+			return fmt.Errorf("object %s is not a part of the virtual object", addr)
+		}
+
+		sh.cmd.Println("Parent:", parentID)
+
+		addr.SetObject(parentID)
+
+		_, err = engine.HeadRaw(sh.engine, addr, true)
+	}
+
+	var siErr *objectSDK.SplitInfoError
+	if !errors.As(err, &siErr) {
+		return fmt.Errorf("could not read object header: %w", err)
+	}
+
+	printSplitInfo(sh.cmd, siErr.SplitInfo())
+
+	parts, err := sh.splitChain(addr.Container(), siErr.SplitInfo())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(sh.cmd.OutOrStdout(), 0, 2, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "#\tPART\tSIZE")
+
+	var (
+		partAddr oid.Address
+		total    uint64
+	)
+	partAddr.SetContainer(addr.Container())
+
+	for i := range parts {
+		partAddr.SetObject(parts[i])
+
+		part, err := engine.HeadRaw(sh.engine, partAddr, true)
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t<%s>\n", i, parts[i], err)
+			continue
+		}
+
+		total += part.PayloadSize()
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%d\n", i, parts[i], part.PayloadSize())
+	}
+
+	_, _ = fmt.Fprintf(w, "\nTotal: %d parts, %d bytes\n", len(parts), total)
+
+	return w.Flush()
+}
+
+// splitChain returns ordered IDs of the virtual object parts. Link object
+// is used if available, otherwise the chain is followed from the last part.
+func (sh *shell) splitChain(cnr cid.ID, si *objectSDK.SplitInfo) ([]oid.ID, error) {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+
+	if link, ok := si.Link(); ok {
+		addr.SetObject(link)
+
+		hdr, err := engine.HeadRaw(sh.engine, addr, true)
+		if err == nil {
+			return hdr.Children(), nil
+		}
+
+		sh.cmd.Printf("Could not read link object %s: %s, following the chain.\n", link, err)
+	}
+
+	last, ok := si.LastPart()
+	if !ok {
+		return nil, errors.New("neither link nor last part of the virtual object is stored")
+	}
+
+	var res []oid.ID
+
+	for id, ok := last, true; ok; {
+		res = append(res, id)
+		addr.SetObject(id)
+
+		hdr, err := engine.HeadRaw(sh.engine, addr, true)
+		if err != nil {
+			return nil, fmt.Errorf("could not read part %s: %w", id, err)
+		}
+
+		id, ok = hdr.PreviousID()
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res, nil
+}
+
+func (sh *shell) members(args []string) error {
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	obj, err := engine.Get(sh.engine, addr)
+	if err != nil {
+		return fmt.Errorf("could not read object: %w", err)
+	}
+
+	var members []oid.ID
+
+	switch obj.Type() {
+	case objectSDK.TypeTombstone:
+		var tomb objectSDK.Tombstone
+		if err := tomb.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not decode tombstone: %w", err)
+		}
+
+		sh.cmd.Println("Expiration epoch:", tomb.ExpirationEpoch())
+		members = tomb.Members()
+	case objectSDK.TypeLock:
+		var lock objectSDK.Lock
+		if err := obj.ReadLock(&lock); err != nil {
+			return fmt.Errorf("could not decode lock: %w", err)
+		}
+
+		members = make([]oid.ID, lock.NumberOfMembers())
+		lock.ReadMembers(members)
+	case objectSDK.TypeStorageGroup:
+		var sg storagegroup.StorageGroup
+		if err := sg.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not decode storage group: %w", err)
+		}
+
+		sh.cmd.Println("Validation data size:", sg.ValidationDataSize())
+		members = sg.Members()
+	default:
+		return fmt.Errorf("object of type %s has no members", obj.Type())
+	}
+
+	sh.cmd.Printf("Members (%d):\n", len(members))
+
+	var memberAddr oid.Address
+	memberAddr.SetContainer(addr.Container())
+
+	for i := range members {
+		memberAddr.SetObject(members[i])
+		sh.cmd.Printf("  %s  %s\n", members[i], sh.objectStatus(memberAddr))
+	}
+
+	return nil
+}
+
+func (sh *shell) status(args []string) error {
+	addr, err := sh.address(args)
+	if err != nil {
+		return err
+	}
+
+	sh.cmd.Println(sh.objectStatus(addr))
+
+	return nil
+}
+
+// objectStatus returns a human-readable status of the object in the engine.
+func (sh *shell) objectStatus(addr oid.Address) string {
+	var (
+		status string
+
+		siErr      *objectSDK.SplitInfoError
+		errRemoved apistatus.ObjectAlreadyRemoved
+		errMissing apistatus.ObjectNotFound
+	)
+
+	_, err := engine.HeadRaw(sh.engine, addr, true)
+	switch {
+	case err == nil:
+		status = "available"
+	case errors.As(err, &siErr):
+		status = "available (virtual)"
+	case errors.As(err, &errRemoved):
+		status = "removed"
+	case errors.As(err, &errMissing):
+		status = "missing"
+	default:
+		status = fmt.Sprintf("unknown (%s)", err)
+	}
+
+	locked, err := sh.engine.IsLocked(addr)
+	if err != nil {
+		return fmt.Sprintf("%s, lock status unknown (%s)", status, err)
+	} else if locked {
+		status += ", locked"
+	}
+
+	return status
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestShell(t *testing.T) (*shell, *bytes.Buffer) {
+	dir := t.TempDir()
+
+	e := engine.New()
+
+	_, err := e.AddShard(
+		shard.WithBlobStorOptions(blobstor.WithStorages([]blobstor.SubStorage{{
+			Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "fstree"))),
+		}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{}),
+		),
+		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+	)
+	require.NoError(t, err)
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	var out bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+
+	return &shell{cmd: cmd, engine: e}, &out
+}
+
+func putTestObject(t *testing.T, e *engine.StorageEngine, cnr cid.ID, name string) oid.ID {
+	var attr objectSDK.Attribute
+	attr.SetKey(objectSDK.AttributeFileName)
+	attr.SetValue(name)
+
+	payload := []byte("payload of " + name)
+	owner := usertest.ID(t)
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetID(oidtest.ID())
+	obj.SetOwnerID(&owner)
+	obj.SetAttributes(attr)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(objectSDK.CalculatePayloadChecksum(payload))
+
+	require.NoError(t, engine.Put(e, obj))
+
+	id, _ := obj.ID()
+
+	return id
+}
+
+func TestShell_exec(t *testing.T) {
+	sh, out := newTestShell(t)
+
+	require.True(t, sh.exec(""))
+	require.True(t, sh.exec("unknown"))
+	require.Contains(t, out.String(), `Unknown command "unknown"`)
+
+	for _, c := range []string{"exit", "quit", "  quit  "} {
+		require.False(t, sh.exec(c), c)
+	}
+
+	out.Reset()
+	require.True(t, sh.exec("help"))
+
+	for name := range shellCommands {
+		require.Contains(t, out.String(), name)
+	}
+
+	out.Reset()
+	require.True(t, sh.exec("cd invalid"))
+	require.Contains(t, out.String(), "Error: invalid container ID")
+}
+
+func TestShell_browse(t *testing.T) {
+	sh, out := newTestShell(t)
+
+	cnr := cidtest.ID()
+	cat := putTestObject(t, sh.engine, cnr, "cat.jpg")
+	dog := putTestObject(t, sh.engine, cnr, "dog.jpg")
+
+	require.True(t, sh.exec("ls"))
+	require.Contains(t, out.String(), "container is not set")
+
+	require.True(t, sh.exec("cd "+cnr.EncodeToString()))
+	require.Equal(t, "storage:"+cnr.EncodeToString()+"> ", sh.prompt())
+
+	out.Reset()
+	require.True(t, sh.exec("ls"))
+	require.Contains(t, out.String(), cat.EncodeToString())
+	require.Contains(t, out.String(), dog.EncodeToString())
+	require.Contains(t, out.String(), "Total: 2 objects")
+
+	out.Reset()
+	require.True(t, sh.exec("ls FileName^=cat"))
+	require.Contains(t, out.String(), cat.EncodeToString())
+	require.NotContains(t, out.String(), dog.EncodeToString())
+
+	out.Reset()
+	require.True(t, sh.exec("cat "+dog.EncodeToString()))
+	require.Equal(t, "payload of dog.jpg\n", out.String())
+
+	out.Reset()
+	require.True(t, sh.exec("status "+cat.EncodeToString()))
+	require.Equal(t, "available\n", out.String())
+
+	require.NoError(t, sh.engine.Lock(cnr, oidtest.ID(), []oid.ID{cat}))
+
+	out.Reset()
+	require.True(t, sh.exec("status "+cnr.EncodeToString()+"/"+cat.EncodeToString()))
+	require.Equal(t, "available, locked\n", out.String())
+
+	out.Reset()
+	require.True(t, sh.exec("status "+oidtest.ID().EncodeToString()))
+	require.Equal(t, "missing\n", out.String())
+
+	require.True(t, sh.exec("cd .."))
+	require.Equal(t, "storage> ", sh.prompt())
+}
+
+func TestParseSearchFilters(t *testing.T) {
+	fs, err := parseSearchFilters([]string{"root", "a=1", "b!=2", "c^=3"})
+	require.NoError(t, err)
+
+	var exp objectSDK.SearchFilters
+	exp.AddRootFilter()
+	exp.AddFilter("a", "1", objectSDK.MatchStringEqual)
+	exp.AddFilter("b", "2", objectSDK.MatchStringNotEqual)
+	exp.AddFilter("c", "3", objectSDK.MatchCommonPrefix)
+
+	require.Equal(t, exp, fs)
+
+	for _, arg := range []string{"key", "=value"} {
+		_, err = parseSearchFilters([]string{arg})
+		require.Error(t, err, arg)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	accountingTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/accounting/grpc"
 	accountingService "github.com/nspcc-dev/neofs-node/pkg/services/accounting"
 	accounting "github.com/nspcc-dev/neofs-node/pkg/services/accounting/morph"
+	"google.golang.org/grpc"
 )
 
 func initAccountingService(c *cfg) {
@@ -28,7 +29,7 @@ func initAccountingService(c *cfg) {
 		),
 	)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		accountingGRPC.RegisterAccountingServiceServer(srv, server)
-	}
+	})
 }
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
-	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -53,15 +52,19 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/decommission"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone"
 	tsourse "github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone/source"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	trustcontroller "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/controller"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util/response"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -304,11 +307,16 @@ type shared struct {
 
 	respSvc *response.Service
 
-	replicator *replicator.Replicator
+	replicator     *replicator.Replicator
+	policer        *policer.Policer
+	decommissioner *decommission.Decommissioner
 
 	treeService *tree.Service
+	treeSync    *treeSyncTrigger
 
 	metricsCollector *metrics.NodeMetrics
+
+	limiter *ratelimit.Limiter
 }
 
 func (s *shared) resetCaches() {
@@ -361,9 +369,17 @@ func (c *cfg) ReadCurrentNetMap(msg *netmapV2.NetMap) error {
 }
 
 type cfgGRPC struct {
-	listeners []net.Listener
+	// serializes changes of the servers
+	mtx sync.Mutex
+
+	// running servers by their endpoints
+	servers map[string]*grpcServer
+
+	// registrars of the services on the new servers
+	services []func(*grpc.Server)
 
-	servers []*grpc.Server
+	// set when the servers are started, new servers are started at once
+	serving bool
 
 	maxChunkSize uint64
 
@@ -417,6 +433,8 @@ type cfgNodeInfo struct {
 type cfgObject struct {
 	getSvc *getsvc.Service
 
+	searchSvc *searchsvc.Service
+
 	cnrSource container.Source
 
 	eaclSource container.EACLSource
@@ -429,9 +447,8 @@ type cfgObject struct {
 }
 
 type cfgNotifications struct {
-	enabled      bool
-	nw           notificationWriter
-	defaultTopic string
+	nw           *notificationWriter
+	defaultTopic *notificationTopic
 }
 
 type cfgLocalStorage struct {
@@ -457,12 +474,23 @@ type cfgReputation struct {
 
 	localTrustStorage *truststorage.Storage
 
+	latencyTrustStorage *truststorage.Storage
+
+	availabilityTrustStorage *truststorage.Storage
+
+	latencyThreshold time.Duration
+
+	trustSource *trustSource
+
 	localTrustCtrl *trustcontroller.Controller
 
 	scriptHash neogoutil.Uint160
 }
 
-var persistateSideChainLastBlockKey = []byte("side_chain_last_processed_block")
+var (
+	persistateSideChainLastBlockKey = []byte("side_chain_last_processed_block")
+	persistateDecommissionKey       = []byte("decommission")
+)
 
 func initCfg(appCfg *config.Config) *cfg {
 	c := &cfg{}
@@ -575,6 +603,8 @@ func initCfg(appCfg *config.Config) *cfg {
 
 	c.ownerIDFromKey = user.ResolveFromECDSAPublicKey(key.PrivateKey.PublicKey)
 
+	c.limiter = newRateLimiter(c)
+
 	if metricsconfig.Enabled(c.appCfg) {
 		c.metricsCollector = metrics.NewNodeMetrics(misc.Version)
 		netState.metrics = c.metricsCollector
@@ -740,6 +770,7 @@ func initLocalStorage(c *cfg) {
 	// allocate memory for the service;
 	// service will be created later
 	c.cfgObject.getSvc = new(getsvc.Service)
+	c.cfgObject.searchSvc = new(searchsvc.Service)
 
 	var tssPrm tsourse.TombstoneSourcePrm
 	tssPrm.SetGetService(c.cfgObject.getSvc)
@@ -752,7 +783,11 @@ func initLocalStorage(c *cfg) {
 
 	var shardsAttached int
 	for _, optsWithMeta := range c.shardOpts() {
-		id, err := ls.AddShard(append(optsWithMeta.shOpts, shard.WithTombstoneSource(tombstoneSource))...)
+		id, err := ls.AddShard(append(optsWithMeta.shOpts,
+			shard.WithTombstoneSource(tombstoneSource),
+			shard.WithLifecycleRulesSource(lifecycleRulesSource{c: c}),
+			shard.WithObjectVersions(objectVersions{c: c}),
+		)...)
 		if err != nil {
 			c.log.Error("failed to attach shard to engine", zap.Error(err))
 		} else {
@@ -837,6 +872,11 @@ func (c *cfg) bootstrapWithState(stateSetter func(*netmap.NodeInfo)) error {
 
 	stateSetter(&ni)
 
+	if c.decommissioner != nil {
+		// other nodes must not count the replicas of the node being decommissioned
+		netmapCore.SetDecommission(&ni, c.decommissioner.IsDecommissioning())
+	}
+
 	prm := nmClient.AddPeerPrm{}
 	prm.SetNodeInfo(ni)
 
@@ -875,13 +915,21 @@ func (c *cfg) needBootstrap() bool {
 // It is calculated as size/capacity ratio of "remote object put" worker.
 // Returns float value between 0.0 and 1.0.
 func (c *cfg) ObjectServiceLoad() float64 {
-	return float64(c.cfgObject.pool.putRemote.Running()) / float64(c.cfgObject.pool.putRemoteCapacity)
+	return float64(c.cfgObject.pool.putRemote.Running()) / float64(c.cfgObject.pool.putRemote.Cap())
 }
 
 func (c *cfg) configWatcher(ctx context.Context) {
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGHUP)
 
+	// values the components are currently running with, changes failed to be
+	// applied or requiring restart are processed again on the next SIGHUP
+	applied, err := readReloadableConfig(c.appCfg)
+	if err != nil {
+		c.log.Error("current configuration reading", zap.Error(err))
+		return
+	}
+
 	for {
 		select {
 		case <-ch:
@@ -893,6 +941,12 @@ func (c *cfg) configWatcher(ctx context.Context) {
 				continue
 			}
 
+			next, err := readReloadableConfig(c.appCfg)
+			if err != nil {
+				c.log.Error("configuration reading", zap.Error(err))
+				continue
+			}
+
 			// Logger
 
 			err = c.internals.logLevel.UnmarshalText([]byte(c.LoggerCfg.level))
@@ -901,6 +955,10 @@ func (c *cfg) configWatcher(ctx context.Context) {
 				continue
 			}
 
+			// Components
+
+			c.reloadComponents(&applied, next)
+
 			// Storage Engine
 
 			var rcfg engine.ReConfiguration
@@ -1,6 +1,7 @@
 package controlconfig
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
@@ -13,6 +14,17 @@ type GRPCConfig struct {
 	cfg *config.Config
 }
 
+// TLSConfig is a wrapper over "tls" config section which provides access
+// to TLS configuration of control service.
+type TLSConfig struct {
+	cfg *config.Config
+}
+
+var (
+	errTLSKeyNotSet  = errors.New("empty/not set TLS key file path, see `control.grpc.tls.key` section")
+	errTLSCertNotSet = errors.New("empty/not set TLS certificate file path, see `control.grpc.tls.certificate` section")
+)
+
 const (
 	subsection     = "control"
 	grpcSubsection = "grpc"
@@ -60,3 +72,50 @@ func (g GRPCConfig) Endpoint() string {
 
 	return GRPCEndpointDefault
 }
+
+// TLS returns "tls" subsection of "grpc" subsection of "control" section.
+//
+// Returns nil if "enabled" value of "tls" subsection is false.
+func (g GRPCConfig) TLS() *TLSConfig {
+	sub := g.cfg.Sub("tls")
+
+	if !config.BoolSafe(sub, "enabled") {
+		return nil
+	}
+
+	return &TLSConfig{
+		cfg: sub,
+	}
+}
+
+// KeyFile returns the value of "key" config parameter.
+//
+// Panics if the value is not a non-empty string.
+func (tls TLSConfig) KeyFile() string {
+	v := config.StringSafe(tls.cfg, "key")
+	if v == "" {
+		panic(errTLSKeyNotSet)
+	}
+
+	return v
+}
+
+// CertificateFile returns the value of "certificate" config parameter.
+//
+// Panics if the value is not a non-empty string.
+func (tls TLSConfig) CertificateFile() string {
+	v := config.StringSafe(tls.cfg, "certificate")
+	if v == "" {
+		panic(errTLSCertNotSet)
+	}
+
+	return v
+}
+
+// ClientCAFile returns the value of "client_ca" config parameter. Clients
+// are required to present certificates signed by this CA if it is set.
+//
+// Returns empty string if the value is not set.
+func (tls TLSConfig) ClientCAFile() string {
+	return config.StringSafe(tls.cfg, "client_ca")
+}
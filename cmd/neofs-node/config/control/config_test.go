@@ -16,6 +16,7 @@ func TestControlSection(t *testing.T) {
 
 		require.Empty(t, controlconfig.AuthorizedKeys(empty))
 		require.Equal(t, controlconfig.GRPCEndpointDefault, controlconfig.GRPC(empty).Endpoint())
+		require.Nil(t, controlconfig.GRPC(empty).TLS())
 	})
 
 	const path = "../../../../config/example/node"
@@ -27,6 +28,12 @@ func TestControlSection(t *testing.T) {
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, pubs, controlconfig.AuthorizedKeys(c))
 		require.Equal(t, "localhost:8090", controlconfig.GRPC(c).Endpoint())
+
+		tls := controlconfig.GRPC(c).TLS()
+		require.NotNil(t, tls)
+		require.Equal(t, "/path/to/control/cert", tls.CertificateFile())
+		require.Equal(t, "/path/to/control/key", tls.KeyFile())
+		require.Equal(t, "/path/to/control/ca", tls.ClientCAFile())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -0,0 +1,115 @@
+package limitsconfig
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+const (
+	subsection = "limits"
+
+	// CacheSizeDefault is a default number of tracked request owners and containers.
+	CacheSizeDefault = 10000
+)
+
+// RateConfig is a wrapper over the config section
+// which provides access to rate limit parameters.
+type RateConfig config.Config
+
+// CacheSize returns the value of "cache_size" config parameter
+// from "limits" section.
+//
+// Returns CacheSizeDefault if the value is not positive integer.
+func CacheSize(c *config.Config) int {
+	v := config.IntSafe(c.Sub(subsection), "cache_size")
+	if v > 0 {
+		return int(v)
+	}
+
+	return CacheSizeDefault
+}
+
+// Owner returns structure that provides access to "owner"
+// subsection of "limits" section. It limits requests signed
+// by a single key.
+func Owner(c *config.Config) *RateConfig {
+	return (*RateConfig)(c.Sub(subsection).Sub("owner"))
+}
+
+// Container returns structure that provides access to "container"
+// subsection of "limits" section. It limits requests to a single
+// container.
+func Container(c *config.Config) *RateConfig {
+	return (*RateConfig)(c.Sub(subsection).Sub("container"))
+}
+
+// RPC returns structure that provides access to the "rpc.<method>"
+// subsection of "limits" section. It limits requests of the RPC type.
+func RPC(c *config.Config, method string) *RateConfig {
+	return (*RateConfig)(c.Sub(subsection).Sub("rpc").Sub(method))
+}
+
+// Rate returns the value of "rate" config parameter.
+//
+// Returns 0 (no limit) if the value is not positive number.
+func (x *RateConfig) Rate() float64 {
+	v := config.FloatSafe((*config.Config)(x), "rate")
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// Burst returns the value of "burst" config parameter.
+//
+// Returns 0 (rate rounded up) if the value is not positive integer.
+func (x *RateConfig) Burst() int {
+	v := config.IntSafe((*config.Config)(x), "burst")
+	if v > 0 {
+		return int(v)
+	}
+
+	return 0
+}
+
+// Bandwidth returns the value of "bandwidth" config parameter
+// in bytes per second.
+//
+// Returns 0 (no limit) if the value is not set.
+func (x *RateConfig) Bandwidth() uint64 {
+	return config.SizeInBytesSafe((*config.Config)(x), "bandwidth")
+}
+
+// IterateNodeQuotas iterates over the "node_quota" subsection of "limits"
+// section and passes container identifiers with their storage quotas on
+// the node to f.
+//
+// Throws panic if container ID is invalid or quota size is not positive.
+func IterateNodeQuotas(c *config.Config, f func(cid.ID, uint64)) {
+	c = c.Sub(subsection).Sub("node_quota")
+
+	for i := 0; ; i++ {
+		sc := c.Sub(strconv.Itoa(i))
+
+		s := config.StringSafe(sc, "container")
+		if s == "" {
+			return
+		}
+
+		var id cid.ID
+		if err := id.DecodeString(s); err != nil {
+			panic(fmt.Errorf("invalid container ID in quota #%d: %w", i, err))
+		}
+
+		size := config.SizeInBytesSafe(sc, "size")
+		if size == 0 {
+			panic(fmt.Errorf("missing size of the container %s quota", s))
+		}
+
+		f(id, size)
+	}
+}
@@ -0,0 +1,73 @@
+package limitsconfig_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	limitsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/limits"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.Equal(t, limitsconfig.CacheSizeDefault, limitsconfig.CacheSize(empty))
+
+		for _, rc := range []*limitsconfig.RateConfig{
+			limitsconfig.Owner(empty),
+			limitsconfig.Container(empty),
+			limitsconfig.RPC(empty, "get"),
+		} {
+			require.Zero(t, rc.Rate())
+			require.Zero(t, rc.Burst())
+			require.Zero(t, rc.Bandwidth())
+		}
+
+		limitsconfig.IterateNodeQuotas(empty, func(cid.ID, uint64) {
+			t.Fatal("unexpected quota")
+		})
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.Equal(t, 5000, limitsconfig.CacheSize(c))
+
+		owner := limitsconfig.Owner(c)
+		require.Equal(t, 100.0, owner.Rate())
+		require.Equal(t, 200, owner.Burst())
+		require.EqualValues(t, 10*1024*1024, owner.Bandwidth())
+
+		cnr := limitsconfig.Container(c)
+		require.Equal(t, 1000.0, cnr.Rate())
+		require.Equal(t, 1500, cnr.Burst())
+		require.EqualValues(t, 100*1024*1024, cnr.Bandwidth())
+
+		put := limitsconfig.RPC(c, "put")
+		require.Equal(t, 500.0, put.Rate())
+		require.Equal(t, 1000, put.Burst())
+
+		treeAdd := limitsconfig.RPC(c, "tree_add")
+		require.Equal(t, 50.0, treeAdd.Rate())
+		require.Zero(t, treeAdd.Burst())
+
+		require.Zero(t, limitsconfig.RPC(c, "get").Rate())
+
+		quotas := make(map[string]uint64)
+		limitsconfig.IterateNodeQuotas(c, func(id cid.ID, size uint64) {
+			quotas[id.EncodeToString()] = size
+		})
+		require.Equal(t, map[string]uint64{
+			"DR6immyV7eBk4CQFoByRDpz6kUuw6Zqmtho1gzx7bpa2": 1024 * 1024 * 1024,
+		}, quotas)
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
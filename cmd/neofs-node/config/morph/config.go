@@ -10,6 +10,7 @@ import (
 const (
 	subsection       = "morph"
 	notarySubsection = "notary"
+	healthSubsection = "health"
 
 	// DialTimeoutDefault is a default dial timeout of morph chain client connection.
 	DialTimeoutDefault = 5 * time.Second
@@ -22,6 +23,16 @@ const (
 	ReconnectionRetriesNumberDefault = 5
 	// ReconnectionRetriesDelayDefault is a default delay b/w reconnections.
 	ReconnectionRetriesDelayDefault = 5 * time.Second
+
+	// HealthCheckIntervalDefault is a default interval b/w health checks of
+	// the RPC endpoints.
+	HealthCheckIntervalDefault = 30 * time.Second
+	// MaxBlockLagDefault is a default number of blocks a healthy RPC endpoint
+	// can lag behind the others.
+	MaxBlockLagDefault = 10
+	// MaxErrorRateDefault is a default ratio of failed health checks of a
+	// healthy RPC endpoint.
+	MaxErrorRateDefault = 0.5
 )
 
 // Endpoints returns list of the values of "endpoints" config parameter
@@ -87,3 +98,56 @@ func ReconnectionRetriesDelay(c *config.Config) time.Duration {
 
 	return ReconnectionRetriesDelayDefault
 }
+
+// HealthCheckInterval returns the value of "interval" config parameter
+// from "morph.health" section.
+//
+// Returns HealthCheckIntervalDefault if value is zero or invalid. Negative
+// value disables health checks.
+func HealthCheckInterval(c *config.Config) time.Duration {
+	res := config.DurationSafe(c.Sub(subsection).Sub(healthSubsection), "interval")
+	if res != 0 {
+		return res
+	}
+
+	return HealthCheckIntervalDefault
+}
+
+// MaxBlockLag returns the value of "max_lag" config parameter
+// from "morph.health" section.
+//
+// Returns MaxBlockLagDefault if value is not specified or zero.
+func MaxBlockLag(c *config.Config) uint32 {
+	res := config.Uint32Safe(c.Sub(subsection).Sub(healthSubsection), "max_lag")
+	if res != 0 {
+		return res
+	}
+
+	return MaxBlockLagDefault
+}
+
+// MaxErrorRate returns the value of "max_error_rate" config parameter
+// from "morph.health" section.
+//
+// Returns MaxErrorRateDefault if value is not in the (0, 1] range.
+func MaxErrorRate(c *config.Config) float64 {
+	res := config.FloatSafe(c.Sub(subsection).Sub(healthSubsection), "max_error_rate")
+	if res > 0 && res <= 1 {
+		return res
+	}
+
+	return MaxErrorRateDefault
+}
+
+// MaxLatency returns the value of "max_latency" config parameter
+// from "morph.health" section.
+//
+// Returns 0 (no limit) if value is not specified or not positive.
+func MaxLatency(c *config.Config) time.Duration {
+	res := config.DurationSafe(c.Sub(subsection).Sub(healthSubsection), "max_latency")
+	if res > 0 {
+		return res
+	}
+
+	return 0
+}
@@ -19,6 +19,10 @@ func TestMorphSection(t *testing.T) {
 		require.Equal(t, morphconfig.CacheTTLDefault, morphconfig.CacheTTL(empty))
 		require.Equal(t, 5, morphconfig.ReconnectionRetriesNumber(empty))
 		require.Equal(t, 5*time.Second, morphconfig.ReconnectionRetriesDelay(empty))
+		require.Equal(t, morphconfig.HealthCheckIntervalDefault, morphconfig.HealthCheckInterval(empty))
+		require.EqualValues(t, morphconfig.MaxBlockLagDefault, morphconfig.MaxBlockLag(empty))
+		require.Equal(t, morphconfig.MaxErrorRateDefault, morphconfig.MaxErrorRate(empty))
+		require.Zero(t, morphconfig.MaxLatency(empty))
 	})
 
 	const path = "../../../../config/example/node"
@@ -31,6 +35,10 @@ func TestMorphSection(t *testing.T) {
 		require.Equal(t, 15*time.Second, morphconfig.CacheTTL(c))
 		require.Equal(t, 6, morphconfig.ReconnectionRetriesNumber(c))
 		require.Equal(t, 6*time.Second, morphconfig.ReconnectionRetriesDelay(c))
+		require.Equal(t, time.Minute, morphconfig.HealthCheckInterval(c))
+		require.EqualValues(t, 5, morphconfig.MaxBlockLag(c))
+		require.Equal(t, 0.3, morphconfig.MaxErrorRate(c))
+		require.Equal(t, 2*time.Second, morphconfig.MaxLatency(c))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
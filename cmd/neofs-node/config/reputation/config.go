@@ -0,0 +1,77 @@
+package reputationconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+const (
+	subsection        = "reputation"
+	sourcesSubsection = "sources"
+
+	// RequestsSourceName is a name of the local trust source
+	// based on the success of the object requests.
+	RequestsSourceName = "requests"
+
+	// LatencySourceName is a name of the local trust source
+	// based on the latency of the object requests.
+	LatencySourceName = "latency"
+
+	// AvailabilitySourceName is a name of the local trust source
+	// based on the share of the object requests the peer responded to.
+	AvailabilitySourceName = "availability"
+
+	// RequestsWeightDefault is a default weight of the requests local trust source.
+	RequestsWeightDefault = 1
+
+	// LatencyThresholdDefault is a default latency of the object request
+	// treated as fully satisfactory.
+	LatencyThresholdDefault = time.Second
+)
+
+func source(c *config.Config, name string) *config.Config {
+	return c.Sub(subsection).Sub(sourcesSubsection).Sub(name)
+}
+
+// RequestsWeight returns the value of "weight" config parameter
+// from "reputation.sources.requests" section.
+//
+// Returns RequestsWeightDefault if the value is not positive.
+func RequestsWeight(c *config.Config) uint64 {
+	v := config.UintSafe(source(c, RequestsSourceName), "weight")
+	if v > 0 {
+		return v
+	}
+
+	return RequestsWeightDefault
+}
+
+// LatencyWeight returns the value of "weight" config parameter
+// from "reputation.sources.latency" section.
+//
+// Returns 0 (source is disabled) if the value is not set.
+func LatencyWeight(c *config.Config) uint64 {
+	return config.UintSafe(source(c, LatencySourceName), "weight")
+}
+
+// AvailabilityWeight returns the value of "weight" config parameter
+// from "reputation.sources.availability" section.
+//
+// Returns 0 (source is disabled) if the value is not set.
+func AvailabilityWeight(c *config.Config) uint64 {
+	return config.UintSafe(source(c, AvailabilitySourceName), "weight")
+}
+
+// LatencyThreshold returns the value of "threshold" config parameter
+// from "reputation.sources.latency" section.
+//
+// Returns LatencyThresholdDefault if the value is not positive duration.
+func LatencyThreshold(c *config.Config) time.Duration {
+	v := config.DurationSafe(source(c, LatencySourceName), "threshold")
+	if v > 0 {
+		return v
+	}
+
+	return LatencyThresholdDefault
+}
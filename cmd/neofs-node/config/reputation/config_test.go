@@ -0,0 +1,37 @@
+package reputationconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	reputationconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/reputation"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReputationSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.EqualValues(t, reputationconfig.RequestsWeightDefault, reputationconfig.RequestsWeight(empty))
+		require.Zero(t, reputationconfig.LatencyWeight(empty))
+		require.Zero(t, reputationconfig.AvailabilityWeight(empty))
+		require.Equal(t, reputationconfig.LatencyThresholdDefault, reputationconfig.LatencyThreshold(empty))
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.EqualValues(t, 3, reputationconfig.RequestsWeight(c))
+		require.EqualValues(t, 1, reputationconfig.LatencyWeight(c))
+		require.EqualValues(t, 2, reputationconfig.AvailabilityWeight(c))
+		require.Equal(t, 500*time.Millisecond, reputationconfig.LatencyThreshold(c))
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
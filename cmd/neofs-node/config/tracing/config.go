@@ -0,0 +1,61 @@
+package tracingconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+const (
+	subsection = "tracing"
+
+	// EndpointDefault is a default value for OTLP collector endpoint.
+	EndpointDefault = "localhost:4317"
+
+	// SamplingRatioDefault is a default value for the fraction of sampled traces.
+	SamplingRatioDefault = 0.1
+)
+
+// Enabled returns the value of "enabled" config parameter
+// from "tracing" section.
+//
+// Returns false if the value is missing or invalid.
+func Enabled(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "enabled")
+}
+
+// Endpoint returns the value of "endpoint" config parameter
+// from "tracing" section.
+//
+// Returns EndpointDefault if the value is not set.
+func Endpoint(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "endpoint")
+	if v != "" {
+		return v
+	}
+
+	return EndpointDefault
+}
+
+// Insecure returns the value of "insecure" config parameter
+// from "tracing" section.
+//
+// Returns false if the value is missing or invalid.
+func Insecure(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "insecure")
+}
+
+// SamplingRatio returns the value of "sampling_ratio" config parameter
+// from "tracing" section.
+//
+// Returns SamplingRatioDefault if the value is not positive.
+// Values greater than 1 are treated as 1.
+func SamplingRatio(c *config.Config) float64 {
+	v := config.FloatSafe(c.Sub(subsection), "sampling_ratio")
+	switch {
+	case v <= 0:
+		return SamplingRatioDefault
+	case v > 1:
+		return 1
+	default:
+		return v
+	}
+}
@@ -0,0 +1,36 @@
+package tracingconfig_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.False(t, tracingconfig.Enabled(empty))
+		require.False(t, tracingconfig.Insecure(empty))
+		require.Equal(t, tracingconfig.EndpointDefault, tracingconfig.Endpoint(empty))
+		require.Equal(t, tracingconfig.SamplingRatioDefault, tracingconfig.SamplingRatio(empty))
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.True(t, tracingconfig.Enabled(c))
+		require.True(t, tracingconfig.Insecure(c))
+		require.Equal(t, "localhost:4317", tracingconfig.Endpoint(c))
+		require.Equal(t, 0.5, tracingconfig.SamplingRatio(c))
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
@@ -34,6 +34,7 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -222,9 +223,9 @@ func initContainerService(c *cfg) {
 		),
 	)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		containerGRPC.RegisterContainerServiceServer(srv, server)
-	}
+	})
 }
 
 // addContainerNotificationHandler adds handler that will be executed synchronously.
@@ -2,15 +2,18 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 
 	controlconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tlscert"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type treeSynchronizer struct {
@@ -48,15 +51,52 @@ func initControlService(c *cfg) {
 		controlSvc.WithTreeService(treeSynchronizer{
 			c.treeService,
 		}),
+		controlSvc.WithTrustSource(c.cfgReputation.trustSource),
+		controlSvc.WithDecommissioner(c),
 	)
 
+	var (
+		serverOpts []grpc.ServerOption
+		cert       *tlscert.Certificate
+		err        error
+	)
+
+	if tlsCfg := controlconfig.GRPC(c.appCfg).TLS(); tlsCfg != nil {
+		cert, err = tlscert.New(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
+		if err != nil {
+			c.log.Error("could not read certificate from file (control)", zap.Error(err))
+			return
+		}
+
+		tlsConfig := &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: cert.GetCertificate,
+		}
+
+		if caFile := tlsCfg.ClientCAFile(); caFile != "" {
+			tlsConfig.ClientCAs, err = tlscert.ReadCertPool(caFile)
+			if err != nil {
+				c.log.Error("could not read client CA certificates from file (control)", zap.Error(err))
+				return
+			}
+
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
 	lis, err := net.Listen("tcp", endpoint)
 	if err != nil {
 		c.log.Error("can't listen gRPC endpoint (control)", zap.Error(err))
 		return
 	}
 
-	c.cfgControlService.server = grpc.NewServer()
+	if cert != nil {
+		watchTLSCertificate(c, endpoint, cert)
+	}
+
+	c.cfgControlService.server = grpc.NewServer(serverOpts...)
 
 	c.onShutdown(func() {
 		stopGRPC("NeoFS Control API", c.cfgControlService.server, c.log)
@@ -1,106 +1,328 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	grpcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/grpc"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tlscert"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
-func initGRPC(c *cfg) {
-	var successCount int
-	grpcconfig.IterateEndpoints(c.appCfg, func(sc *grpcconfig.Config) {
-		serverOpts := []grpc.ServerOption{
-			grpc.MaxSendMsgSize(maxMsgSize),
-		}
+// grpcServer is a running gRPC server of the public API.
+type grpcServer struct {
+	cfg  grpcEndpointConfig
+	srv  *grpc.Server
+	lis  net.Listener
+	cert *tlscert.Certificate
 
-		tlsCfg := sc.TLS()
+	// stops certificate watcher, nil without TLS
+	stopWatch context.CancelFunc
+}
 
-		if tlsCfg != nil {
-			cert, err := tls.LoadX509KeyPair(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
-			if err != nil {
-				c.log.Error("could not read certificate from file", zap.Error(err))
-				return
-			}
+// readGRPCEndpoints reads configuration of the gRPC endpoints.
+func readGRPCEndpoints(c *config.Config) []grpcEndpointConfig {
+	var res []grpcEndpointConfig
 
-			var cipherSuites []uint16
-			if !tlsCfg.UseInsecureCrypto() {
-				// This more or less follows the list in https://wiki.mozilla.org/Security/Server_Side_TLS
-				// excluding:
-				// 1. TLS 1.3 suites need not be specified here.
-				// 2. Suites that use DH key exchange are not implemented by stdlib.
-				cipherSuites = []uint16{
-					tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-					tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-					tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
-					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
-				}
-			}
-			creds := credentials.NewTLS(&tls.Config{
-				MinVersion:   tls.VersionTLS12,
-				CipherSuites: cipherSuites,
-				Certificates: []tls.Certificate{cert},
-			})
+	grpcconfig.IterateEndpoints(c, func(sc *grpcconfig.Config) {
+		e := grpcEndpointConfig{
+			endpoint: sc.Endpoint(),
+		}
+
+		if tlsCfg := sc.TLS(); tlsCfg != nil {
+			e.tls = true
+			e.insecureCrypto = tlsCfg.UseInsecureCrypto()
 
-			serverOpts = append(serverOpts, grpc.Creds(creds))
+			// getters panic on empty paths, such paths fail certificate loading instead
+			tlsSection := (*config.Config)(sc).Sub("tls")
+			e.certFile = config.StringSafe(tlsSection, "certificate")
+			e.keyFile = config.StringSafe(tlsSection, "key")
 		}
 
-		lis, err := net.Listen("tcp", sc.Endpoint())
+		res = append(res, e)
+	})
+
+	return res
+}
+
+// sameGRPCServer checks whether gRPC server configured by a can serve
+// endpoint b. Certificate files are reread at runtime.
+func sameGRPCServer(a, b grpcEndpointConfig) bool {
+	return a.endpoint == b.endpoint && a.tls == b.tls && a.insecureCrypto == b.insecureCrypto
+}
+
+// registerGRPCService registers the service on all the gRPC servers including
+// the ones created later.
+func registerGRPCService(c *cfg, register func(*grpc.Server)) {
+	c.cfgGRPC.mtx.Lock()
+	defer c.cfgGRPC.mtx.Unlock()
+
+	c.cfgGRPC.services = append(c.cfgGRPC.services, register)
+
+	for _, s := range c.cfgGRPC.servers {
+		register(s.srv)
+	}
+}
+
+// grpcCertificate returns TLS certificate served on the endpoint, nil if
+// there is no such endpoint or it is served without TLS.
+func (c *cfg) grpcCertificate(endpoint string) *tlscert.Certificate {
+	c.cfgGRPC.mtx.Lock()
+	defer c.cfgGRPC.mtx.Unlock()
+
+	if s, ok := c.cfgGRPC.servers[endpoint]; ok {
+		return s.cert
+	}
+
+	return nil
+}
+
+// watchTLSCertificate exposes expiration time of the certificate served on the
+// endpoint as a metric and reloads the certificate on its files' changes until
+// the returned function is called.
+func watchTLSCertificate(c *cfg, endpoint string, cert *tlscert.Certificate) context.CancelFunc {
+	c.updateCertificateExpiry(endpoint, cert)
+
+	ctx, cancel := context.WithCancel(c.ctx)
+
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+
+		l := c.log.With(zap.String("endpoint", endpoint))
+
+		err := cert.Watch(ctx, l, func() {
+			c.updateCertificateExpiry(endpoint, cert)
+		})
 		if err != nil {
-			c.log.Error("can't listen gRPC endpoint", zap.Error(err))
-			return
+			l.Error("could not watch TLS certificate files", zap.Error(err))
 		}
+	}()
+
+	return cancel
+}
+
+func (c *cfg) updateCertificateExpiry(endpoint string, cert *tlscert.Certificate) {
+	if c.metricsCollector != nil {
+		c.metricsCollector.SetCertificateExpiry(endpoint, cert.NotAfter())
+	}
+}
 
-		c.cfgGRPC.listeners = append(c.cfgGRPC.listeners, lis)
+// newGRPCServer starts listening the configured endpoint and returns
+// the server with all the registered services. Must be called under the lock.
+func newGRPCServer(c *cfg, e grpcEndpointConfig) (*grpcServer, error) {
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxSendMsgSize(maxMsgSize),
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor()),
+	}
 
-		srv := grpc.NewServer(serverOpts...)
+	var cert *tlscert.Certificate
 
-		c.onShutdown(func() {
-			stopGRPC("NeoFS Public API", srv, c.log)
+	if e.tls {
+		var err error
+		cert, err = tlscert.New(e.certFile, e.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read certificate from file: %w", err)
+		}
+
+		var cipherSuites []uint16
+		if !e.insecureCrypto {
+			// This more or less follows the list in https://wiki.mozilla.org/Security/Server_Side_TLS
+			// excluding:
+			// 1. TLS 1.3 suites need not be specified here.
+			// 2. Suites that use DH key exchange are not implemented by stdlib.
+			cipherSuites = []uint16{
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+			}
+		}
+		creds := credentials.NewTLS(&tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			CipherSuites:   cipherSuites,
+			GetCertificate: cert.GetCertificate,
 		})
 
-		c.cfgGRPC.servers = append(c.cfgGRPC.servers, srv)
-		successCount++
-	})
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", e.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("can't listen gRPC endpoint: %w", err)
+	}
 
-	if successCount == 0 {
+	s := &grpcServer{
+		cfg:  e,
+		srv:  grpc.NewServer(serverOpts...),
+		lis:  lis,
+		cert: cert,
+	}
+
+	for _, register := range c.cfgGRPC.services {
+		register(s.srv)
+	}
+
+	if cert != nil {
+		s.stopWatch = watchTLSCertificate(c, e.endpoint, cert)
+	}
+
+	return s, nil
+}
+
+func initGRPC(c *cfg) {
+	c.cfgGRPC.servers = make(map[string]*grpcServer)
+
+	for _, e := range readGRPCEndpoints(c.appCfg) {
+		s, err := newGRPCServer(c, e)
+		if err != nil {
+			c.log.Error("could not init gRPC server",
+				zap.String("endpoint", e.endpoint),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		c.cfgGRPC.servers[e.endpoint] = s
+	}
+
+	if len(c.cfgGRPC.servers) == 0 {
 		fatalOnErr(errors.New("could not listen to any gRPC endpoints"))
 	}
+
+	c.onShutdown(func() {
+		c.cfgGRPC.mtx.Lock()
+		defer c.cfgGRPC.mtx.Unlock()
+
+		for endpoint, s := range c.cfgGRPC.servers {
+			stopGRPCServer(c, s)
+			delete(c.cfgGRPC.servers, endpoint)
+		}
+	})
 }
 
 func serveGRPC(c *cfg) {
-	for i := range c.cfgGRPC.servers {
-		c.wg.Add(1)
+	c.cfgGRPC.mtx.Lock()
+	defer c.cfgGRPC.mtx.Unlock()
 
-		srv := c.cfgGRPC.servers[i]
-		lis := c.cfgGRPC.listeners[i]
+	c.cfgGRPC.serving = true
 
-		go func() {
-			defer func() {
-				c.log.Info("stop listening gRPC endpoint",
-					zap.String("endpoint", lis.Addr().String()),
-				)
+	for _, s := range c.cfgGRPC.servers {
+		startGRPCServer(c, s)
+	}
+}
 
-				c.wg.Done()
-			}()
+func startGRPCServer(c *cfg, s *grpcServer) {
+	c.wg.Add(1)
 
-			c.log.Info("start listening gRPC endpoint",
-				zap.String("endpoint", lis.Addr().String()),
+	go func() {
+		defer func() {
+			c.log.Info("stop listening gRPC endpoint",
+				zap.String("endpoint", s.lis.Addr().String()),
 			)
 
-			if err := srv.Serve(lis); err != nil {
-				fmt.Println("gRPC server error", err)
-			}
+			c.wg.Done()
 		}()
+
+		c.log.Info("start listening gRPC endpoint",
+			zap.String("endpoint", s.lis.Addr().String()),
+		)
+
+		if err := s.srv.Serve(s.lis); err != nil {
+			fmt.Println("gRPC server error", err)
+		}
+	}()
+}
+
+func stopGRPCServer(c *cfg, s *grpcServer) {
+	stopGRPC("NeoFS Public API", s.srv, c.log)
+
+	if s.stopWatch != nil {
+		s.stopWatch()
+	}
+}
+
+// reloadGRPC stops servers of the endpoints missing in the new configuration
+// or served in a different way and starts servers of the new endpoints.
+// Graceful stop may take a while, so the servers are stopped without holding
+// the lock.
+func reloadGRPC(c *cfg, endpoints []grpcEndpointConfig) error {
+	next := make(map[string]grpcEndpointConfig, len(endpoints))
+	for _, e := range endpoints {
+		next[e.endpoint] = e
+	}
+
+	c.cfgGRPC.mtx.Lock()
+
+	var stopped []*grpcServer
+
+	for endpoint, s := range c.cfgGRPC.servers {
+		if e, ok := next[endpoint]; ok && sameGRPCServer(s.cfg, e) {
+			continue
+		}
+
+		stopped = append(stopped, s)
+		delete(c.cfgGRPC.servers, endpoint)
+	}
+
+	c.cfgGRPC.mtx.Unlock()
+
+	for _, s := range stopped {
+		stopGRPCServer(c, s)
+
+		if c.metricsCollector != nil && s.cert != nil {
+			c.metricsCollector.DeleteCertificateExpiry(s.cfg.endpoint)
+		}
 	}
+
+	c.cfgGRPC.mtx.Lock()
+	defer c.cfgGRPC.mtx.Unlock()
+
+	var failed []string
+
+	for _, e := range endpoints {
+		if _, ok := c.cfgGRPC.servers[e.endpoint]; ok {
+			continue
+		}
+
+		s, err := newGRPCServer(c, e)
+		if err != nil {
+			c.log.Error("could not init gRPC server",
+				zap.String("endpoint", e.endpoint),
+				zap.Error(err),
+			)
+
+			failed = append(failed, e.endpoint)
+
+			continue
+		}
+
+		c.cfgGRPC.servers[e.endpoint] = s
+
+		if c.cfgGRPC.serving {
+			startGRPCServer(c, s)
+		}
+	}
+
+	if len(failed) != 0 {
+		return fmt.Errorf("could not serve gRPC endpoints: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
 }
 
 func stopGRPC(name string, s *grpc.Server, l *zap.Logger) {
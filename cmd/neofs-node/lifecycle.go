@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// lifecycleRulesSource reads container lifecycle rules from the container
+// source of the node. Container source is initialized after the local
+// storage, so it is resolved on each call.
+type lifecycleRulesSource struct {
+	c *cfg
+}
+
+func (x lifecycleRulesSource) LifecycleRules(id cid.ID) (container.LifecycleRules, error) {
+	src := x.c.cfgObject.cnrSource
+	if src == nil {
+		return nil, nil
+	}
+
+	cnr, err := src.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return container.ReadLifecycleRules(cnr.Value)
+}
+
+// objectVersions reads versions of the objects from the network through
+// the object services of the node.
+type objectVersions struct {
+	c *cfg
+}
+
+type idListWriter []oid.ID
+
+func (x *idListWriter) WriteIDs(ids []oid.ID) error {
+	*x = append(*x, ids...)
+	return nil
+}
+
+func (x objectVersions) Versions(ctx context.Context, cnr cid.ID, attr, value string) ([]uint64, error) {
+	var (
+		ids     idListWriter
+		fs      object.SearchFilters
+		prm     searchsvc.Prm
+		headPrm getsvc.HeadPrm
+	)
+
+	fs.AddRootFilter()
+	fs.AddFilter(attr, value, object.MatchStringEqual)
+
+	prm.SetCommonParameters(&util.CommonPrm{}) // default values are ok for that operation
+	prm.SetWriter(&ids)
+	prm.WithContainerID(cnr)
+	prm.WithSearchFilters(fs)
+
+	err := x.c.cfgObject.searchSvc.Search(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("search objects: %w", err)
+	}
+
+	res := make([]uint64, 0, len(ids))
+
+	for _, id := range ids {
+		var addr oid.Address
+		addr.SetContainer(cnr)
+		addr.SetObject(id)
+
+		wr := getsvc.NewSimpleObjectWriter()
+
+		headPrm.SetCommonParameters(&util.CommonPrm{})
+		headPrm.SetHeaderWriter(wr)
+		headPrm.WithAddress(addr)
+
+		err = x.c.cfgObject.getSvc.Head(ctx, headPrm)
+		if err != nil {
+			return nil, fmt.Errorf("head object %s: %w", id, err)
+		}
+
+		res = append(res, wr.Object().CreationEpoch())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	limitsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/limits"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+func newRateLimiter(c *cfg) *ratelimit.Limiter {
+	rateLimit := func(rc *limitsconfig.RateConfig) ratelimit.Limit {
+		return ratelimit.Limit{
+			Rate:  rc.Rate(),
+			Burst: rc.Burst(),
+		}
+	}
+
+	owner := limitsconfig.Owner(c.appCfg)
+	cnr := limitsconfig.Container(c.appCfg)
+
+	prm := ratelimit.Config{
+		Owner:              rateLimit(owner),
+		Container:          rateLimit(cnr),
+		Methods:            make(map[string]ratelimit.Limit),
+		OwnerBandwidth:     owner.Bandwidth(),
+		ContainerBandwidth: cnr.Bandwidth(),
+		NodeQuotas:         make(map[cid.ID]uint64),
+		CacheSize:          limitsconfig.CacheSize(c.appCfg),
+	}
+
+	for _, m := range ratelimit.Methods {
+		prm.Methods[m] = rateLimit(limitsconfig.RPC(c.appCfg, m))
+	}
+
+	limitsconfig.IterateNodeQuotas(c.appCfg, func(id cid.ID, size uint64) {
+		prm.NodeQuotas[id] = size
+	})
+
+	return ratelimit.New(prm)
+}
+
+// localContainerSizes provides sizes of the containers
+// stored in the local storage engine.
+type localContainerSizes struct {
+	e *engine.StorageEngine
+}
+
+func (x localContainerSizes) ContainerSize(id cid.ID) (uint64, error) {
+	return engine.ContainerSize(x.e, id)
+}
@@ -31,12 +31,16 @@ func initMorphComponents(c *cfg) {
 
 	addresses := morphconfig.Endpoints(c.appCfg)
 
-	cli, err := client.New(c.key,
+	opts := []client.Option{
 		client.WithDialTimeout(morphconfig.DialTimeout(c.appCfg)),
 		client.WithLogger(c.log),
 		client.WithEndpoints(addresses),
 		client.WithReconnectionRetries(morphconfig.ReconnectionRetriesNumber(c.appCfg)),
 		client.WithReconnectionsDelay(morphconfig.ReconnectionRetriesDelay(c.appCfg)),
+		client.WithHealthCheckInterval(morphconfig.HealthCheckInterval(c.appCfg)),
+		client.WithMaxBlockLag(morphconfig.MaxBlockLag(c.appCfg)),
+		client.WithMaxErrorRate(morphconfig.MaxErrorRate(c.appCfg)),
+		client.WithMaxLatency(morphconfig.MaxLatency(c.appCfg)),
 		client.WithConnSwitchCallback(func() {
 			err = c.restartMorph()
 			if err != nil {
@@ -46,7 +50,13 @@ func initMorphComponents(c *cfg) {
 		client.WithConnLostCallback(func() {
 			c.internalErr <- errors.New("morph connection has been lost")
 		}),
-	)
+	}
+
+	if c.metricsCollector != nil {
+		opts = append(opts, client.WithMetrics(c.metricsCollector.RPCMetrics("morph")))
+	}
+
+	cli, err := client.New(c.key, opts...)
 	if err != nil {
 		c.log.Info("failed to create neo RPC client",
 			zap.Any("endpoints", addresses),
@@ -15,10 +15,12 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	netmapTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/netmap/grpc"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/decommission"
 	netmapService "github.com/nspcc-dev/neofs-node/pkg/services/netmap"
 	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 // primary solution of local network state dump.
@@ -163,9 +165,9 @@ func initNetmapService(c *cfg) {
 		),
 	)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		netmapGRPC.RegisterNetmapServiceServer(srv, server)
-	}
+	})
 
 	addNewEpochNotificationHandler(c, func(ev event.Event) {
 		c.cfgNetmap.state.setCurrentEpoch(ev.(netmapEvent.NewEpoch).EpochNumber())
@@ -356,6 +358,64 @@ func (c *cfg) ForceMaintenance() error {
 	return c.setMaintenanceStatus(true)
 }
 
+var errDecommissionMaintenance = errors.New("decommission is not allowed under maintenance")
+
+// StartDecommission starts decommission of the storage node. Node under
+// maintenance can not be decommissioned since it does not serve objects.
+func (c *cfg) StartDecommission() error {
+	if c.IsMaintenance() {
+		return errDecommissionMaintenance
+	}
+
+	c.decommissioner.Start()
+	c.switchDecommission(true)
+
+	return nil
+}
+
+// StopDecommission stops decommission of the storage node.
+func (c *cfg) StopDecommission() {
+	c.decommissioner.Stop()
+	c.switchDecommission(false)
+}
+
+// switchDecommission persists decommission state of the storage node and
+// announces it in the network map, so that the state survives restarts and
+// the other nodes stop counting the local replicas.
+func (c *cfg) switchDecommission(enabled bool) {
+	v := []byte{0}
+	if enabled {
+		v[0] = 1
+	}
+
+	err := c.persistate.SetBytes(persistateDecommissionKey, v)
+	if err != nil {
+		c.log.Warn("can't persist decommission state", zap.Bool("enabled", enabled), zap.Error(err))
+	}
+
+	if !c.needBootstrap() || c.cfgNetmap.reBoostrapTurnedOff.Load() {
+		return
+	}
+
+	// otherwise the state is announced with the next re-bootstrap
+	err = c.bootstrap()
+	if err != nil {
+		c.log.Warn("can't announce decommission state in the network map", zap.Bool("enabled", enabled), zap.Error(err))
+	}
+}
+
+// IsLocalNodeDecommissioning checks if the local node announces decommission
+// in the current network map.
+func (c *cfg) IsLocalNodeDecommissioning() bool {
+	ni, ok := c.cfgNetmap.state.getNodeInfo()
+	return ok && netmap.IsDecommissioning(ni)
+}
+
+// DecommissionStatus returns decommission progress of the storage node.
+func (c *cfg) DecommissionStatus() decommission.Status {
+	return c.decommissioner.Status()
+}
+
 func (c *cfg) setMaintenanceStatus(force bool) error {
 	netSettings, err := c.cfgNetmap.wrapper.ReadNetworkConfiguration()
 	if err != nil {
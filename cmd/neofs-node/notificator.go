@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -15,10 +19,29 @@ import (
 	"go.uber.org/zap"
 )
 
+// notificationTopic is a default notification topic which can be changed
+// at runtime. Empty topic is replaced with the fallback one.
+type notificationTopic struct {
+	fallback string
+	v        atomic.Pointer[string]
+}
+
+func (t *notificationTopic) set(topic string) {
+	if topic == "" {
+		topic = t.fallback
+	}
+
+	t.v.Store(&topic)
+}
+
+func (t *notificationTopic) get() string {
+	return *t.v.Load()
+}
+
 type notificationSource struct {
 	e            *engine.StorageEngine
 	l            *zap.Logger
-	defaultTopic string
+	defaultTopic *notificationTopic
 }
 
 func (n *notificationSource) Iterate(epoch uint64, handler func(topic string, addr oid.Address)) {
@@ -83,7 +106,7 @@ func (n *notificationSource) processAddress(
 	topic := ni.Topic()
 
 	if topic == "" {
-		topic = n.defaultTopic
+		topic = n.defaultTopic.get()
 	}
 
 	h(topic, a)
@@ -91,13 +114,37 @@ func (n *notificationSource) processAddress(
 	return nil
 }
 
+// notificationWriter writes object notifications to the NATS server. The
+// server connection can be replaced at runtime, notifications are dropped
+// while there is no connection.
 type notificationWriter struct {
 	l *zap.Logger
-	w *nats.Writer
+
+	// connection name used in the server side logs
+	name string
+
+	// serializes connection changes
+	mtx  sync.Mutex
+	conn atomic.Pointer[natsConnection]
 }
 
-func (n notificationWriter) Notify(topic string, address oid.Address) {
-	if err := n.w.Notify(topic, address); err != nil {
+type natsConnection struct {
+	w     *nats.Writer
+	close context.CancelFunc
+}
+
+// enabled checks whether the notifications are written at the moment.
+func (n *notificationWriter) enabled() bool {
+	return n.conn.Load() != nil
+}
+
+func (n *notificationWriter) Notify(topic string, address oid.Address) {
+	conn := n.conn.Load()
+	if conn == nil {
+		return
+	}
+
+	if err := conn.w.Notify(topic, address); err != nil {
 		n.l.Warn("could not write object notification",
 			zap.Stringer("address", address),
 			zap.String("topic", topic),
@@ -106,62 +153,99 @@ func (n notificationWriter) Notify(topic string, address oid.Address) {
 	}
 }
 
-func initNotifications(c *cfg) {
-	if nodeconfig.Notification(c.appCfg).Enabled() {
-		topic := nodeconfig.Notification(c.appCfg).DefaultTopic()
-		pubKey := hex.EncodeToString(c.cfgNodeInfo.localInfo.PublicKey())
-
-		if topic == "" {
-			topic = pubKey
-		}
-
-		natsSvc := nats.New(
-			nats.WithConnectionName("NeoFS Storage Node: "+pubKey), // connection name is used in the server side logs
-			nats.WithTimeout(nodeconfig.Notification(c.appCfg).Timeout()),
-			nats.WithClientCert(
-				nodeconfig.Notification(c.appCfg).CertPath(),
-				nodeconfig.Notification(c.appCfg).KeyPath(),
-			),
-			nats.WithRootCA(nodeconfig.Notification(c.appCfg).CAPath()),
-			nats.WithLogger(c.log),
+// connect establishes a new connection to the NATS server according to the
+// configuration and closes the previous one. Disabled notifications just
+// close the current connection. Current connection is kept on failure.
+func (n *notificationWriter) connect(ctx context.Context, cfg notificationConfig) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	var conn *natsConnection
+
+	if cfg.enabled {
+		w := nats.New(
+			nats.WithConnectionName(n.name),
+			nats.WithTimeout(cfg.timeout),
+			nats.WithClientCert(cfg.certPath, cfg.keyPath),
+			nats.WithRootCA(cfg.caPath),
+			nats.WithLogger(n.l),
 		)
 
-		c.cfgNotifications = cfgNotifications{
-			enabled: true,
-			nw: notificationWriter{
-				l: c.log,
-				w: natsSvc,
-			},
-			defaultTopic: topic,
+		connCtx, cancel := context.WithCancel(ctx)
+
+		err := w.Connect(connCtx, cfg.endpoint)
+		if err != nil {
+			cancel()
+			return fmt.Errorf("could not connect to a nats endpoint %s: %w", cfg.endpoint, err)
 		}
 
-		n := notificator.New(new(notificator.Prm).
-			SetLogger(c.log).
-			SetNotificationSource(
-				&notificationSource{
-					e:            c.cfgObject.cfgLocalStorage.localStorage,
-					l:            c.log,
-					defaultTopic: topic,
-				}).
-			SetWriter(c.cfgNotifications.nw),
-		)
+		conn = &natsConnection{w: w, close: cancel}
+	}
 
-		addNewEpochAsyncNotificationHandler(c, func(e event.Event) {
-			ev := e.(netmap.NewEpoch)
+	if prev := n.conn.Swap(conn); prev != nil {
+		prev.close()
+	}
 
-			n.ProcessEpoch(ev.EpochNumber())
-		})
+	return nil
+}
+
+// readNotificationConfig reads "node.notification" section parameters
+// of the NATS connection.
+func readNotificationConfig(c *config.Config) notificationConfig {
+	notificationCfg := nodeconfig.Notification(c)
+
+	return notificationConfig{
+		enabled:  notificationCfg.Enabled(),
+		endpoint: notificationCfg.Endpoint(),
+		timeout:  notificationCfg.Timeout(),
+		certPath: notificationCfg.CertPath(),
+		keyPath:  notificationCfg.KeyPath(),
+		caPath:   notificationCfg.CAPath(),
 	}
 }
 
-func connectNats(c *cfg) {
-	if !c.cfgNotifications.enabled {
-		return
+// initNotifications prepares object notifications. They are written only
+// when enabled in the configuration, but can be enabled and reconfigured at
+// runtime.
+func initNotifications(c *cfg) {
+	pubKey := hex.EncodeToString(c.cfgNodeInfo.localInfo.PublicKey())
+
+	topic := &notificationTopic{fallback: pubKey}
+	topic.set(nodeconfig.Notification(c.appCfg).DefaultTopic())
+
+	c.cfgNotifications = cfgNotifications{
+		nw: &notificationWriter{
+			l:    c.log,
+			name: "NeoFS Storage Node: " + pubKey,
+		},
+		defaultTopic: topic,
 	}
 
-	endpoint := nodeconfig.Notification(c.appCfg).Endpoint()
-	err := c.cfgNotifications.nw.w.Connect(c.ctx, endpoint)
+	n := notificator.New(new(notificator.Prm).
+		SetLogger(c.log).
+		SetNotificationSource(
+			&notificationSource{
+				e:            c.cfgObject.cfgLocalStorage.localStorage,
+				l:            c.log,
+				defaultTopic: topic,
+			}).
+		SetWriter(c.cfgNotifications.nw),
+	)
+
+	addNewEpochAsyncNotificationHandler(c, func(e event.Event) {
+		if !c.cfgNotifications.nw.enabled() {
+			return
+		}
+
+		ev := e.(netmap.NewEpoch)
+
+		n.ProcessEpoch(ev.EpochNumber())
+	})
+}
+
+func connectNats(c *cfg) {
+	err := c.cfgNotifications.nw.connect(c.ctx, readNotificationConfig(c.appCfg))
 	if err != nil {
-		panic(fmt.Sprintf("could not connect to a nats endpoint %s: %v", endpoint, err))
+		panic(err)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
@@ -18,6 +19,7 @@ import (
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	objectTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/object/grpc"
+	"github.com/nspcc-dev/neofs-node/pkg/services/decommission"
 	objectService "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
 	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
@@ -34,8 +36,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -43,6 +47,7 @@ import (
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 type objectSvc struct {
@@ -152,27 +157,36 @@ func initObjectService(c *cfg) {
 	keyStorage := util.NewKeyStorage(&c.key.PrivateKey, c.privateTokenStore, c.cfgNetmap.state)
 
 	clientConstructor := &reputationClientConstructor{
-		log:              c.log,
-		nmSrc:            c.netMapSource,
-		netState:         c.cfgNetmap.state,
-		trustStorage:     c.cfgReputation.localTrustStorage,
-		basicConstructor: c.bgClientCache,
+		log:                 c.log,
+		nmSrc:               c.netMapSource,
+		netState:            c.cfgNetmap.state,
+		trustStorage:        c.cfgReputation.localTrustStorage,
+		latencyStorage:      c.cfgReputation.latencyTrustStorage,
+		latencyThreshold:    c.cfgReputation.latencyThreshold,
+		availabilityStorage: c.cfgReputation.availabilityTrustStorage,
+		basicConstructor:    c.bgClientCache,
 	}
 
 	coreConstructor := &coreClientConstructor{
-		log:              c.log,
-		nmSrc:            c.netMapSource,
-		netState:         c.cfgNetmap.state,
-		trustStorage:     c.cfgReputation.localTrustStorage,
-		basicConstructor: c.clientCache,
+		log:                 c.log,
+		nmSrc:               c.netMapSource,
+		netState:            c.cfgNetmap.state,
+		trustStorage:        c.cfgReputation.localTrustStorage,
+		latencyStorage:      c.cfgReputation.latencyTrustStorage,
+		latencyThreshold:    c.cfgReputation.latencyThreshold,
+		availabilityStorage: c.cfgReputation.availabilityTrustStorage,
+		basicConstructor:    c.clientCache,
 	}
 
 	putConstructor := &coreClientConstructor{
-		log:              c.log,
-		nmSrc:            c.netMapSource,
-		netState:         c.cfgNetmap.state,
-		trustStorage:     c.cfgReputation.localTrustStorage,
-		basicConstructor: c.putClientCache,
+		log:                 c.log,
+		nmSrc:               c.netMapSource,
+		netState:            c.cfgNetmap.state,
+		trustStorage:        c.cfgReputation.localTrustStorage,
+		latencyStorage:      c.cfgReputation.latencyTrustStorage,
+		latencyThreshold:    c.cfgReputation.latencyThreshold,
+		availabilityStorage: c.cfgReputation.availabilityTrustStorage,
+		basicConstructor:    c.putClientCache,
 	}
 
 	irFetcher := &innerRingFetcherWithNotary{
@@ -222,21 +236,37 @@ func initObjectService(c *cfg) {
 		policer.WithNetwork(c),
 	)
 
-	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
+	c.decommissioner = decommission.New(
+		decommission.WithLogger(c.log),
+		decommission.WithLocalStorage(ls),
+		decommission.WithPolicer(pol),
+		decommission.WithNetworkState(c),
+	)
 
-	c.workers = append(c.workers, pol)
+	c.closers = append(c.closers, c.decommissioner.Stop)
 
-	var os putsvc.ObjectStorage = engineWithoutNotifications{
-		engine: ls,
+	// decommission is continued after restart and announced on bootstrap
+	if v, err := c.persistate.Bytes(persistateDecommissionKey); err != nil {
+		c.log.Warn("can't read persisted decommission state", zap.Error(err))
+	} else if len(v) == 1 && v[0] != 0 {
+		c.decommissioner.Start()
 	}
 
-	if c.cfgNotifications.enabled {
-		os = engineWithNotifications{
-			base:         os,
-			nw:           c.cfgNotifications.nw,
-			ns:           c.cfgNetmap.state,
-			defaultTopic: c.cfgNotifications.defaultTopic,
-		}
+	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
+
+	c.policer = pol
+	c.workers = append(c.workers, pol)
+
+	var os putsvc.ObjectStorage = engineWithNotifications{
+		base: engineWithDecommission{
+			base: engineWithoutNotifications{
+				engine: ls,
+			},
+			d: c.decommissioner,
+		},
+		nw:           c.cfgNotifications.nw,
+		ns:           c.cfgNetmap.state,
+		defaultTopic: c.cfgNotifications.defaultTopic,
 	}
 
 	sPut := putsvc.NewService(
@@ -249,6 +279,7 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetmapKeys(c),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote),
+		putsvc.WithNodeState(c.decommissioner),
 		putsvc.WithLogger(c.log),
 	)
 
@@ -270,6 +301,8 @@ func initObjectService(c *cfg) {
 		searchsvc.WithKeyStorage(keyStorage),
 	)
 
+	*c.cfgObject.searchSvc = *sSearch
+
 	sSearchV2 := searchsvcV2.NewService(
 		searchsvcV2.WithInternalService(sSearch),
 		searchsvcV2.WithKeyStorage(keyStorage),
@@ -314,7 +347,7 @@ func initObjectService(c *cfg) {
 	)
 
 	// build service pipeline
-	// grpc | <metrics> | signature | response | acl | split
+	// grpc | <metrics> | signature | response | limits | acl | split
 
 	splitSvc := objectService.NewTransportSplitter(
 		c.cfgGRPC.maxChunkSize,
@@ -348,8 +381,11 @@ func initObjectService(c *cfg) {
 	var commonSvc objectService.Common
 	commonSvc.Init(&c.internals, aclSvc)
 
+	var limitsSvc objectService.Limits
+	limitsSvc.Init(c.limiter, localContainerSizes{e: ls}, &commonSvc)
+
 	respSvc := objectService.NewResponseService(
-		&commonSvc,
+		&limitsSvc,
 		c.respSvc,
 	)
 
@@ -365,9 +401,9 @@ func initObjectService(c *cfg) {
 
 	server := objectTransportGRPC.New(firstSvc)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		objectGRPC.RegisterObjectServiceServer(srv, server)
-	}
+	})
 }
 
 type morphEACLFetcher struct {
@@ -402,6 +438,12 @@ type reputationClientConstructor struct {
 
 	trustStorage *truststorage.Storage
 
+	latencyStorage *truststorage.Storage
+
+	latencyThreshold time.Duration
+
+	availabilityStorage *truststorage.Storage
+
 	basicConstructor interface {
 		Get(coreclient.NodeInfo) (coreclient.Client, error)
 	}
@@ -415,7 +457,7 @@ type reputationClient struct {
 	cons *reputationClientConstructor
 }
 
-func (c *reputationClient) submitResult(err error) {
+func (c *reputationClient) submitResult(err error, latency time.Duration) {
 	currEpoch := c.cons.netState.CurrentEpoch()
 	sat := err == nil
 
@@ -423,6 +465,7 @@ func (c *reputationClient) submitResult(err error) {
 		"writing local reputation values",
 		zap.Uint64("epoch", currEpoch),
 		zap.Bool("satisfactory", sat),
+		zap.Duration("latency", latency),
 	)
 
 	prm := c.prm
@@ -430,56 +473,95 @@ func (c *reputationClient) submitResult(err error) {
 	prm.SetEpoch(currEpoch)
 
 	c.cons.trustStorage.Update(prm)
+
+	if sat {
+		prm.SetValue(latencyScore(latency, c.cons.latencyThreshold))
+
+		c.cons.latencyStorage.Update(prm)
+	}
+
+	prm.SetSatisfactory(responded(err))
+
+	c.cons.availabilityStorage.Update(prm)
+}
+
+// responded checks whether the peer responded to the request: any status
+// except node maintenance means the peer is available.
+func responded(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	var st apistatus.StatusV2
+
+	return errors.As(err, &st) && !errors.Is(err, apistatus.ErrNodeUnderMaintenance)
+}
+
+// latencyScore scores the request latency: requests not longer
+// than threshold are fully satisfactory, longer ones are scored
+// inversely proportional to their latency.
+func latencyScore(latency, threshold time.Duration) reputation.TrustValue {
+	if latency <= threshold {
+		return reputation.TrustOne
+	}
+
+	return reputation.TrustValueFromFloat64(float64(threshold) / float64(latency))
 }
 
 func (c *reputationClient) ObjectPutInit(ctx context.Context, hdr objectSDK.Object, signer user.Signer, prm client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	start := time.Now()
 	res, err := c.MultiAddressClient.ObjectPutInit(ctx, hdr, signer, prm)
 
 	// FIXME: (neofs-node#1193) here we submit only initialization errors, writing errors are not processed
-	c.submitResult(err)
+	c.submitResult(err, time.Since(start))
 
 	return res, err
 }
 
 func (c *reputationClient) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (oid.ID, error) {
+	start := time.Now()
 	res, err := c.MultiAddressClient.ObjectDelete(ctx, containerID, objectID, signer, prm)
 	if err != nil {
-		c.submitResult(err)
+		c.submitResult(err, time.Since(start))
 	}
 
 	return res, err
 }
 
 func (c *reputationClient) GetObjectInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (objectSDK.Object, *client.PayloadReader, error) {
+	start := time.Now()
 	hdr, rdr, err := c.MultiAddressClient.ObjectGetInit(ctx, containerID, objectID, signer, prm)
 
 	// FIXME: (neofs-node#1193) here we submit only initialization errors, reading errors are not processed
-	c.submitResult(err)
+	c.submitResult(err, time.Since(start))
 
 	return hdr, rdr, err
 }
 
 func (c *reputationClient) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*objectSDK.Object, error) {
+	start := time.Now()
 	res, err := c.MultiAddressClient.ObjectHead(ctx, containerID, objectID, signer, prm)
 
-	c.submitResult(err)
+	c.submitResult(err, time.Since(start))
 
 	return res, err
 }
 
 func (c *reputationClient) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHash) ([][]byte, error) {
+	start := time.Now()
 	res, err := c.MultiAddressClient.ObjectHash(ctx, containerID, objectID, signer, prm)
 
-	c.submitResult(err)
+	c.submitResult(err, time.Since(start))
 
 	return res, err
 }
 
 func (c *reputationClient) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm client.PrmObjectSearch) (*client.ObjectListReader, error) {
+	start := time.Now()
 	res, err := c.MultiAddressClient.ObjectSearchInit(ctx, containerID, signer, prm)
 
 	// FIXME: (neofs-node#1193) here we submit only initialization errors, reading errors are not processed
-	c.submitResult(err)
+	c.submitResult(err, time.Since(start))
 
 	return res, err
 }
@@ -522,10 +604,10 @@ func (c *reputationClientConstructor) Get(info coreclient.NodeInfo) (coreclient.
 
 type engineWithNotifications struct {
 	base putsvc.ObjectStorage
-	nw   notificationWriter
+	nw   *notificationWriter
 	ns   netmap.State
 
-	defaultTopic string
+	defaultTopic *notificationTopic
 }
 
 func (e engineWithNotifications) IsLocked(address oid.Address) (bool, error) {
@@ -540,18 +622,22 @@ func (e engineWithNotifications) Lock(locker oid.Address, toLock []oid.ID) error
 	return e.base.Lock(locker, toLock)
 }
 
-func (e engineWithNotifications) Put(o *objectSDK.Object) error {
-	if err := e.base.Put(o); err != nil {
+func (e engineWithNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	if err := e.base.Put(ctx, o); err != nil {
 		return err
 	}
 
+	if !e.nw.enabled() {
+		return nil
+	}
+
 	ni, err := o.NotificationInfo()
 	if err == nil {
 		if epoch := ni.Epoch(); epoch == 0 || epoch == e.ns.CurrentEpoch() {
 			topic := ni.Topic()
 
 			if topic == "" {
-				topic = e.defaultTopic
+				topic = e.defaultTopic.get()
 			}
 
 			e.nw.Notify(topic, objectCore.AddressOf(o))
@@ -561,6 +647,35 @@ func (e engineWithNotifications) Put(o *objectSDK.Object) error {
 	return nil
 }
 
+// engineWithDecommission submits objects stored locally to the
+// Decommissioner, so that they are handed over before the node shutdown.
+type engineWithDecommission struct {
+	base putsvc.ObjectStorage
+	d    *decommission.Decommissioner
+}
+
+func (e engineWithDecommission) IsLocked(address oid.Address) (bool, error) {
+	return e.base.IsLocked(address)
+}
+
+func (e engineWithDecommission) Delete(tombstone oid.Address, toDelete []oid.ID) error {
+	return e.base.Delete(tombstone, toDelete)
+}
+
+func (e engineWithDecommission) Lock(locker oid.Address, toLock []oid.ID) error {
+	return e.base.Lock(locker, toLock)
+}
+
+func (e engineWithDecommission) Put(ctx context.Context, o *objectSDK.Object) error {
+	if err := e.base.Put(ctx, o); err != nil {
+		return err
+	}
+
+	e.d.HandleStoredObject(objectCore.AddressWithType{Address: objectCore.AddressOf(o), Type: o.Type()})
+
+	return nil
+}
+
 type engineWithoutNotifications struct {
 	engine *engine.StorageEngine
 }
@@ -588,6 +703,11 @@ func (e engineWithoutNotifications) Lock(locker oid.Address, toLock []oid.ID) er
 	return e.engine.Lock(locker.Container(), locker.Object(), toLock)
 }
 
-func (e engineWithoutNotifications) Put(o *objectSDK.Object) error {
-	return engine.Put(e.engine, o)
+func (e engineWithoutNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	var prm engine.PutPrm
+	prm.WithObject(o)
+	prm.WithContext(ctx)
+
+	_, err := e.engine.Put(prm)
+	return err
 }
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	morphconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/morph"
+	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
+	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
+	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
+	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
+	"go.uber.org/zap"
+)
+
+// reloadableConfig is a snapshot of the configuration values which are
+// checked for changes on SIGHUP.
+type reloadableConfig struct {
+	grpc []grpcEndpointConfig
+
+	policerHeadTimeout time.Duration
+
+	replicatorPutTimeout time.Duration
+	replicatorPoolSize   int
+
+	putRemotePoolSize int
+
+	treeSyncInterval time.Duration
+	tree             treeServiceConfig
+
+	notificationDefaultTopic string
+	notification             notificationConfig
+
+	morphEndpoints []string
+	morph          morphConfig
+}
+
+type grpcEndpointConfig struct {
+	endpoint string
+
+	tls               bool
+	certFile, keyFile string
+	insecureCrypto    bool
+}
+
+// treeServiceConfig groups "tree" section parameters requiring restart.
+type treeServiceConfig struct {
+	enabled                    bool
+	cacheSize                  int
+	replicationTimeout         time.Duration
+	replicationChannelCapacity int
+	replicationWorkerCount     int
+}
+
+// notificationConfig groups "node.notification" section parameters of the
+// NATS connection.
+type notificationConfig struct {
+	enabled                   bool
+	endpoint                  string
+	timeout                   time.Duration
+	certPath, keyPath, caPath string
+}
+
+// morphConfig groups "morph" section parameters requiring restart.
+type morphConfig struct {
+	dialTimeout         time.Duration
+	cacheTTL            time.Duration
+	reconnectionsNumber int
+	reconnectionsDelay  time.Duration
+	healthInterval      time.Duration
+	healthMaxLag        uint32
+	healthMaxErrorRate  float64
+	healthMaxLatency    time.Duration
+}
+
+func readReloadableConfig(c *config.Config) (reloadableConfig, error) {
+	var res reloadableConfig
+
+	// getters below panic on these, but the node must survive invalid
+	// configuration being reread
+	if config.StringSafe(c.Sub("grpc").Sub("0"), "endpoint") == "" {
+		return res, errors.New("no gRPC server configured")
+	}
+
+	if len(config.StringSliceSafe(c.Sub("morph"), "endpoints")) == 0 {
+		return res, errors.New("no morph chain RPC endpoints")
+	}
+
+	res.grpc = readGRPCEndpoints(c)
+
+	res.policerHeadTimeout = policerconfig.HeadTimeout(c)
+
+	res.putRemotePoolSize = objectconfig.Put(c).PoolSizeRemote()
+
+	res.replicatorPutTimeout = replicatorconfig.PutTimeout(c)
+	res.replicatorPoolSize = replicatorconfig.PoolSize(c)
+	if res.replicatorPoolSize <= 0 {
+		res.replicatorPoolSize = res.putRemotePoolSize
+	}
+
+	treeCfg := treeconfig.Tree(c)
+	res.treeSyncInterval = treeCfg.SyncInterval()
+	res.tree = treeServiceConfig{
+		enabled:                    treeCfg.Enabled(),
+		cacheSize:                  treeCfg.CacheSize(),
+		replicationTimeout:         treeCfg.ReplicationTimeout(),
+		replicationChannelCapacity: treeCfg.ReplicationChannelCapacity(),
+		replicationWorkerCount:     treeCfg.ReplicationWorkerCount(),
+	}
+
+	res.notificationDefaultTopic = nodeconfig.Notification(c).DefaultTopic()
+	res.notification = readNotificationConfig(c)
+
+	res.morphEndpoints = morphconfig.Endpoints(c)
+	res.morph = morphConfig{
+		dialTimeout:         morphconfig.DialTimeout(c),
+		cacheTTL:            morphconfig.CacheTTL(c),
+		reconnectionsNumber: morphconfig.ReconnectionRetriesNumber(c),
+		reconnectionsDelay:  morphconfig.ReconnectionRetriesDelay(c),
+		healthInterval:      morphconfig.HealthCheckInterval(c),
+		healthMaxLag:        morphconfig.MaxBlockLag(c),
+		healthMaxErrorRate:  morphconfig.MaxErrorRate(c),
+		healthMaxLatency:    morphconfig.MaxLatency(c),
+	}
+
+	return res, nil
+}
+
+// reloadParam is a configuration parameter (or a group of them) checked for
+// changes on SIGHUP.
+type reloadParam struct {
+	name    string
+	changed bool
+
+	// apply applies the new value at runtime. Nil apply means that the
+	// parameter requires node restart.
+	apply func() error
+
+	// commit saves the applied value as the current one, so that it is not
+	// processed again. Parameters failed to be applied or requiring restart
+	// are not committed and remain changed on the next reload.
+	commit func()
+
+	// reload is set instead of changed and apply for parameters stored
+	// outside the configuration (e.g. in files referenced by it). It applies
+	// the current value and reports whether it has changed.
+	reload func() (bool, error)
+}
+
+// reloadReport lists names of the changed parameters by the way they were
+// processed.
+type reloadReport struct {
+	applied []string
+	restart []string
+	failed  []string
+}
+
+func applyReloadParams(l *zap.Logger, params []reloadParam) reloadReport {
+	var res reloadReport
+
+	for _, p := range params {
+		if p.reload != nil {
+			changed, err := p.reload()
+			if err != nil {
+				l.Error("could not reload configuration parameter",
+					zap.String("parameter", p.name),
+					zap.Error(err),
+				)
+
+				res.failed = append(res.failed, p.name)
+			} else if changed {
+				res.applied = append(res.applied, p.name)
+			}
+
+			continue
+		}
+
+		switch {
+		case !p.changed:
+		case p.apply == nil:
+			res.restart = append(res.restart, p.name)
+		default:
+			err := p.apply()
+			if err != nil {
+				l.Error("could not apply configuration change",
+					zap.String("parameter", p.name),
+					zap.Error(err),
+				)
+
+				res.failed = append(res.failed, p.name)
+				continue
+			}
+
+			if p.commit != nil {
+				p.commit()
+			}
+
+			res.applied = append(res.applied, p.name)
+		}
+	}
+
+	return res
+}
+
+// reloadParams returns parameters to be processed when the configuration
+// changes from prev to next. Nothing is changed until the parameters are
+// applied, applied values are committed to prev.
+func (c *cfg) reloadParams(prev *reloadableConfig, next reloadableConfig) []reloadParam {
+	res := []reloadParam{
+		{
+			name:    "grpc",
+			changed: !equalGRPCEndpoints(prev.grpc, next.grpc),
+			apply: func() error {
+				return reloadGRPC(c, next.grpc)
+			},
+			commit: func() {
+				prev.grpc = next.grpc
+			},
+		},
+		{
+			name:    "policer.head_timeout",
+			changed: prev.policerHeadTimeout != next.policerHeadTimeout,
+			apply: func() error {
+				c.policer.SetHeadTimeout(next.policerHeadTimeout)
+				return nil
+			},
+			commit: func() {
+				prev.policerHeadTimeout = next.policerHeadTimeout
+			},
+		},
+		{
+			name:    "replicator.put_timeout",
+			changed: prev.replicatorPutTimeout != next.replicatorPutTimeout,
+			apply: func() error {
+				c.replicator.SetPutTimeout(next.replicatorPutTimeout)
+				return nil
+			},
+			commit: func() {
+				prev.replicatorPutTimeout = next.replicatorPutTimeout
+			},
+		},
+		{
+			name:    "replicator.pool_size",
+			changed: prev.replicatorPoolSize != next.replicatorPoolSize,
+			apply: func() error {
+				// replication pool itself is tuned by Policer
+				c.policer.SetMaxCapacity(next.replicatorPoolSize)
+				return nil
+			},
+			commit: func() {
+				prev.replicatorPoolSize = next.replicatorPoolSize
+			},
+		},
+		{
+			name:    "object.put.pool_size_remote",
+			changed: prev.putRemotePoolSize != next.putRemotePoolSize,
+			apply: func() error {
+				c.cfgObject.pool.putRemote.Tune(next.putRemotePoolSize)
+				return nil
+			},
+			commit: func() {
+				prev.putRemotePoolSize = next.putRemotePoolSize
+			},
+		},
+		{
+			name:    "tree",
+			changed: prev.tree != next.tree,
+		},
+		{
+			name:    "tree.sync_interval",
+			changed: prev.treeSyncInterval != next.treeSyncInterval,
+			apply: func() error {
+				if c.treeSync != nil {
+					c.treeSync.setInterval(next.treeSyncInterval)
+				}
+				return nil
+			},
+			commit: func() {
+				prev.treeSyncInterval = next.treeSyncInterval
+			},
+		},
+		{
+			name:    "node.notification",
+			changed: prev.notification != next.notification,
+			apply: func() error {
+				return c.cfgNotifications.nw.connect(c.ctx, next.notification)
+			},
+			commit: func() {
+				prev.notification = next.notification
+			},
+		},
+		{
+			name:    "node.notification.default_topic",
+			changed: prev.notificationDefaultTopic != next.notificationDefaultTopic,
+			apply: func() error {
+				c.cfgNotifications.defaultTopic.set(next.notificationDefaultTopic)
+				return nil
+			},
+			commit: func() {
+				prev.notificationDefaultTopic = next.notificationDefaultTopic
+			},
+		},
+		{
+			name:    "morph",
+			changed: prev.morph != next.morph,
+		},
+		{
+			name:    "morph.endpoints",
+			changed: !equalStrings(prev.morphEndpoints, next.morphEndpoints),
+			apply: func() error {
+				c.cfgMorph.client.SetEndpoints(next.morphEndpoints)
+				return nil
+			},
+			commit: func() {
+				prev.morphEndpoints = next.morphEndpoints
+			},
+		},
+	}
+
+	prevGRPC := make(map[string]grpcEndpointConfig, len(prev.grpc))
+	for _, e := range prev.grpc {
+		prevGRPC[e.endpoint] = e
+	}
+
+	// certificates are reread on each SIGHUP since they can be replaced
+	// without changing the paths, servers started anew load them anyway
+	for i, e := range next.grpc {
+		if p, ok := prevGRPC[e.endpoint]; !ok || !e.tls || !sameGRPCServer(p, e) {
+			continue
+		}
+
+		e := e
+
+		res = append(res, reloadParam{
+			name: "grpc." + strconv.Itoa(i) + ".tls",
+			reload: func() (bool, error) {
+				return c.reloadCertificate(e)
+			},
+		})
+	}
+
+	return res
+}
+
+// reloadCertificate rereads certificate files of the running gRPC server and
+// reports whether the certificate has changed.
+func (c *cfg) reloadCertificate(e grpcEndpointConfig) (bool, error) {
+	cert := c.grpcCertificate(e.endpoint)
+	if cert == nil {
+		// server has not been started or has been restarted
+		return false, nil
+	}
+
+	changed, err := cert.Load(e.certFile, e.keyFile)
+	if changed {
+		c.updateCertificateExpiry(e.endpoint, cert)
+	}
+
+	return changed, err
+}
+
+func equalGRPCEndpoints(a, b []grpcEndpointConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !sameGRPCServer(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reloadComponents applies configuration changes between the last applied
+// snapshot and the next one to the running components and logs the report.
+// Successfully applied values are saved to applied.
+func (c *cfg) reloadComponents(applied *reloadableConfig, next reloadableConfig) {
+	rep := applyReloadParams(c.log, c.reloadParams(applied, next))
+
+	if len(rep.applied) != 0 {
+		c.log.Info("configuration changes have been applied",
+			zap.Strings("parameters", rep.applied))
+	}
+
+	if len(rep.restart) != 0 {
+		c.log.Warn("configuration changes require node restart to take effect",
+			zap.Strings("parameters", rep.restart))
+	}
+
+	if len(rep.failed) != 0 {
+		c.log.Error("some configuration changes have not been applied",
+			zap.Strings("parameters", rep.failed))
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestApplyReloadParams(t *testing.T) {
+	var applied []string
+
+	apply := func(name string) func() error {
+		return func() error {
+			applied = append(applied, name)
+			return nil
+		}
+	}
+
+	var committed []string
+
+	commit := func(name string) func() {
+		return func() {
+			committed = append(committed, name)
+		}
+	}
+
+	reload := func(changed bool, err error) func() (bool, error) {
+		return func() (bool, error) {
+			return changed, err
+		}
+	}
+
+	rep := applyReloadParams(zap.NewNop(), []reloadParam{
+		{name: "unchanged", apply: apply("unchanged"), commit: commit("unchanged")},
+		{name: "unchanged restart"},
+		{name: "live", changed: true, apply: apply("live"), commit: commit("live")},
+		{name: "restart", changed: true},
+		{name: "failed", changed: true, apply: func() error { return errors.New("any error") }, commit: commit("failed")},
+		{name: "reloaded", reload: reload(true, nil)},
+		{name: "not reloaded", reload: reload(false, nil)},
+		{name: "failed reload", reload: reload(false, errors.New("any error"))},
+	})
+
+	require.Equal(t, []string{"live"}, applied)
+	require.Equal(t, []string{"live"}, committed)
+	require.Equal(t, []string{"live", "reloaded"}, rep.applied)
+	require.Equal(t, []string{"restart"}, rep.restart)
+	require.Equal(t, []string{"failed", "failed reload"}, rep.failed)
+}
+
+func TestReloadParams(t *testing.T) {
+	c := &cfg{
+		internals: internals{
+			ctx: context.Background(),
+			log: zap.NewNop(),
+			wg:  new(sync.WaitGroup),
+		},
+	}
+
+	c.cfgGRPC.servers = make(map[string]*grpcServer)
+
+	var applied reloadableConfig
+	next := reloadableConfig{
+		// missing certificate files
+		grpc: []grpcEndpointConfig{{endpoint: "127.0.0.1:0", tls: true}},
+		tree: treeServiceConfig{enabled: true},
+	}
+
+	for i := 0; i < 2; i++ {
+		rep := applyReloadParams(zap.NewNop(), c.reloadParams(&applied, next))
+		require.Empty(t, rep.applied)
+		require.Equal(t, []string{"tree"}, rep.restart, "restart must be reported until it happens")
+		require.Equal(t, []string{"grpc"}, rep.failed, "failed changes must be retried")
+		require.Empty(t, applied.grpc)
+	}
+
+	next.grpc = []grpcEndpointConfig{{endpoint: "127.0.0.1:0"}}
+
+	rep := applyReloadParams(zap.NewNop(), c.reloadParams(&applied, next))
+	require.Equal(t, []string{"grpc"}, rep.applied)
+	require.Equal(t, next.grpc, applied.grpc)
+
+	rep = applyReloadParams(zap.NewNop(), c.reloadParams(&applied, next))
+	require.Empty(t, rep.applied)
+	require.Empty(t, rep.failed)
+
+	require.NoError(t, reloadGRPC(c, nil))
+	c.wg.Wait()
+}
+
+func TestReadReloadableConfig(t *testing.T) {
+	t.Run("example", func(t *testing.T) {
+		configtest.ForEachFileType("../../config/example/node", func(c *config.Config) {
+			rc, err := readReloadableConfig(c)
+			require.NoError(t, err)
+
+			require.Len(t, rc.grpc, 3)
+			require.Equal(t, grpcEndpointConfig{
+				endpoint:       "s01.neofs.devenv:8080",
+				tls:            true,
+				certFile:       "/path/to/cert",
+				keyFile:        "/path/to/key",
+				insecureCrypto: false,
+			}, rc.grpc[0])
+			require.Equal(t, grpcEndpointConfig{
+				endpoint:       "s03.neofs.devenv:8080",
+				tls:            true,
+				insecureCrypto: true,
+			}, rc.grpc[2])
+
+			require.Equal(t, 15*time.Second, rc.policerHeadTimeout)
+			require.Equal(t, 15*time.Second, rc.replicatorPutTimeout)
+			require.Equal(t, 10, rc.replicatorPoolSize)
+			require.Equal(t, 100, rc.putRemotePoolSize)
+			require.Equal(t, time.Hour, rc.treeSyncInterval)
+			require.Equal(t, "topic", rc.notificationDefaultTopic)
+			require.Equal(t, []string{
+				"wss://rpc1.morph.fs.neo.org:40341/ws",
+				"wss://rpc2.morph.fs.neo.org:40341/ws",
+			}, rc.morphEndpoints)
+		})
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := readReloadableConfig(configtest.EmptyConfig())
+		require.Error(t, err)
+	})
+}
+
+func TestReloadGRPC(t *testing.T) {
+	c := &cfg{
+		internals: internals{
+			ctx: context.Background(),
+			log: zap.NewNop(),
+			wg:  new(sync.WaitGroup),
+		},
+	}
+
+	var registered int
+	registerGRPCService(c, func(*grpc.Server) {
+		registered++
+	})
+
+	endpoints := func() []string {
+		var res []string
+		for e := range c.cfgGRPC.servers {
+			res = append(res, e)
+		}
+		return res
+	}
+
+	c.cfgGRPC.servers = make(map[string]*grpcServer)
+	c.cfgGRPC.serving = true
+
+	first := grpcEndpointConfig{endpoint: "127.0.0.1:0"}
+	second := grpcEndpointConfig{endpoint: "localhost:0"}
+
+	require.NoError(t, reloadGRPC(c, []grpcEndpointConfig{first}))
+	require.ElementsMatch(t, []string{first.endpoint}, endpoints())
+	require.Equal(t, 1, registered)
+
+	s := c.cfgGRPC.servers[first.endpoint]
+
+	require.NoError(t, reloadGRPC(c, []grpcEndpointConfig{first, second}))
+	require.ElementsMatch(t, []string{first.endpoint, second.endpoint}, endpoints())
+	require.Equal(t, 2, registered)
+	require.Same(t, s, c.cfgGRPC.servers[first.endpoint], "unchanged endpoint must not be restarted")
+
+	// missing certificate files
+	err := reloadGRPC(c, []grpcEndpointConfig{{endpoint: first.endpoint, tls: true}, second})
+	require.Error(t, err)
+	require.ElementsMatch(t, []string{second.endpoint}, endpoints())
+
+	require.NoError(t, reloadGRPC(c, nil))
+	require.Empty(t, endpoints())
+
+	c.wg.Wait()
+}
+
+func TestNotificationWriter(t *testing.T) {
+	w := &notificationWriter{l: zap.NewNop()}
+	require.False(t, w.enabled())
+
+	// unreachable server, writer stays disabled
+	err := w.connect(context.Background(), notificationConfig{
+		enabled:  true,
+		endpoint: "nats://127.0.0.1:1",
+		timeout:  time.Second,
+	})
+	require.Error(t, err)
+	require.False(t, w.enabled())
+
+	require.NoError(t, w.connect(context.Background(), notificationConfig{}))
+	require.False(t, w.enabled())
+
+	// does nothing while disabled
+	w.Notify("topic", oidtest.Address())
+}
@@ -2,15 +2,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	v2reputation "github.com/nspcc-dev/neofs-api-go/v2/reputation"
 	v2reputationgrpc "github.com/nspcc-dev/neofs-api-go/v2/reputation/grpc"
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	reputationconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/reputation"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/common"
 	intermediatereputation "github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/intermediate"
 	localreputation "github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/local"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/reputation/ticker"
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	repClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/reputation"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
@@ -30,6 +33,7 @@ import (
 	reputationrpc "github.com/nspcc-dev/neofs-node/pkg/services/reputation/rpc"
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func initReputationService(c *cfg) {
@@ -45,6 +49,48 @@ func initReputationService(c *cfg) {
 		truststorage.Prm{},
 	)
 
+	// all sources are collected since their weights may be changed
+	// in the network configuration at any time
+	c.cfgReputation.latencyTrustStorage = truststorage.New(
+		truststorage.Prm{},
+	)
+	c.cfgReputation.latencyThreshold = reputationconfig.LatencyThreshold(c.appCfg)
+
+	c.cfgReputation.availabilityTrustStorage = truststorage.New(
+		truststorage.Prm{},
+	)
+
+	localTrustSources := &networkTrustSources{
+		log: c.log,
+		sources: truststorage.Sources{
+			{
+				Name:   reputationconfig.RequestsSourceName,
+				Weight: reputationconfig.RequestsWeight(c.appCfg),
+				Source: c.cfgReputation.localTrustStorage,
+			},
+			{
+				Name:   reputationconfig.LatencySourceName,
+				Weight: reputationconfig.LatencyWeight(c.appCfg),
+				Source: c.cfgReputation.latencyTrustStorage,
+			},
+			{
+				Name:   reputationconfig.AvailabilitySourceName,
+				Weight: reputationconfig.AvailabilityWeight(c.appCfg),
+				Source: c.cfgReputation.availabilityTrustStorage,
+			},
+		},
+		weights: map[string]func() (uint64, error){
+			reputationconfig.RequestsSourceName:     c.cfgNetmap.wrapper.ReputationRequestsWeight,
+			reputationconfig.LatencySourceName:      c.cfgNetmap.wrapper.ReputationLatencyWeight,
+			reputationconfig.AvailabilitySourceName: c.cfgNetmap.wrapper.ReputationAvailabilityWeight,
+		},
+	}
+
+	c.cfgReputation.trustSource = &trustSource{
+		sources: localTrustSources,
+		client:  wrap,
+	}
+
 	daughterStorage := daughters.New(daughters.Prm{})
 	consumerStorage := consumerstorage.New(consumerstorage.Prm{})
 
@@ -64,7 +110,7 @@ func initReputationService(c *cfg) {
 
 	localTrustStorage := &localreputation.TrustStorage{
 		Log:      localTrustLogger,
-		Storage:  c.cfgReputation.localTrustStorage,
+		Sources:  localTrustSources.Sources,
 		NmSrc:    nmSrc,
 		LocalKey: localKey,
 	}
@@ -210,9 +256,9 @@ func initReputationService(c *cfg) {
 		),
 	)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		v2reputationgrpc.RegisterReputationServiceServer(srv, server)
-	}
+	})
 
 	// initialize eigen trust block timer
 	newEigenTrustIterTimer(c)
@@ -354,3 +400,77 @@ func reverseRoute(hdr *session.RequestVerificationHeader) (passedRoute []reputat
 
 	return
 }
+
+// networkTrustSources weights local trust sources according to the rules
+// set in the network configuration. Sources keep locally configured weights
+// if the network sets none.
+type networkTrustSources struct {
+	log *zap.Logger
+
+	sources truststorage.Sources
+
+	// network weight readers by the source names
+	weights map[string]func() (uint64, error)
+}
+
+// Sources returns local trust sources with the current network weights.
+func (x *networkTrustSources) Sources() truststorage.Sources {
+	weights := make(map[string]uint64, len(x.weights))
+
+	for name, read := range x.weights {
+		w, err := read()
+		if err != nil {
+			if !errors.Is(err, nmClient.ErrConfigNotFound) {
+				x.log.Warn("could not read network weight of the local trust source, using the local one",
+					zap.String("source", name),
+					zap.Error(err),
+				)
+			}
+
+			continue
+		}
+
+		weights[name] = w
+	}
+
+	return x.sources.WithWeights(weights)
+}
+
+// trustSource provides local and global trusts of
+// the node to the control service.
+type trustSource struct {
+	sources *networkTrustSources
+
+	client *repClient.Client
+}
+
+func (s *trustSource) LocalTrusts(epoch uint64) ([]truststorage.PeerTrust, error) {
+	return s.sources.Sources().PeerTrusts(epoch)
+}
+
+// GlobalTrusts returns global trusts of the peers averaged over the values
+// published by the reputation managers. All values are read at once.
+func (s *trustSource) GlobalTrusts(epoch uint64, peers []apireputation.PeerID) ([]float64, error) {
+	trusts, err := s.client.GetBatch(epoch, peers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get global trusts: %w", err)
+	}
+
+	res := make([]float64, len(trusts))
+
+	for i := range trusts {
+		if len(trusts[i]) == 0 {
+			continue
+		}
+
+		var sum float64
+
+		for j := range trusts[i] {
+			sum += trusts[i][j].Trust().Value()
+		}
+
+		res[i] = sum / float64(len(trusts[i]))
+	}
+
+	return res, nil
+}
@@ -16,7 +16,8 @@ import (
 type TrustStorage struct {
 	Log *zap.Logger
 
-	Storage *truststorage.Storage
+	// Sources returns local trust sources for the next iteration.
+	Sources func() truststorage.Sources
 
 	NmSrc netmapcore.Source
 
@@ -30,15 +31,9 @@ func (s *TrustStorage) InitIterator(ctx reputationcommon.Context) (trustcontroll
 		zap.Uint64("epoch", epoch),
 	)
 
-	epochStorage, err := s.Storage.DataForEpoch(epoch)
-	if err != nil && !errors.Is(err, truststorage.ErrNoPositiveTrust) {
-		return nil, err
-	}
-
 	return &TrustIterator{
-		ctx:          ctx,
-		storage:      s,
-		epochStorage: epochStorage,
+		ctx:     ctx,
+		storage: s,
 	}, nil
 }
 
@@ -46,16 +41,12 @@ type TrustIterator struct {
 	ctx reputationcommon.Context
 
 	storage *TrustStorage
-
-	epochStorage *truststorage.EpochTrustValueStorage
 }
 
 func (it *TrustIterator) Iterate(h reputation.TrustHandler) error {
-	if it.epochStorage != nil {
-		err := it.epochStorage.Iterate(h)
-		if !errors.Is(err, truststorage.ErrNoPositiveTrust) {
-			return err
-		}
+	err := it.storage.Sources().Iterate(it.ctx.Epoch(), h)
+	if !errors.Is(err, truststorage.ErrNoPositiveTrust) {
+		return err
 	}
 
 	nm, err := it.storage.NmSrc.GetNetMapByEpoch(it.ctx.Epoch())
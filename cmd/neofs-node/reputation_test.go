@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNetworkTrustSources(t *testing.T) {
+	weight := func(w uint64, err error) func() (uint64, error) {
+		return func() (uint64, error) { return w, err }
+	}
+
+	srcs := &networkTrustSources{
+		log: zap.NewNop(),
+		sources: truststorage.Sources{
+			{Name: "set", Weight: 1},
+			{Name: "unset", Weight: 2},
+			{Name: "failed", Weight: 3},
+			{Name: "disabled", Weight: 4},
+		},
+		weights: map[string]func() (uint64, error){
+			"set":      weight(5, nil),
+			"unset":    weight(0, fmt.Errorf("wrapped: %w", nmClient.ErrConfigNotFound)),
+			"failed":   weight(0, errors.New("any error")),
+			"disabled": weight(0, nil),
+		},
+	}
+
+	require.Equal(t, truststorage.Sources{
+		{Name: "set", Weight: 5},
+		{Name: "unset", Weight: 2},
+		{Name: "failed", Weight: 3},
+		{Name: "disabled", Weight: 0},
+	}, srcs.Sources())
+}
+
+func TestResponded(t *testing.T) {
+	require.True(t, responded(nil))
+	require.True(t, responded(apistatus.ErrObjectNotFound))
+	require.True(t, responded(fmt.Errorf("wrapped: %w", apistatus.ErrObjectAccessDenied)))
+	require.False(t, responded(apistatus.ErrNodeUnderMaintenance))
+	require.False(t, responded(errors.New("connection refused")))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/session/storage/persistent"
 	"github.com/nspcc-dev/neofs-node/pkg/services/session/storage/temporary"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"google.golang.org/grpc"
 )
 
 type sessionStorage interface {
@@ -60,7 +61,7 @@ func initSessionService(c *cfg) {
 		),
 	)
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		sessionGRPC.RegisterSessionServiceServer(srv, server)
-	}
+	})
 }
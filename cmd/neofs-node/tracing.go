@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/nspcc-dev/neofs-node/misc"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.uber.org/zap"
+)
+
+// tracingShutdownTimeout limits the time to flush remaining spans on shutdown.
+const tracingShutdownTimeout = 5 * time.Second
+
+func initTracing(c *cfg) {
+	if !tracingconfig.Enabled(c.appCfg) {
+		c.log.Info("tracing is disabled")
+		return
+	}
+
+	shutdown, err := tracing.Setup(c.ctx, tracing.Config{
+		Endpoint:      tracingconfig.Endpoint(c.appCfg),
+		Insecure:      tracingconfig.Insecure(c.appCfg),
+		SamplingRatio: tracingconfig.SamplingRatio(c.appCfg),
+		Service:       "neofs-node",
+		Version:       misc.Version,
+	})
+	fatalOnErr(err)
+
+	c.closers = append(c.closers, func() {
+		c.log.Debug("shutting down tracing exporter")
+
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		err := shutdown(ctx)
+		if err != nil {
+			c.log.Debug("could not shutdown tracing exporter",
+				zap.String("error", err.Error()),
+			)
+		}
+
+		c.log.Debug("tracing exporter has been stopped")
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
@@ -14,6 +15,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 type cnrSource struct {
@@ -34,6 +36,85 @@ func (c cnrSource) List() ([]cid.ID, error) {
 	return c.cli.List(nil)
 }
 
+// treeSyncTrigger triggers Tree Service synchronization either on every new
+// epoch or periodically if the sync interval is set. The interval can be
+// changed at runtime.
+type treeSyncTrigger struct {
+	c *cfg
+
+	onEpoch  atomic.Bool
+	interval chan time.Duration
+}
+
+func newTreeSyncTrigger(c *cfg, interval time.Duration) *treeSyncTrigger {
+	s := &treeSyncTrigger{
+		c:        c,
+		interval: make(chan time.Duration, 1),
+	}
+
+	s.setInterval(interval)
+
+	return s
+}
+
+// setInterval changes synchronization interval. Zero interval means
+// synchronization on every new epoch.
+func (s *treeSyncTrigger) setInterval(d time.Duration) {
+	s.onEpoch.Store(d == 0)
+
+	// drop not yet processed value, if any
+	select {
+	case <-s.interval:
+	default:
+	}
+
+	s.interval <- d
+}
+
+func (s *treeSyncTrigger) synchronize() bool {
+	err := s.c.treeService.SynchronizeAll()
+	if err != nil {
+		s.c.log.Error("could not synchronize Tree Service", zap.Error(err))
+		return !errors.Is(err, tree.ErrShuttingDown)
+	}
+
+	return true
+}
+
+func (s *treeSyncTrigger) run(ctx context.Context) {
+	var (
+		ticker *time.Ticker
+		tick   <-chan time.Time
+	)
+
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-s.interval:
+			if ticker != nil {
+				ticker.Stop()
+				ticker, tick = nil, nil
+			}
+
+			if d > 0 {
+				ticker = time.NewTicker(d)
+				tick = ticker.C
+			}
+		case <-tick:
+			if !s.synchronize() {
+				return
+			}
+		}
+	}
+}
+
 func initTreeService(c *cfg) {
 	treeConfig := treeconfig.Tree(c.appCfg)
 	if !treeConfig.Enabled() {
@@ -54,39 +135,26 @@ func initTreeService(c *cfg) {
 		tree.WithContainerCacheSize(treeConfig.CacheSize()),
 		tree.WithReplicationTimeout(treeConfig.ReplicationTimeout()),
 		tree.WithReplicationChannelCapacity(treeConfig.ReplicationChannelCapacity()),
-		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()))
+		tree.WithReplicationWorkerCount(treeConfig.ReplicationWorkerCount()),
+		tree.WithRateLimiter(c.limiter))
 
-	for _, srv := range c.cfgGRPC.servers {
+	registerGRPCService(c, func(srv *grpc.Server) {
 		tree.RegisterTreeServiceServer(srv, c.treeService)
-	}
+	})
 
 	c.workers = append(c.workers, newWorkerFromFunc(func(ctx context.Context) {
 		c.treeService.Start(ctx)
 	}))
 
-	if d := treeConfig.SyncInterval(); d == 0 {
-		addNewEpochNotificationHandler(c, func(_ event.Event) {
-			err := c.treeService.SynchronizeAll()
-			if err != nil {
-				c.log.Error("could not synchronize Tree Service", zap.Error(err))
-			}
-		})
-	} else {
-		go func() {
-			tick := time.NewTicker(d)
-			defer tick.Stop()
-
-			for range tick.C {
-				err := c.treeService.SynchronizeAll()
-				if err != nil {
-					c.log.Error("could not synchronize Tree Service", zap.Error(err))
-					if errors.Is(err, tree.ErrShuttingDown) {
-						return
-					}
-				}
-			}
-		}()
-	}
+	c.treeSync = newTreeSyncTrigger(c, treeConfig.SyncInterval())
+
+	addNewEpochNotificationHandler(c, func(_ event.Event) {
+		if c.treeSync.onEpoch.Load() {
+			c.treeSync.synchronize()
+		}
+	})
+
+	c.workers = append(c.workers, newWorkerFromFunc(c.treeSync.run))
 
 	subscribeToContainerRemoval(c, func(e event.Event) {
 		ev := e.(containerEvent.DeleteSuccess)
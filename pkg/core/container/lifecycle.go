@@ -0,0 +1,184 @@
+package container
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// AttributeLifecycle is a container attribute carrying JSON-encoded
+// LifecycleRules applied to all objects of the container.
+const AttributeLifecycle = "__NEOFS__LIFECYCLE"
+
+// LifecycleRule describes the lifecycle of the container objects selected
+// by the attribute: objects are expired after ExpireAfter epochs since their
+// creation, and objects having the same attribute value are purged when
+// there are Versions newer objects with this value in the container.
+type LifecycleRule struct {
+	// Attribute key the objects are selected by. Empty key selects all objects.
+	Attribute string `json:"attribute,omitempty"`
+
+	// Prefix of the Attribute value the objects are selected by. Empty
+	// prefix selects objects with any value of the Attribute.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Number of epochs after the object creation epoch the
+	// object is available within. Zero disables expiration.
+	ExpireAfter uint64 `json:"expire_after,omitempty"`
+
+	// Number of the newest versions of the objects having the same value
+	// of the Attribute kept in the container, older ones are purged.
+	// Versions are ordered by the creation epoch, so objects created at
+	// the same epoch are the same version. Zero keeps all versions.
+	Versions uint64 `json:"versions,omitempty"`
+}
+
+// LifecycleRules is a set of the container lifecycle rules. Object
+// is expired if at least one of the rules treats it so.
+type LifecycleRules []LifecycleRule
+
+var (
+	errEmptyRule             = errors.New("neither expiration nor versions limit is set")
+	errPrefixWithoutFilter   = errors.New("value prefix is set without attribute")
+	errVersionsWithoutFilter = errors.New("versions limit is set without attribute")
+)
+
+// Validate checks whether the rule is correctly filled.
+func (r LifecycleRule) Validate() error {
+	if r.ExpireAfter == 0 && r.Versions == 0 {
+		return errEmptyRule
+	}
+
+	if r.Attribute == "" && r.Prefix != "" {
+		return errPrefixWithoutFilter
+	}
+
+	if r.Attribute == "" && r.Versions != 0 {
+		return errVersionsWithoutFilter
+	}
+
+	return nil
+}
+
+// DecodeLifecycleRules decodes LifecycleRules from the JSON and checks them.
+func DecodeLifecycleRules(data []byte) (LifecycleRules, error) {
+	var rules LifecycleRules
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&rules)
+	if err != nil {
+		return nil, fmt.Errorf("decode JSON: %w", err)
+	}
+
+	for i := range rules {
+		if err = rules[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rule #%d: %w", i, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// ReadLifecycleRules reads LifecycleRules from the AttributeLifecycle
+// attribute of the container. Returns nil if the attribute is not set.
+func ReadLifecycleRules(cnr container.Container) (LifecycleRules, error) {
+	v := cnr.Attribute(AttributeLifecycle)
+	if v == "" {
+		return nil, nil
+	}
+
+	return DecodeLifecycleRules([]byte(v))
+}
+
+// Matches checks whether the rule is applied to the object.
+func (r LifecycleRule) Matches(hdr object.Object) bool {
+	if r.Attribute == "" {
+		return true
+	}
+
+	v, ok := attributeValue(hdr, r.Attribute)
+
+	return ok && strings.HasPrefix(v, r.Prefix)
+}
+
+// Version returns the value of the Attribute the object versions are
+// counted by. Returns false if the rule does not limit versions or is not
+// applied to the object.
+func (r LifecycleRule) Version(hdr object.Object) (string, bool) {
+	if r.Versions == 0 {
+		return "", false
+	}
+
+	v, ok := attributeValue(hdr, r.Attribute)
+
+	return v, ok && strings.HasPrefix(v, r.Prefix)
+}
+
+// LimitVersions checks whether any of the rules limits object versions.
+func (x LifecycleRules) LimitVersions() bool {
+	for i := range x {
+		if x[i].Versions != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExpirationEpoch returns the first epoch the object is expired at according
+// to the rules. Returns false if none of the rules is applied to the object.
+// Expiration epochs exceeding the epoch range are limited to its maximum.
+func (x LifecycleRules) ExpirationEpoch(hdr object.Object) (uint64, bool) {
+	var (
+		res uint64
+		ok  bool
+	)
+
+	for i := range x {
+		if x[i].ExpireAfter == 0 || !x[i].Matches(hdr) {
+			continue
+		}
+
+		if e := addEpochs(hdr.CreationEpoch(), x[i].ExpireAfter); !ok || e < res {
+			res, ok = e, true
+		}
+	}
+
+	return res, ok
+}
+
+// Expired checks whether the object is expired at the given epoch according
+// to the rules.
+func (x LifecycleRules) Expired(epoch uint64, hdr object.Object) bool {
+	e, ok := x.ExpirationEpoch(hdr)
+	return ok && e <= epoch
+}
+
+// addEpochs returns the first epoch after n epochs since the given one
+// saturated at math.MaxUint64.
+func addEpochs(epoch, n uint64) uint64 {
+	if n >= math.MaxUint64-epoch {
+		return math.MaxUint64
+	}
+
+	return epoch + n + 1
+}
+
+func attributeValue(hdr object.Object, key string) (string, bool) {
+	attrs := hdr.Attributes()
+	for i := range attrs {
+		if attrs[i].Key() == key {
+			return attrs[i].Value(), true
+		}
+	}
+
+	return "", false
+}
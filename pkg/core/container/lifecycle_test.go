@@ -0,0 +1,151 @@
+package container_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func lifecycleObject(creationEpoch uint64, attrs ...string) object.Object {
+	var obj object.Object
+	obj.SetID(oidtest.ID())
+	obj.SetCreationEpoch(creationEpoch)
+
+	as := make([]object.Attribute, 0, len(attrs)/2)
+	for i := 0; i < len(attrs); i += 2 {
+		var a object.Attribute
+		a.SetKey(attrs[i])
+		a.SetValue(attrs[i+1])
+
+		as = append(as, a)
+	}
+
+	obj.SetAttributes(as...)
+
+	return obj
+}
+
+func TestDecodeLifecycleRules(t *testing.T) {
+	rules, err := container.DecodeLifecycleRules([]byte(`[
+		{"attribute": "Type", "prefix": "backup", "expire_after": 10},
+		{"expire_after": 20},
+		{"attribute": "FilePath", "versions": 3}
+	]`))
+	require.NoError(t, err)
+	require.Equal(t, container.LifecycleRules{
+		{Attribute: "Type", Prefix: "backup", ExpireAfter: 10},
+		{ExpireAfter: 20},
+		{Attribute: "FilePath", Versions: 3},
+	}, rules)
+
+	for _, data := range []string{
+		`[{}]`,
+		`[{"prefix": "a", "expire_after": 1}]`,
+		`[{"unknown": 1, "expire_after": 1}]`,
+		`[{"keep_versions": 1, "expire_after": 1}]`,
+		`[{"versions": 1}]`,
+		`[{"prefix": "a", "versions": 1}]`,
+		`{"expire_after": 1}`,
+	} {
+		_, err = container.DecodeLifecycleRules([]byte(data))
+		require.Error(t, err, data)
+	}
+
+	var cnr containerSDK.Container
+
+	rules, err = container.ReadLifecycleRules(cnr)
+	require.NoError(t, err)
+	require.Nil(t, rules)
+
+	cnr.SetAttribute(container.AttributeLifecycle, `[{"expire_after": 5}]`)
+
+	rules, err = container.ReadLifecycleRules(cnr)
+	require.NoError(t, err)
+	require.Equal(t, container.LifecycleRules{{ExpireAfter: 5}}, rules)
+}
+
+func TestLifecycleRules_Expired(t *testing.T) {
+	rules := container.LifecycleRules{
+		{Attribute: "Type", Prefix: "backup/", ExpireAfter: 5},
+		{Attribute: "Type", Prefix: "backup/hourly", ExpireAfter: 1},
+	}
+
+	var (
+		daily  = lifecycleObject(10, "Type", "backup/daily")
+		hourly = lifecycleObject(10, "Type", "backup/hourly")
+		other  = lifecycleObject(1, "Type", "image")
+	)
+
+	e, ok := rules.ExpirationEpoch(daily)
+	require.True(t, ok)
+	require.EqualValues(t, 16, e)
+
+	e, ok = rules.ExpirationEpoch(hourly)
+	require.True(t, ok)
+	require.EqualValues(t, 12, e)
+
+	_, ok = rules.ExpirationEpoch(other)
+	require.False(t, ok)
+
+	require.False(t, rules.Expired(15, daily))
+	require.True(t, rules.Expired(16, daily))
+	require.True(t, rules.Expired(12, hourly))
+	require.False(t, rules.Expired(100, other))
+}
+
+func TestLifecycleRules_ExpirationEpochOverflow(t *testing.T) {
+	for _, tc := range []struct {
+		creation, expireAfter, expected uint64
+	}{
+		{creation: 10, expireAfter: math.MaxUint64, expected: math.MaxUint64},
+		{creation: 10, expireAfter: math.MaxUint64 - 10, expected: math.MaxUint64},
+		{creation: 10, expireAfter: math.MaxUint64 - 11, expected: math.MaxUint64},
+		{creation: 10, expireAfter: math.MaxUint64 - 12, expected: math.MaxUint64 - 1},
+		{creation: math.MaxUint64, expireAfter: 1, expected: math.MaxUint64},
+	} {
+		rules := container.LifecycleRules{{ExpireAfter: tc.expireAfter}}
+		obj := lifecycleObject(tc.creation)
+
+		e, ok := rules.ExpirationEpoch(obj)
+		require.True(t, ok)
+		require.EqualValues(t, tc.expected, e)
+
+		require.False(t, rules.Expired(tc.expected-1, obj))
+	}
+}
+
+func TestLifecycleRule_Version(t *testing.T) {
+	rules := container.LifecycleRules{
+		{Attribute: "FilePath", Prefix: "backup/", Versions: 2},
+		{Attribute: "FilePath", ExpireAfter: 5},
+	}
+
+	require.True(t, rules.LimitVersions())
+	require.False(t, rules[1:].LimitVersions())
+
+	v, ok := rules[0].Version(lifecycleObject(1, "FilePath", "backup/db"))
+	require.True(t, ok)
+	require.Equal(t, "backup/db", v)
+
+	_, ok = rules[0].Version(lifecycleObject(1, "FilePath", "image"))
+	require.False(t, ok)
+
+	_, ok = rules[0].Version(lifecycleObject(1, "Type", "backup/db"))
+	require.False(t, ok)
+
+	_, ok = rules[1].Version(lifecycleObject(1, "FilePath", "backup/db"))
+	require.False(t, ok)
+
+	// versions limit does not expire objects
+	e, ok := rules.ExpirationEpoch(lifecycleObject(1, "FilePath", "backup/db"))
+	require.True(t, ok)
+	require.EqualValues(t, 7, e)
+
+	_, ok = rules[:1].ExpirationEpoch(lifecycleObject(1, "FilePath", "backup/db"))
+	require.False(t, ok)
+}
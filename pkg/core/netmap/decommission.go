@@ -0,0 +1,25 @@
+package netmap
+
+import "github.com/nspcc-dev/neofs-sdk-go/netmap"
+
+// AttrDecommission is a storage node attribute announced as "true" while the
+// node is being decommissioned. Such node hands its objects over to other
+// container nodes before the permanent shutdown, so its replicas must not be
+// counted when checking the container storage policy.
+const AttrDecommission = "Decommission"
+
+// SetDecommission sets AttrDecommission attribute of the storage node.
+func SetDecommission(ni *netmap.NodeInfo, v bool) {
+	if v {
+		ni.SetAttribute(AttrDecommission, "true")
+	} else if ni.Attribute(AttrDecommission) != "" {
+		// attributes can not be removed
+		ni.SetAttribute(AttrDecommission, "false")
+	}
+}
+
+// IsDecommissioning checks if the storage node announces that it is being
+// decommissioned.
+func IsDecommissioning(ni netmap.NodeInfo) bool {
+	return ni.Attribute(AttrDecommission) == "true"
+}
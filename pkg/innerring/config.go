@@ -13,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/core/native/nativenames"
 	"github.com/nspcc-dev/neo-go/pkg/core/storage/dbconfig"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/internal/blockchain"
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
@@ -262,6 +263,87 @@ func parseBlockchainConfig(v *viper.Viper, _logger *zap.Logger) (c blockchain.Co
 	return c, nil
 }
 
+// default values of the automatic deployment settings.
+const (
+	defaultDeploySystemEmail   = "ops@nspcc.ru"
+	defaultDeployGASAmount     = 1000
+	defaultDeployEpochDuration = 240
+	defaultDeployMaxObjectSize = 64 << 20
+)
+
+// parseDeployConfig parses settings of the automatic NeoFS Sidechain deployment
+// performed in local consensus mode with the given committee.
+func parseDeployConfig(v *viper.Viper, committee keys.PublicKeys) (c deployConfig, err error) {
+	const rootSection = "morph.consensus.deploy"
+
+	if !v.GetBool(rootSection + ".enabled") {
+		return c, nil
+	}
+
+	c.enabled = true
+
+	// GAS for the deployment and the network operation is taken from the committee,
+	// so the local node must be able to sign on its behalf
+	if len(committee) != 1 {
+		return c, fmt.Errorf("automatic deployment requires single committee member, configured %d", len(committee))
+	}
+
+	const contractsKey = rootSection + ".contracts"
+	c.contractsDir = v.GetString(contractsKey)
+	if strings.TrimSpace(c.contractsDir) == "" {
+		return c, fmt.Errorf("missing path to the directory with NeoFS contracts '%s'", contractsKey)
+	}
+
+	c.systemEmail = defaultDeploySystemEmail
+	if v.IsSet(rootSection + ".system_email") {
+		c.systemEmail = v.GetString(rootSection + ".system_email")
+	}
+
+	c.gasAmount, err = parseConfigUint64Range(v, rootSection+".gas.amount", "amount of GAS to transfer", 1, math.MaxInt64)
+	if err != nil {
+		if !errors.Is(err, errMissingConfig) {
+			return c, err
+		}
+		c.gasAmount = defaultDeployGASAmount
+	}
+
+	const gasAccountsKey = rootSection + ".gas.accounts"
+	accounts, err := parseConfigStrings(v, gasAccountsKey, "accounts to transfer GAS to")
+	if err != nil && !errors.Is(err, errMissingConfig) {
+		return c, err
+	}
+	for i := range accounts {
+		acc, err := address.StringToUint160(accounts[i])
+		if err != nil {
+			return c, fmt.Errorf("invalid accounts to transfer GAS to '%s' (Neo addresses): %w", gasAccountsKey, err)
+		}
+		c.gasAccounts = append(c.gasAccounts, acc)
+	}
+
+	const networkSection = rootSection + ".network"
+	c.network.EpochDuration, err = parseConfigUint64Range(v, networkSection+".epoch_duration", "NeoFS epoch duration", 1, math.MaxUint32)
+	if err != nil {
+		if !errors.Is(err, errMissingConfig) {
+			return c, err
+		}
+		c.network.EpochDuration = defaultDeployEpochDuration
+	}
+	c.network.MaxObjectSize, err = parseConfigUint64Range(v, networkSection+".max_object_size", "maximum object size", 1, math.MaxInt64)
+	if err != nil {
+		if !errors.Is(err, errMissingConfig) {
+			return c, err
+		}
+		c.network.MaxObjectSize = defaultDeployMaxObjectSize
+	}
+	c.network.HomomorphicHashingDisabled = v.GetBool(networkSection + ".homomorphic_hashing_disabled")
+	c.network.MaintenanceModeAllowed = v.GetBool(networkSection + ".maintenance_mode_allowed")
+	// same values as in neofs-adm
+	c.network.EigenTrustIterations = 4
+	c.network.EigenTrustAlpha = 0.1
+
+	return c, nil
+}
+
 var errMissingConfig = errors.New("config value is missing")
 
 func parseConfigUint64Condition(v *viper.Viper, key, desc string, cond func(uint64) error) (uint64, error) {
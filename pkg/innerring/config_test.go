@@ -10,6 +10,7 @@ import (
 
 	"github.com/nspcc-dev/neo-go/pkg/core/native/nativenames"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/internal/blockchain"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -430,3 +431,87 @@ morph:
 		require.True(t, isLocalConsensusMode(v))
 	})
 }
+
+func TestDeployConfigParser(t *testing.T) {
+	committee, err := keys.NewPublicKeysFromStrings([]string{
+		"02cddc58c3f7d27b5c9967dd90fbd4269798cbbb9cd7b137d886aca209cb734fb6",
+	})
+	require.NoError(t, err)
+
+	const acc = "NfgHwwTi3wHAS8aFAN243C5vGbkYDpqLHP"
+
+	newConfig := func() *viper.Viper {
+		v := viper.New()
+		v.Set("morph.consensus.deploy.enabled", true)
+		v.Set("morph.consensus.deploy.contracts", "/path/to/contracts")
+		return v
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		c, err := parseDeployConfig(viper.New(), nil)
+		require.NoError(t, err)
+		require.False(t, c.enabled)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		c, err := parseDeployConfig(newConfig(), committee)
+		require.NoError(t, err)
+		require.True(t, c.enabled)
+		require.Equal(t, "/path/to/contracts", c.contractsDir)
+		require.Equal(t, defaultDeploySystemEmail, c.systemEmail)
+		require.EqualValues(t, defaultDeployGASAmount, c.gasAmount)
+		require.Empty(t, c.gasAccounts)
+		require.EqualValues(t, defaultDeployEpochDuration, c.network.EpochDuration)
+		require.EqualValues(t, defaultDeployMaxObjectSize, c.network.MaxObjectSize)
+		require.False(t, c.network.HomomorphicHashingDisabled)
+		require.False(t, c.network.MaintenanceModeAllowed)
+	})
+
+	t.Run("full", func(t *testing.T) {
+		v := newConfig()
+		v.Set("morph.consensus.deploy.system_email", "admin@example.com")
+		v.Set("morph.consensus.deploy.gas.amount", 50)
+		v.Set("morph.consensus.deploy.gas.accounts", []string{acc})
+		v.Set("morph.consensus.deploy.network.epoch_duration", 10)
+		v.Set("morph.consensus.deploy.network.max_object_size", 1024)
+		v.Set("morph.consensus.deploy.network.homomorphic_hashing_disabled", true)
+		v.Set("morph.consensus.deploy.network.maintenance_mode_allowed", true)
+
+		c, err := parseDeployConfig(v, committee)
+		require.NoError(t, err)
+		require.Equal(t, "admin@example.com", c.systemEmail)
+		require.EqualValues(t, 50, c.gasAmount)
+		require.Len(t, c.gasAccounts, 1)
+		require.Equal(t, acc, address.Uint160ToString(c.gasAccounts[0]))
+		require.EqualValues(t, 10, c.network.EpochDuration)
+		require.EqualValues(t, 1024, c.network.MaxObjectSize)
+		require.True(t, c.network.HomomorphicHashingDisabled)
+		require.True(t, c.network.MaintenanceModeAllowed)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseDeployConfig(newConfig(), append(committee, committee[0]))
+		require.Error(t, err, "multiple committee members")
+
+		for _, testCase := range []struct {
+			key string
+			val interface{}
+		}{
+			{"contracts", ""},
+			{"contracts", " \t"},
+			{"gas.amount", "not a number"},
+			{"gas.amount", 0},
+			{"gas.amount", -1},
+			{"gas.accounts", []string{"not an address"}},
+			{"network.epoch_duration", 0},
+			{"network.epoch_duration", math.MaxUint32 + 1},
+			{"network.max_object_size", 0},
+		} {
+			v := newConfig()
+			v.Set("morph.consensus.deploy."+testCase.key, testCase.val)
+
+			_, err := parseDeployConfig(v, committee)
+			require.Error(t, err, fmt.Sprintf("%s=%v", testCase.key, testCase.val))
+		}
+	})
+}
@@ -0,0 +1,273 @@
+package innerring
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nep17"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/manifest"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/nef"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/deploy"
+	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	"go.uber.org/zap"
+)
+
+// deployConfig groups settings of the automatic NeoFS Sidechain deployment
+// performed in local consensus mode.
+type deployConfig struct {
+	enabled bool
+
+	// directory with compiled NeoFS contracts in neofs-adm layout
+	contractsDir string
+
+	systemEmail string
+
+	// amount of GAS (in whole tokens) each of the accounts below must have
+	gasAmount uint64
+	// accounts to be funded by the committee in addition to the local one
+	gasAccounts []util.Uint160
+
+	network deploy.NetworkConfiguration
+}
+
+var persistateCommitteeGroupKey = []byte("committee_group_key")
+
+// persistentKeyStorage is a deploy.KeyStorage storing the key in the Inner
+// Ring persistent state.
+type persistentKeyStorage struct {
+	storage *state.PersistentStorage
+}
+
+// GetPersistedPrivateKey implements deploy.KeyStorage.
+func (x persistentKeyStorage) GetPersistedPrivateKey() (*keys.PrivateKey, error) {
+	b, err := x.storage.Bytes(persistateCommitteeGroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("read key from persistent storage: %w", err)
+	}
+
+	if b != nil {
+		key, err := keys.NewPrivateKeyFromBytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("decode key from persistent storage: %w", err)
+		}
+
+		return key, nil
+	}
+
+	key, err := keys.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate random key: %w", err)
+	}
+
+	err = x.storage.SetBytes(persistateCommitteeGroupKey, key.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("save key in persistent storage: %w", err)
+	}
+
+	return key, nil
+}
+
+// readContract reads compiled contract named ctrName from the directory
+// structured like neofs-contract release: <dir>/<name>/<name>_contract.nef
+// and <dir>/<name>/config.json.
+func readContract(dir, ctrName string) (res deploy.CommonDeployPrm, err error) {
+	ctrDir := filepath.Join(dir, ctrName)
+
+	b, err := os.ReadFile(filepath.Join(ctrDir, ctrName+"_contract.nef"))
+	if err != nil {
+		return res, fmt.Errorf("read NEF file of '%s' contract: %w", ctrName, err)
+	}
+
+	res.NEF, err = nef.FileFromBytes(b)
+	if err != nil {
+		return res, fmt.Errorf("decode NEF file of '%s' contract: %w", ctrName, err)
+	}
+
+	b, err = os.ReadFile(filepath.Join(ctrDir, "config.json"))
+	if err != nil {
+		return res, fmt.Errorf("read manifest file of '%s' contract: %w", ctrName, err)
+	}
+
+	var manif manifest.Manifest
+
+	err = json.Unmarshal(b, &manif)
+	if err != nil {
+		return res, fmt.Errorf("decode manifest file of '%s' contract: %w", ctrName, err)
+	}
+
+	res.Manifest = manif
+
+	return res, nil
+}
+
+// deploySidechain initializes local blockchain as NeoFS Sidechain: funds
+// configured accounts from the committee and deploys NeoFS contracts. The
+// local node must be the only committee member. Blocks until deployment is
+// done or ctx is done.
+func (s *Server) deploySidechain(ctx context.Context, log *zap.Logger, bc deploy.Blockchain, cfg deployConfig) error {
+	committee, err := bc.GetCommittee()
+	if err != nil {
+		return fmt.Errorf("get committee: %w", err)
+	}
+
+	if len(committee) != 1 || !committee[0].Equal(s.key.PublicKey()) {
+		return errors.New("local node must be the only committee member")
+	}
+
+	var prm deploy.Prm
+
+	for _, c := range []struct {
+		name string
+		dst  *deploy.CommonDeployPrm
+	}{
+		{"nns", &prm.NNS.Common},
+		{"alphabet", &prm.AlphabetContract},
+		{"audit", &prm.AuditContract},
+		{"balance", &prm.BalanceContract},
+		{"container", &prm.ContainerContract},
+		{"neofsid", &prm.NeoFSIDContract},
+		{"netmap", &prm.NetmapContract.Common},
+		{"proxy", &prm.ProxyContract},
+		{"reputation", &prm.ReputationContract},
+	} {
+		*c.dst, err = readContract(cfg.contractsDir, c.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	localAcc := wallet.NewAccountFromPrivateKey(s.key)
+
+	committeeAcc := wallet.NewAccountFromPrivateKey(s.key)
+
+	err = committeeAcc.ConvertMultisig(smartcontract.GetMajorityHonestNodeCount(len(committee)), committee)
+	if err != nil {
+		return fmt.Errorf("compose committee multi-signature account: %w", err)
+	}
+
+	committeeActor, err := actor.New(bc, []actor.SignerAccount{{
+		Signer: transaction.Signer{
+			Account: committeeAcc.ScriptHash(),
+			Scopes:  transaction.CalledByEntry,
+		},
+		Account: committeeAcc,
+	}})
+	if err != nil {
+		return fmt.Errorf("create committee actor: %w", err)
+	}
+
+	err = transferCommitteeGAS(ctx, log, committeeActor, cfg.gasAmount, append([]util.Uint160{localAcc.ScriptHash()}, cfg.gasAccounts...))
+	if err != nil {
+		return fmt.Errorf("transfer GAS from the committee: %w", err)
+	}
+
+	prm.Logger = log
+	prm.Blockchain = bc
+	prm.LocalAccount = localAcc
+	prm.KeyStorage = persistentKeyStorage{storage: s.persistate}
+	prm.NNS.SystemEmail = cfg.systemEmail
+	prm.NetmapContract.Config = cfg.network
+
+	log.Info("deploying NeoFS Sidechain...")
+
+	err = deploy.Deploy(ctx, prm)
+	if err != nil {
+		return err
+	}
+
+	log.Info("NeoFS Sidechain successfully deployed")
+
+	proxyContract, err := s.morphClient.NNSContractAddress(client.NNSProxyContractName)
+	if err != nil {
+		return fmt.Errorf("resolve Proxy contract address: %w", err)
+	}
+
+	err = transferCommitteeGAS(ctx, log, committeeActor, cfg.gasAmount, []util.Uint160{proxyContract})
+	if err != nil {
+		return fmt.Errorf("transfer GAS from the committee to the Proxy contract: %w", err)
+	}
+
+	return nil
+}
+
+// transferCommitteeGAS tops up GAS balance of each of the given accounts to
+// the specified amount (in whole tokens) from the committee. Accounts already
+// having enough GAS are skipped.
+func transferCommitteeGAS(ctx context.Context, log *zap.Logger, committeeActor *actor.Actor, amount uint64, accounts []util.Uint160) error {
+	gasToken := gas.New(committeeActor)
+
+	decimals, err := gasToken.Decimals()
+	if err != nil {
+		return fmt.Errorf("get GAS decimals: %w", err)
+	}
+
+	required := new(big.Int).Mul(new(big.Int).SetUint64(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+
+	var transfers []nep17.TransferParameters
+	total := new(big.Int)
+
+	for i := range accounts {
+		bal, err := gasToken.BalanceOf(accounts[i])
+		if err != nil {
+			return fmt.Errorf("get GAS balance of the account %s: %w", accounts[i].StringLE(), err)
+		}
+
+		if bal.Cmp(required) >= 0 {
+			continue
+		}
+
+		diff := new(big.Int).Sub(required, bal)
+		total.Add(total, diff)
+
+		transfers = append(transfers, nep17.TransferParameters{
+			From:   committeeActor.Sender(),
+			To:     accounts[i],
+			Amount: diff,
+		})
+	}
+
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	bal, err := gasToken.BalanceOf(committeeActor.Sender())
+	if err != nil {
+		return fmt.Errorf("get GAS balance of the committee: %w", err)
+	}
+
+	if bal.Cmp(total) < 0 {
+		return fmt.Errorf("insufficient committee GAS balance: need %s, have %s", total, bal)
+	}
+
+	log.Info("transferring GAS from the committee...", zap.Int("receivers", len(transfers)))
+
+	txID, vub, err := gasToken.MultiTransfer(transfers)
+	if err != nil {
+		return fmt.Errorf("send transaction: %w", err)
+	}
+
+	res, err := committeeActor.WaitAny(ctx, vub, txID)
+	if err != nil {
+		return fmt.Errorf("wait for transaction %s: %w", txID.StringLE(), err)
+	}
+
+	if res.VMState != vmstate.Halt {
+		return fmt.Errorf("transaction %s failed: %s", txID.StringLE(), res.FaultException)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package innerring
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	nodestate "github.com/nspcc-dev/neofs-node/pkg/util/state"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPersistentKeyStorage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state")
+
+	storage, err := nodestate.NewPersistentStorage(path)
+	require.NoError(t, err)
+
+	ks := persistentKeyStorage{storage: storage}
+
+	key, err := ks.GetPersistedPrivateKey()
+	require.NoError(t, err)
+
+	key2, err := ks.GetPersistedPrivateKey()
+	require.NoError(t, err)
+	require.Equal(t, key.Bytes(), key2.Bytes())
+
+	require.NoError(t, storage.Close())
+
+	// key survives restart
+	storage, err = nodestate.NewPersistentStorage(path)
+	require.NoError(t, err)
+
+	ks = persistentKeyStorage{storage: storage}
+
+	key2, err = ks.GetPersistedPrivateKey()
+	require.NoError(t, err)
+	require.Equal(t, key.Bytes(), key2.Bytes())
+
+	// corrupted key is not replaced silently
+	require.NoError(t, storage.SetBytes(persistateCommitteeGroupKey, []byte("not a key")))
+
+	_, err = ks.GetPersistedPrivateKey()
+	require.Error(t, err)
+
+	require.NoError(t, storage.Close())
+}
+
+// testGASChain is an actor.RPCActor imitating GAS contract of the blockchain.
+type testGASChain struct {
+	actor.RPCActor
+
+	balances map[util.Uint160]int64
+	// execution state of the sent transactions
+	txState vmstate.State
+
+	scripts [][]byte
+	sent    []*transaction.Transaction
+}
+
+func (x *testGASChain) InvokeFunction(contract util.Uint160, method string, params []smartcontract.Parameter, _ []transaction.Signer) (*result.Invoke, error) {
+	res := &result.Invoke{State: vmstate.Halt.String()}
+
+	switch {
+	case contract != gas.Hash:
+		res.State = vmstate.Fault.String()
+	case method == "decimals":
+		res.Stack = []stackitem.Item{stackitem.Make(8)}
+	case method == "balanceOf":
+		res.Stack = []stackitem.Item{stackitem.Make(x.balances[params[0].Value.(util.Uint160)])}
+	default:
+		res.State = vmstate.Fault.String()
+	}
+
+	return res, nil
+}
+
+func (x *testGASChain) InvokeScript(script []byte, _ []transaction.Signer) (*result.Invoke, error) {
+	x.scripts = append(x.scripts, script)
+	return &result.Invoke{State: vmstate.Halt.String(), Script: script}, nil
+}
+
+func (x *testGASChain) CalculateNetworkFee(*transaction.Transaction) (int64, error) {
+	return 0, nil
+}
+
+func (x *testGASChain) GetBlockCount() (uint32, error) {
+	return 1, nil
+}
+
+func (x *testGASChain) GetVersion() (*result.Version, error) {
+	return &result.Version{
+		Protocol: result.Protocol{
+			MillisecondsPerBlock:        2,
+			ValidatorsCount:             1,
+			MaxValidUntilBlockIncrement: 100,
+		},
+	}, nil
+}
+
+func (x *testGASChain) SendRawTransaction(tx *transaction.Transaction) (util.Uint256, error) {
+	x.sent = append(x.sent, tx)
+	return tx.Hash(), nil
+}
+
+func (x *testGASChain) Context() context.Context {
+	return context.Background()
+}
+
+func (x *testGASChain) GetApplicationLog(h util.Uint256, _ *trigger.Type) (*result.ApplicationLog, error) {
+	return &result.ApplicationLog{
+		Container: h,
+		Executions: []state.Execution{{
+			Trigger: trigger.Application,
+			VMState: x.txState,
+		}},
+	}, nil
+}
+
+func TestTransferCommitteeGAS(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	committeeAcc := wallet.NewAccountFromPrivateKey(key)
+	require.NoError(t, committeeAcc.ConvertMultisig(1, keys.PublicKeys{key.PublicKey()}))
+
+	committee := committeeAcc.ScriptHash()
+	accounts := []util.Uint160{{1}, {2}, {3}}
+
+	const gasAmount = 10
+	const required = gasAmount * 1_0000_0000
+
+	newActor := func(t testing.TB, chain *testGASChain) *actor.Actor {
+		a, err := actor.New(chain, []actor.SignerAccount{{
+			Signer: transaction.Signer{
+				Account: committee,
+				Scopes:  transaction.CalledByEntry,
+			},
+			Account: committeeAcc,
+		}})
+		require.NoError(t, err)
+		return a
+	}
+
+	t.Run("enough GAS", func(t *testing.T) {
+		chain := &testGASChain{
+			balances: map[util.Uint160]int64{
+				accounts[0]: required,
+				accounts[1]: required + 1,
+				accounts[2]: 2 * required,
+			},
+		}
+
+		err := transferCommitteeGAS(context.Background(), zap.NewNop(), newActor(t, chain), gasAmount, accounts)
+		require.NoError(t, err)
+		require.Empty(t, chain.scripts)
+		require.Empty(t, chain.sent)
+	})
+
+	t.Run("top up", func(t *testing.T) {
+		chain := &testGASChain{
+			balances: map[util.Uint160]int64{
+				committee:   2 * required,
+				accounts[1]: required / 2,
+				accounts[2]: required,
+			},
+			txState: vmstate.Halt,
+		}
+
+		err := transferCommitteeGAS(context.Background(), zap.NewNop(), newActor(t, chain), gasAmount, accounts)
+		require.NoError(t, err)
+
+		b := smartcontract.NewBuilder()
+		b.InvokeWithAssert(gas.Hash, "transfer", committee, accounts[0], big.NewInt(required), nil)
+		b.InvokeWithAssert(gas.Hash, "transfer", committee, accounts[1], big.NewInt(required/2), nil)
+		expected, err := b.Script()
+		require.NoError(t, err)
+
+		require.Equal(t, [][]byte{expected}, chain.scripts)
+		require.Len(t, chain.sent, 1)
+		require.Equal(t, expected, chain.sent[0].Script)
+	})
+
+	t.Run("insufficient committee balance", func(t *testing.T) {
+		chain := &testGASChain{
+			balances: map[util.Uint160]int64{
+				committee: required,
+			},
+		}
+
+		err := transferCommitteeGAS(context.Background(), zap.NewNop(), newActor(t, chain), gasAmount, accounts[:2])
+		require.ErrorContains(t, err, "insufficient committee GAS balance")
+		require.Empty(t, chain.sent)
+	})
+
+	t.Run("failed transaction", func(t *testing.T) {
+		chain := &testGASChain{
+			balances: map[util.Uint160]int64{
+				committee: 3 * required,
+			},
+			txState: vmstate.Fault,
+		}
+
+		err := transferCommitteeGAS(context.Background(), zap.NewNop(), newActor(t, chain), gasAmount, accounts)
+		require.Error(t, err)
+		require.Len(t, chain.sent, 1)
+	})
+}
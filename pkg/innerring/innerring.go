@@ -2,6 +2,7 @@ package innerring
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -51,10 +52,12 @@ import (
 	utilConfig "github.com/nspcc-dev/neofs-node/pkg/util/config"
 	"github.com/nspcc-dev/neofs-node/pkg/util/precision"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tlscert"
 	"github.com/panjf2000/ants/v2"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type (
@@ -344,6 +347,11 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		log.Warn("can't get last processed side chain block number", zap.String("error", err.Error()))
 	}
 
+	if cfg.GetString("prometheus.address") != "" {
+		m := metrics.NewInnerRingMetrics(misc.Version)
+		server.metrics = &m
+	}
+
 	morphChain := chainParams{
 		log:  log,
 		cfg:  cfg,
@@ -373,6 +381,11 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 			return nil, fmt.Errorf("invalid blockchain configuration: %w", err)
 		}
 
+		cfgDeploy, err := parseDeployConfig(cfg, cfgBlockchain.Committee)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployment configuration: %w", err)
+		}
+
 		if len(server.predefinedValidators) == 0 {
 			server.predefinedValidators = cfgBlockchain.Committee
 		}
@@ -421,6 +434,13 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		if err != nil {
 			return nil, fmt.Errorf("init internal morph client: %w", err)
 		}
+
+		if cfgDeploy.enabled {
+			err = server.deploySidechain(ctx, log, wsClient, cfgDeploy)
+			if err != nil {
+				return nil, fmt.Errorf("deploy NeoFS Sidechain: %w", err)
+			}
+		}
 	} else {
 		if len(server.predefinedValidators) == 0 {
 			return nil, fmt.Errorf("empty '%s' list in config", validatorsConfigKey)
@@ -926,12 +946,24 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 
 		p.SetPrivateKey(*server.key)
 		p.SetHealthChecker(server)
+		p.SetSettlementReporter(settlementProcessor)
 
 		controlSvc := controlsrv.New(p,
 			controlsrv.WithAllowedKeys(authKeys),
 		)
 
-		grpcControlSrv := grpc.NewServer()
+		var serverOpts []grpc.ServerOption
+
+		if cfg.GetBool("control.grpc.tls.enabled") {
+			creds, err := server.controlTLSCredentials(cfg, controlSvcEndpoint)
+			if err != nil {
+				return nil, err
+			}
+
+			serverOpts = append(serverOpts, grpc.Creds(creds))
+		}
+
+		grpcControlSrv := grpc.NewServer(serverOpts...)
 		control.RegisterControlServiceServer(grpcControlSrv, controlSvc)
 
 		server.runners = append(server.runners, func(ch chan<- error) error {
@@ -951,12 +983,52 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper, errChan chan<-
 		log.Info("no Control server endpoint specified, service is disabled")
 	}
 
-	if cfg.GetString("prometheus.address") != "" {
-		m := metrics.NewInnerRingMetrics(misc.Version)
-		server.metrics = &m
+	return server, nil
+}
+
+// controlTLSCredentials returns TLS credentials of the control service
+// configured in "control.grpc.tls" section. Served certificate is reloaded on
+// its files' changes and its expiration time is exposed as a metric.
+func (s *Server) controlTLSCredentials(cfg *viper.Viper, endpoint string) (credentials.TransportCredentials, error) {
+	const section = "control.grpc.tls."
+
+	cert, err := tlscert.New(cfg.GetString(section+"certificate"), cfg.GetString(section+"key"))
+	if err != nil {
+		return nil, fmt.Errorf("could not read control service certificate: %w", err)
 	}
 
-	return server, nil
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cert.GetCertificate,
+	}
+
+	if caFile := cfg.GetString(section + "client_ca"); caFile != "" {
+		tlsConfig.ClientCAs, err = tlscert.ReadCertPool(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read control service client CA certificates: %w", err)
+		}
+
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	updateMetric := func() {
+		if s.metrics != nil {
+			s.metrics.SetCertificateExpiry(endpoint, cert.NotAfter())
+		}
+	}
+
+	updateMetric()
+
+	s.workers = append(s.workers, func(ctx context.Context) {
+		l := s.log.With(zap.String("endpoint", endpoint))
+
+		err := cert.Watch(ctx, l, updateMetric)
+		if err != nil {
+			l.Error("could not watch TLS certificate files", zap.Error(err))
+		}
+	})
+
+	return credentials.NewTLS(tlsConfig), nil
 }
 
 func createListener(ctx context.Context, cli *client.Client, p chainParams) (event.Listener, error) {
@@ -1000,15 +1072,18 @@ func (s *Server) createClient(ctx context.Context, p chainParams, errChan chan<-
 		return nil, fmt.Errorf("%s chain client endpoints not provided", p.name)
 	}
 
-	return client.New(
-		p.key,
+	opts := []client.Option{
 		client.WithContext(ctx),
 		client.WithLogger(p.log),
-		client.WithDialTimeout(p.cfg.GetDuration(p.name+".dial_timeout")),
+		client.WithDialTimeout(p.cfg.GetDuration(p.name + ".dial_timeout")),
 		client.WithSigner(p.sgn),
 		client.WithEndpoints(endpoints),
-		client.WithReconnectionRetries(p.cfg.GetInt(p.name+".reconnections_number")),
-		client.WithReconnectionsDelay(p.cfg.GetDuration(p.name+".reconnections_delay")),
+		client.WithReconnectionRetries(p.cfg.GetInt(p.name + ".reconnections_number")),
+		client.WithReconnectionsDelay(p.cfg.GetDuration(p.name + ".reconnections_delay")),
+		client.WithHealthCheckInterval(p.cfg.GetDuration(p.name + ".health.interval")),
+		client.WithMaxBlockLag(p.cfg.GetUint32(p.name + ".health.max_lag")),
+		client.WithMaxErrorRate(p.cfg.GetFloat64(p.name + ".health.max_error_rate")),
+		client.WithMaxLatency(p.cfg.GetDuration(p.name + ".health.max_latency")),
 		client.WithConnSwitchCallback(func() {
 			var err error
 
@@ -1024,7 +1099,13 @@ func (s *Server) createClient(ctx context.Context, p chainParams, errChan chan<-
 		client.WithConnLostCallback(func() {
 			errChan <- fmt.Errorf("%s chain connection has been lost", p.name)
 		}),
-	)
+	}
+
+	if s.metrics != nil {
+		opts = append(opts, client.WithMetrics(s.metrics.RPCMetrics(p.name)))
+	}
+
+	return client.New(p.key, opts...)
 }
 
 const validatorsConfigKey = "morph.validators"
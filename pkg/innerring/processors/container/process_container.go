@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
@@ -86,6 +87,12 @@ func (cp *Processor) checkPutContainer(ctx *putContainerContext) error {
 		return fmt.Errorf("NNS: %w", err)
 	}
 
+	// check object lifecycle rules
+	_, err = containercore.ReadLifecycleRules(cnr)
+	if err != nil {
+		return fmt.Errorf("incorrect lifecycle rules: %w", err)
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
@@ -32,6 +33,8 @@ type singleResultCtx struct {
 
 	txTable *common.TransferTable
 
+	reporter common.Reporter
+
 	cnrInfo common.ContainerInfo
 
 	cnrNodes []common.NodeInfo
@@ -55,23 +58,57 @@ func (c *Calculator) Calculate(p *CalculatePrm) {
 		zap.Uint64("current epoch", p.Epoch),
 	)
 
-	if p.Epoch == 0 {
-		log.Info("settlements are ignored for zero epoch")
+	table, err := c.calculate(log, p.Epoch, nil)
+	if err != nil {
+		log.Error("could not collect audit results",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	} else if table == nil {
 		return
 	}
 
+	log.Debug("processing transfers")
+
+	common.TransferAssets(c.prm.Exchanger, table, common.AuditSettlementDetails(p.Epoch-1))
+}
+
+// Report calculates payments for audit results in a specific epoch of the
+// network like Calculate does, but passes them to r instead of transferring.
+func (c *Calculator) Report(p *CalculatePrm, r common.Reporter) error {
+	log := c.opts.log.With(
+		zap.Uint64("current epoch", p.Epoch),
+	)
+
+	_, err := c.calculate(log, p.Epoch, r)
+	if err != nil {
+		return fmt.Errorf("could not collect audit results: %w", err)
+	}
+
+	return nil
+}
+
+// calculate fills the transfer table with payments for audit results of the
+// epoch preceding the given one. If r is set, every calculated payment is passed
+// to it. Returns nil table if there is nothing to transfer.
+func (c *Calculator) calculate(log *zap.Logger, epoch uint64, r common.Reporter) (*common.TransferTable, error) {
+	if epoch == 0 {
+		log.Info("settlements are ignored for zero epoch")
+		return nil, nil
+	}
+
 	log.Info("calculate audit settlements")
 
 	log.Debug("getting results for the previous epoch")
-	prevEpoch := p.Epoch - 1
+	prevEpoch := epoch - 1
 
 	auditResults, err := c.prm.ResultStorage.AuditResultsForEpoch(prevEpoch)
 	if err != nil {
-		log.Error("could not collect audit results")
-		return
+		return nil, err
 	} else if len(auditResults) == 0 {
 		log.Debug("no audit results in previous epoch")
-		return
+		return nil, nil
 	}
 
 	auditFee, err := c.prm.AuditFeeFetcher.AuditFee()
@@ -92,13 +129,12 @@ func (c *Calculator) Calculate(p *CalculatePrm) {
 			log:         log,
 			auditResult: auditResults[i],
 			txTable:     table,
+			reporter:    r,
 			auditFee:    big.NewInt(0).SetUint64(auditFee),
 		})
 	}
 
-	log.Debug("processing transfers")
-
-	common.TransferAssets(c.prm.Exchanger, table, common.AuditSettlementDetails(prevEpoch))
+	return table, nil
 }
 
 func (c *Calculator) processResult(ctx *singleResultCtx) {
@@ -280,6 +316,19 @@ func (c *Calculator) fillTransferTable(ctx *singleResultCtx) bool {
 			fee.Add(fee, bigOne)
 		}
 
+		if ctx.reporter != nil {
+			ctx.reporter.ReportPayment(common.Payment{
+				Type:      common.AuditReward,
+				Container: ctx.containerID(),
+				Node:      info.PublicKey(),
+				From:      cnrOwner,
+				To:        *ownerID,
+				Size:      ctx.sumSGSize.Uint64(),
+				Price:     new(big.Int).Set(price),
+				Amount:    new(big.Int).Set(fee),
+			})
+		}
+
 		ctx.txTable.Transfer(&common.TransferTx{
 			From:   cnrOwner,
 			To:     *ownerID,
@@ -304,6 +353,17 @@ func (c *Calculator) fillTransferTable(ctx *singleResultCtx) bool {
 	}
 	transferTx.To = user.ResolveFromECDSAPublicKey(ecdsa.PublicKey(*auditorKey))
 
+	if ctx.reporter != nil {
+		ctx.reporter.ReportPayment(common.Payment{
+			Type:      common.AuditFee,
+			Container: ctx.containerID(),
+			Node:      ctx.auditResult.AuditorKey(),
+			From:      transferTx.From,
+			To:        transferTx.To,
+			Amount:    new(big.Int).Set(ctx.auditFee),
+		})
+	}
+
 	ctx.txTable.Transfer(transferTx)
 
 	return false
@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
@@ -17,23 +18,33 @@ func (inc *IncomeSettlementContext) Collect() {
 	inc.mu.Lock()
 	defer inc.mu.Unlock()
 
-	cachedRate, err := inc.rate.BasicRate()
+	txTable, err := inc.collect(nil)
 	if err != nil {
-		inc.log.Error("can't get basic income rate",
+		inc.log.Error("can't collect basic income",
+			zap.Uint64("epoch", inc.epoch),
 			zap.String("error", err.Error()))
 
 		return
 	}
 
-	cnrEstimations, err := inc.estimations.Estimations(inc.epoch)
+	common.TransferAssets(inc.exchange, txTable, common.BasicIncomeCollectionDetails(inc.epoch))
+}
+
+// collect calculates basic income payments of the container owners and fills
+// the distribution table. If r is set, every calculated payment is passed to it.
+func (inc *IncomeSettlementContext) collect(r common.Reporter) (*common.TransferTable, error) {
+	cachedRate, err := inc.rate.BasicRate()
 	if err != nil {
-		inc.log.Error("can't fetch container size estimations",
-			zap.Uint64("epoch", inc.epoch),
-			zap.String("error", err.Error()))
+		return nil, fmt.Errorf("can't get basic income rate: %w", err)
+	}
 
-		return
+	cnrEstimations, err := inc.estimations.Estimations(inc.epoch)
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch container size estimations: %w", err)
 	}
 
+	inc.distributeTable = NewNodeSizeTable()
+
 	txTable := common.NewTransferTable()
 
 	for cnr, e := range cnrEstimations {
@@ -65,6 +76,18 @@ func (inc *IncomeSettlementContext) Collect() {
 			inc.distributeTable.Put(cnrNodes[i].PublicKey(), avg)
 		}
 
+		if r != nil {
+			r.ReportPayment(common.Payment{
+				Type:      common.BasicIncomeCollection,
+				Container: cnr,
+				From:      owner.Owner(),
+				To:        inc.bankOwner,
+				Size:      avg * uint64(len(cnrNodes)),
+				Price:     new(big.Int).SetUint64(cachedRate),
+				Amount:    new(big.Int).Set(total),
+			})
+		}
+
 		txTable.Transfer(&common.TransferTx{
 			From:   owner.Owner(),
 			To:     inc.bankOwner,
@@ -72,7 +95,7 @@ func (inc *IncomeSettlementContext) Collect() {
 		})
 	}
 
-	common.TransferAssets(inc.exchange, txTable, common.BasicIncomeCollectionDetails(inc.epoch))
+	return txTable, nil
 }
 
 // avgEstimation returns estimation value for a single container. Right now it
@@ -12,14 +12,11 @@ func (inc *IncomeSettlementContext) Distribute() {
 	inc.mu.Lock()
 	defer inc.mu.Unlock()
 
-	total := inc.distributeTable.Total()
-	if total.Sign() == 0 {
+	if inc.distributeTable.Total().Sign() == 0 {
 		inc.log.Info("zero total size of all estimated containers, skip distribution of funds")
 		return
 	}
 
-	txTable := common.NewTransferTable()
-
 	bankBalance, err := inc.balances.Balance(inc.bankOwner)
 	if err != nil {
 		inc.log.Error("can't fetch balance of banking account",
@@ -28,6 +25,18 @@ func (inc *IncomeSettlementContext) Distribute() {
 		return
 	}
 
+	txTable := inc.distribute(bankBalance, nil)
+
+	common.TransferAssets(inc.exchange, txTable, common.BasicIncomeDistributionDetails(inc.epoch))
+}
+
+// distribute calculates payments of the limit to the storage nodes
+// proportionally to the sizes in the distribution table. If r is set, every
+// calculated payment is passed to it.
+func (inc *IncomeSettlementContext) distribute(limit *big.Int, r common.Reporter) *common.TransferTable {
+	total := inc.distributeTable.Total()
+	txTable := common.NewTransferTable()
+
 	inc.distributeTable.Iterate(func(key []byte, n *big.Int) {
 		nodeOwner, err := inc.accounts.ResolveKey(nodeInfoWrapper(key))
 		if err != nil {
@@ -38,14 +47,55 @@ func (inc *IncomeSettlementContext) Distribute() {
 			return
 		}
 
+		size := n.Uint64()
+		amount := normalizedValue(n, total, limit)
+
+		if r != nil {
+			r.ReportPayment(common.Payment{
+				Type:   common.BasicIncomeDistribution,
+				Node:   key,
+				From:   inc.bankOwner,
+				To:     *nodeOwner,
+				Size:   size,
+				Amount: new(big.Int).Set(amount),
+			})
+		}
+
 		txTable.Transfer(&common.TransferTx{
 			From:   inc.bankOwner,
 			To:     *nodeOwner,
-			Amount: normalizedValue(n, total, bankBalance),
+			Amount: amount,
 		})
 	})
 
-	common.TransferAssets(inc.exchange, txTable, common.BasicIncomeDistributionDetails(inc.epoch))
+	return txTable
+}
+
+// Estimate calculates basic income collection and distribution payments like
+// Collect and Distribute do, but passes them to r instead of transferring.
+// Distribution is estimated as if the banking account holds exactly the
+// collected income.
+func (inc *IncomeSettlementContext) Estimate(r common.Reporter) error {
+	inc.mu.Lock()
+	defer inc.mu.Unlock()
+
+	txTable, err := inc.collect(r)
+	if err != nil {
+		return err
+	}
+
+	if inc.distributeTable.Total().Sign() == 0 {
+		return nil
+	}
+
+	collected := big.NewInt(0)
+	txTable.Iterate(func(tx *common.TransferTx) {
+		collected.Add(collected, tx.Amount)
+	})
+
+	inc.distribute(collected, r)
+
+	return nil
 }
 
 func normalizedValue(n, total, limit *big.Int) *big.Int {
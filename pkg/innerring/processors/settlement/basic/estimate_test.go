@@ -0,0 +1,117 @@
+package basic
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testDeps struct {
+	rate        uint64
+	estimations map[cid.ID]*container.Estimations
+	owners      map[cid.ID]user.ID
+	nodes       map[cid.ID][]common.NodeInfo
+	accounts    map[string]user.ID
+}
+
+func (d testDeps) BasicRate() (uint64, error) { return d.rate, nil }
+
+func (d testDeps) Estimations(uint64) (map[cid.ID]*container.Estimations, error) {
+	return d.estimations, nil
+}
+
+func (d testDeps) ContainerInfo(cnr cid.ID) (common.ContainerInfo, error) {
+	return testContainer(d.owners[cnr]), nil
+}
+
+func (d testDeps) ContainerNodes(_ uint64, cnr cid.ID) ([]common.NodeInfo, error) {
+	return d.nodes[cnr], nil
+}
+
+func (d testDeps) ResolveKey(ni common.NodeInfo) (*user.ID, error) {
+	id := d.accounts[string(ni.PublicKey())]
+	return &id, nil
+}
+
+func (d testDeps) Balance(user.ID) (*big.Int, error) {
+	panic("must not be called in estimation")
+}
+
+func (d testDeps) Transfer(user.ID, user.ID, *big.Int, []byte) {
+	panic("must not be called in estimation")
+}
+
+type testContainer user.ID
+
+func (c testContainer) Owner() user.ID { return user.ID(c) }
+
+type testReport []common.Payment
+
+func (r *testReport) ReportPayment(p common.Payment) { *r = append(*r, p) }
+
+func TestIncomeSettlementContext_Estimate(t *testing.T) {
+	const rate = 1 << 10
+
+	cnr := cidtest.ID()
+	owner := usertest.ID(t)
+	nodeOwners := []user.ID{usertest.ID(t), usertest.ID(t)}
+	nodes := []common.NodeInfo{nodeInfoWrapper{1}, nodeInfoWrapper{2}}
+
+	deps := testDeps{
+		rate: rate,
+		estimations: map[cid.ID]*container.Estimations{
+			cnr: {
+				ContainerID: cnr,
+				Values: []container.Estimation{
+					{Size: 1 << 30},
+					{Size: 3 << 30},
+				},
+			},
+		},
+		owners: map[cid.ID]user.ID{cnr: owner},
+		nodes:  map[cid.ID][]common.NodeInfo{cnr: nodes},
+		accounts: map[string]user.ID{
+			string(nodes[0].PublicKey()): nodeOwners[0],
+			string(nodes[1].PublicKey()): nodeOwners[1],
+		},
+	}
+
+	inc := NewIncomeSettlementContext(&IncomeSettlementContextPrms{
+		Log:         zap.NewNop(),
+		Rate:        deps,
+		Estimations: deps,
+		Balances:    deps,
+		Container:   deps,
+		Placement:   deps,
+		Exchange:    deps,
+		Accounts:    deps,
+	})
+
+	var report testReport
+	require.NoError(t, inc.Estimate(&report))
+	require.Len(t, report, 3)
+
+	collection := report[0]
+	require.Equal(t, common.BasicIncomeCollection, collection.Type)
+	require.Equal(t, cnr, collection.Container)
+	require.Equal(t, owner, collection.From)
+	require.Equal(t, uint64(4<<30), collection.Size) // average 2 GB on each of 2 nodes
+	require.EqualValues(t, rate, collection.Price.Uint64())
+	require.EqualValues(t, 4*rate, collection.Amount.Uint64())
+
+	for _, p := range report[1:] {
+		require.Equal(t, common.BasicIncomeDistribution, p.Type)
+		require.Equal(t, inc.bankOwner, p.From)
+		require.Contains(t, nodeOwners, p.To)
+		require.Equal(t, uint64(2<<30), p.Size)
+		require.EqualValues(t, 2*rate, p.Amount.Uint64())
+	}
+}
@@ -52,3 +52,55 @@ type Exchanger interface {
 	// Amount must be positive.
 	Transfer(sender, recipient user.ID, amount *big.Int, details []byte)
 }
+
+// PaymentType is a type of the settlement payment.
+type PaymentType uint8
+
+const (
+	_ PaymentType = iota
+
+	// BasicIncomeCollection is a payment of the container owner
+	// for the data stored in the container.
+	BasicIncomeCollection
+
+	// BasicIncomeDistribution is a payment to the storage node
+	// for the data it stores.
+	BasicIncomeDistribution
+
+	// AuditReward is a payment of the container owner to the storage node
+	// which passed the data audit.
+	AuditReward
+
+	// AuditFee is a payment of the container owner to the Inner Ring node
+	// which performed the data audit.
+	AuditFee
+)
+
+// Payment groups the data about a single calculated settlement payment.
+type Payment struct {
+	Type PaymentType
+
+	// Container the payment is made for. Zero for
+	// BasicIncomeDistribution payments.
+	Container cid.ID
+
+	// Public key of the node receiving the payment, if any.
+	Node []byte
+
+	From, To user.ID
+
+	// Size of the paid data in bytes.
+	Size uint64
+
+	// Price of storing 1 GB of data during one epoch in GASe-12, if any.
+	Price *big.Int
+
+	// Amount of the payment in GASe-12.
+	Amount *big.Int
+}
+
+// Reporter is an interface of the calculated payments receiver.
+type Reporter interface {
+	// Must handle the calculated payment.
+	ReportPayment(Payment)
+}
@@ -2,12 +2,17 @@ package settlement
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/basic"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
 )
 
 // AuditProcessor is an interface of data audit fee processor.
 type AuditProcessor interface {
 	// Must process data audit conducted in epoch.
 	ProcessAuditSettlements(epoch uint64)
+
+	// Must calculate data audit fees like ProcessAuditSettlements does
+	// and pass them to the Reporter instead of transferring.
+	ReportAuditSettlements(epoch uint64, r common.Reporter) error
 }
 
 // BasicIncomeInitializer is an interface of basic income context creator.
@@ -0,0 +1,39 @@
+package settlement
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+)
+
+type paymentList []common.Payment
+
+func (l *paymentList) ReportPayment(p common.Payment) {
+	*l = append(*l, p)
+}
+
+// Report calculates basic income and data audit payments for the data
+// stored during the given epoch without transferring any assets.
+//
+// The epoch corresponds to the one passed to the basic income events and
+// precedes the one passed to the audit event.
+func (p *Processor) Report(epoch uint64) ([]common.Payment, error) {
+	var res paymentList
+
+	incomeCtx, err := p.basicIncome.CreateContext(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("can't create income context: %w", err)
+	}
+
+	err = incomeCtx.Estimate(&res)
+	if err != nil {
+		return nil, fmt.Errorf("basic income: %w", err)
+	}
+
+	err = p.auditProc.ReportAuditSettlements(epoch+1, &res)
+	if err != nil {
+		return nil, fmt.Errorf("audit: %w", err)
+	}
+
+	return res, nil
+}
@@ -259,6 +259,12 @@ func (s *auditSettlementCalculator) ProcessAuditSettlements(epoch uint64) {
 	})
 }
 
+func (s *auditSettlementCalculator) ReportAuditSettlements(epoch uint64, r common.Reporter) error {
+	return (*audit.Calculator)(s).Report(&audit.CalculatePrm{
+		Epoch: epoch,
+	}, r)
+}
+
 func (b *basicSettlementConstructor) CreateContext(epoch uint64) (*basic.IncomeSettlementContext, error) {
 	return basic.NewIncomeSettlementContext(&basic.IncomeSettlementContextPrms{
 		Log:         b.dep.log,
@@ -89,6 +89,34 @@ func (e *StorageEngine) containerSize(prm ContainerSizePrm) (res ContainerSizeRe
 	return
 }
 
+// ContainerObjects returns the sum of available root objects of the container
+// among all shards. Objects are counted by the metabase index, so tombstones,
+// locks and parts of the split objects are not included.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) ContainerObjects(cnr cid.ID) (uint64, error) {
+	var res uint64
+
+	err := e.execIfNotBlocked(func() error {
+		e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+			n, err := sh.Shard.ContainerObjects(cnr)
+			if err != nil {
+				e.reportShardError(sh, "can't count container objects", err,
+					zap.Stringer("container_id", cnr))
+				return false
+			}
+
+			res += n
+
+			return false
+		})
+
+		return nil
+	})
+
+	return res, err
+}
+
 // ListContainers returns a unique container IDs presented in the engine objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
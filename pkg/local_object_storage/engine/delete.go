@@ -1,17 +1,23 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // DeletePrm groups the parameters of Delete operation.
 type DeletePrm struct {
+	ctx context.Context
+
 	addr oid.Address
 
 	forceRemoval bool
@@ -34,6 +40,11 @@ func (p *DeletePrm) WithForceRemoval() {
 	p.forceRemoval = true
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *DeletePrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Delete marks the objects to be removed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
@@ -45,6 +56,10 @@ func (p *DeletePrm) WithForceRemoval() {
 // on operations with that object) if WithForceRemoval option has
 // been provided.
 func (e *StorageEngine) Delete(prm DeletePrm) (res DeleteRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Delete", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.delete(prm)
 		return err
@@ -91,6 +106,7 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 
 		var shPrm shard.InhumePrm
 		shPrm.MarkAsGarbage(prm.addr)
+		shPrm.SetContext(prm.ctx)
 		if prm.forceRemoval {
 			shPrm.ForceRemoval()
 		}
@@ -114,22 +130,24 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 
 	if splitInfo != nil {
 		if splitID := splitInfo.SplitID(); splitID != nil {
-			e.deleteChildren(prm.addr, prm.forceRemoval, *splitID)
+			e.deleteChildren(prm.ctx, prm.addr, prm.forceRemoval, *splitID)
 		}
 	}
 
 	return DeleteRes{}, nil
 }
 
-func (e *StorageEngine) deleteChildren(addr oid.Address, force bool, splitID objectSDK.SplitID) {
+func (e *StorageEngine) deleteChildren(ctx context.Context, addr oid.Address, force bool, splitID objectSDK.SplitID) {
 	var fs objectSDK.SearchFilters
 	fs.AddSplitIDFilter(objectSDK.MatchStringEqual, splitID)
 
 	var selectPrm shard.SelectPrm
 	selectPrm.SetFilters(fs)
 	selectPrm.SetContainerID(addr.Container())
+	selectPrm.SetContext(ctx)
 
 	var inhumePrm shard.InhumePrm
+	inhumePrm.SetContext(ctx)
 	if force {
 		inhumePrm.ForceRemoval()
 	}
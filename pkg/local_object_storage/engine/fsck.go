@@ -0,0 +1,19 @@
+package engine
+
+import "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+
+// FsckShard checks consistency of the shard with provided identifier.
+//
+// Returns an error if shard is not read-only for checking or is read-only
+// for repairing.
+func (e *StorageEngine) FsckShard(id *shard.ID, prm shard.FsckPrm) (shard.FsckRes, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return shard.FsckRes{}, errShardNotFound
+	}
+
+	return sh.Fsck(prm)
+}
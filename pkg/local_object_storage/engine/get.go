@@ -1,19 +1,25 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
+	ctx context.Context
+
 	addr oid.Address
 }
 
@@ -34,6 +40,11 @@ func (r GetRes) Object() *objectSDK.Object {
 	return r.obj
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *GetPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Get reads an object from local storage.
 //
 // Returns any error encountered that
@@ -44,6 +55,10 @@ func (r GetRes) Object() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Get(prm GetPrm) (res GetRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Get", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.get(prm)
 		return err
@@ -72,6 +87,7 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 
 	var shPrm shard.GetPrm
 	shPrm.SetAddress(prm.addr)
+	shPrm.SetContext(prm.ctx)
 
 	var hasDegraded bool
 	var objectExpired bool
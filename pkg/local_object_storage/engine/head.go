@@ -1,18 +1,24 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
+	ctx context.Context
+
 	addr oid.Address
 	raw  bool
 }
@@ -43,6 +49,11 @@ func (r HeadRes) Header() *objectSDK.Object {
 	return r.head
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *HeadPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Head reads object header from local storage.
 //
 // Returns any error encountered that
@@ -53,6 +64,10 @@ func (r HeadRes) Header() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Head(prm HeadPrm) (res HeadRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Head", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.head(prm)
 		return err
@@ -79,6 +94,7 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 	var shPrm shard.HeadPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRaw(prm.raw)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
 		res, err := sh.Head(shPrm)
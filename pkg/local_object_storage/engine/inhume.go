@@ -6,14 +6,19 @@ import (
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // InhumePrm encapsulates parameters for inhume operation.
 type InhumePrm struct {
+	ctx context.Context
+
 	tombstone *oid.Address
 	addrs     []oid.Address
 
@@ -50,6 +55,11 @@ func (p *InhumePrm) WithForceRemoval() {
 
 var errInhumeFailure = errors.New("inhume operation failed")
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *InhumePrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Inhume calls metabase. Inhume method to mark an object as removed. It won't be
 // removed physically from the shard until `Delete` operation.
 //
@@ -61,6 +71,10 @@ var errInhumeFailure = errors.New("inhume operation failed")
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Inhume(prm InhumePrm) (res InhumeRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Inhume", attribute.Int("count", len(prm.addrs)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.inhume(prm)
 		return err
@@ -75,6 +89,7 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 	}
 
 	var shPrm shard.InhumePrm
+	shPrm.SetContext(prm.ctx)
 	if prm.forceRemoval {
 		shPrm.ForceRemoval()
 	}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -8,13 +9,18 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
+	ctx context.Context
+
 	obj *objectSDK.Object
 }
 
@@ -30,6 +36,11 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 	p.obj = obj
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *PutPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Put saves the object to local storage.
 //
 // Returns any error encountered that
@@ -39,6 +50,10 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
 func (e *StorageEngine) Put(prm PutPrm) (res PutRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Put", attribute.String("address", object.AddressOf(prm.obj).EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.put(prm)
 		return err
@@ -72,7 +87,7 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 			return false
 		}
 
-		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		putDone, exists := e.putToShard(prm.ctx, sh, ind, pool, addr, prm.obj)
 		finished = putDone || exists
 		return finished
 	})
@@ -87,7 +102,7 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 // putToShard puts object to sh.
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
-func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
+func (e *StorageEngine) putToShard(ctx context.Context, sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
 	var putSuccess, alreadyExists bool
 
 	exitCh := make(chan struct{})
@@ -129,6 +144,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
+		putPrm.SetContext(ctx)
 
 		_, err = sh.Put(putPrm)
 		if err != nil {
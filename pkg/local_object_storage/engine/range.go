@@ -1,19 +1,25 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // RngPrm groups the parameters of GetRange operation.
 type RngPrm struct {
+	ctx context.Context
+
 	off, ln uint64
 
 	addr oid.Address
@@ -46,6 +52,11 @@ func (r RngRes) Object() *objectSDK.Object {
 	return r.obj
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *RngPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // GetRange reads part of an object from local storage.
 //
 // Returns any error encountered that
@@ -57,6 +68,10 @@ func (r RngRes) Object() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) GetRange(prm RngPrm) (res RngRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.GetRange", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.getRange(prm)
 		return err
@@ -88,6 +103,7 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 	var shPrm shard.RngPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRange(prm.off, prm.ln)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
 		noMeta := sh.GetMode().NoMetabase()
@@ -1,14 +1,21 @@
 package engine
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
+	ctx context.Context
+
 	cnr     cid.ID
 	filters object.SearchFilters
 }
@@ -33,12 +40,21 @@ func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// WithContext sets context of the operation. It is used for tracing only.
+func (p *SelectPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Select(prm SelectPrm) (res SelectRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "StorageEngine.Select", attribute.String("container", prm.cnr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e._select(prm)
 		return err
@@ -60,6 +76,7 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		res, err := sh.Select(shPrm)
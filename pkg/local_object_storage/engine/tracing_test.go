@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing(t *testing.T) {
+	defer os.RemoveAll(t.Name())
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exp), tracing.Config{SamplingRatio: 1})
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	e := testNewEngineWithShardNum(t, 1)
+	defer e.Close()
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := objectCore.AddressOf(obj)
+
+	ctx, root := tracing.StartSpan(context.Background(), "request")
+
+	var putPrm PutPrm
+	putPrm.WithObject(obj)
+	putPrm.WithContext(ctx)
+
+	_, err := e.Put(putPrm)
+	require.NoError(t, err)
+
+	var getPrm GetPrm
+	getPrm.WithAddress(addr)
+	getPrm.WithContext(ctx)
+
+	_, err = e.Get(getPrm)
+	require.NoError(t, err)
+
+	root.End()
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, s := range exp.GetSpans() {
+		require.Equal(t, root.SpanContext().TraceID(), s.SpanContext.TraceID())
+		spans[s.Name] = s
+	}
+
+	for engineOp, shardOp := range map[string]string{
+		"StorageEngine.Put": "Shard.Put",
+		"StorageEngine.Get": "Shard.Get",
+	} {
+		require.Contains(t, spans, engineOp)
+		require.Contains(t, spans, shardOp)
+		require.Equal(t, root.SpanContext().SpanID(), spans[engineOp].Parent.SpanID())
+		require.Equal(t, spans[engineOp].SpanContext.SpanID(), spans[shardOp].Parent.SpanID())
+	}
+
+	require.NotEmpty(t, spans["Shard.Get"].Events)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 )
 
@@ -66,6 +67,59 @@ func (db *DB) containerSize(tx *bbolt.Tx, id cid.ID) (uint64, error) {
 	return parseContainerSize(containerVolume.Get(key)), nil
 }
 
+// ContainerObjects returns the number of available root objects of the
+// container, i.e. regular objects and parents of the split ones. Tombstones,
+// locks, children and removed or expired objects are not counted.
+func (db *DB) ContainerObjects(cnr cid.ID) (count uint64, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	currEpoch := db.epochState.CurrentEpoch()
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		count, err = db.containerObjects(tx, cnr, currEpoch)
+
+		return err
+	})
+
+	return count, err
+}
+
+func (db *DB) containerObjects(tx *bbolt.Tx, cnr cid.ID, currEpoch uint64) (uint64, error) {
+	bkt := tx.Bucket(rootBucketName(cnr, make([]byte, bucketKeySize)))
+	if bkt == nil {
+		return 0, nil
+	}
+
+	var (
+		count uint64
+		id    oid.ID
+		addr  oid.Address
+	)
+
+	addr.SetContainer(cnr)
+
+	err := bkt.ForEach(func(k, _ []byte) error {
+		if id.Decode(k) != nil {
+			return nil
+		}
+
+		addr.SetObject(id)
+
+		if objectStatus(tx, addr, currEpoch) == 0 {
+			count++
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
 func parseContainerID(dst *cid.ID, name []byte, ignore map[string]struct{}) bool {
 	if len(name) != bucketKeySize {
 		return false
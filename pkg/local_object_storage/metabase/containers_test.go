@@ -193,3 +193,45 @@ func TestDB_ContainerSize(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_ContainerObjects(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	n, err := db.ContainerObjects(cnr)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	first := generateObjectWithCID(t, cnr)
+	second := generateObjectWithCID(t, cnr)
+
+	parent := generateObjectWithCID(t, cnr)
+	idParent, _ := parent.ID()
+
+	child := generateObjectWithCID(t, cnr)
+	child.SetParentID(idParent)
+	child.SetParent(parent)
+
+	link := generateObjectWithCID(t, cnr)
+	link.SetParentID(idParent)
+	link.SetParent(parent)
+
+	tomb := generateObjectWithCID(t, cnr)
+	tomb.SetType(objectSDK.TypeTombstone)
+
+	for _, obj := range []*objectSDK.Object{first, second, child, link, tomb, generateObject(t)} {
+		require.NoError(t, putBig(db, obj))
+	}
+
+	// regular objects and the parent only
+	n, err = db.ContainerObjects(cnr)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, n)
+
+	require.NoError(t, metaInhume(db, object.AddressOf(first), object.AddressOf(tomb)))
+
+	n, err = db.ContainerObjects(cnr)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+}
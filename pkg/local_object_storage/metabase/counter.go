@@ -51,18 +51,7 @@ func (db *DB) ObjectCounters() (cc ObjectCounters, err error) {
 	}
 
 	err = db.boltDB.View(func(tx *bbolt.Tx) error {
-		b := tx.Bucket(shardInfoBucket)
-		if b != nil {
-			data := b.Get(objectPhyCounterKey)
-			if len(data) == 8 {
-				cc.phy = binary.LittleEndian.Uint64(data)
-			}
-
-			data = b.Get(objectLogicCounterKey)
-			if len(data) == 8 {
-				cc.logic = binary.LittleEndian.Uint64(data)
-			}
-		}
+		cc = readObjectCounters(tx)
 
 		return nil
 	})
@@ -70,6 +59,25 @@ func (db *DB) ObjectCounters() (cc ObjectCounters, err error) {
 	return
 }
 
+func readObjectCounters(tx *bbolt.Tx) ObjectCounters {
+	var cc ObjectCounters
+
+	b := tx.Bucket(shardInfoBucket)
+	if b != nil {
+		data := b.Get(objectPhyCounterKey)
+		if len(data) == 8 {
+			cc.phy = binary.LittleEndian.Uint64(data)
+		}
+
+		data = b.Get(objectLogicCounterKey)
+		if len(data) == 8 {
+			cc.logic = binary.LittleEndian.Uint64(data)
+		}
+	}
+
+	return cc
+}
+
 // updateCounter updates the object counter. Tx MUST be writable.
 // If inc == `true`, increases the counter, decreases otherwise.
 func (db *DB) updateCounter(tx *bbolt.Tx, typ objectType, delta uint64, inc bool) error {
@@ -198,3 +198,43 @@ func iteratePhyObjects(tx *bbolt.Tx, f func(cid.ID, oid.ID) error) error {
 		})
 	})
 }
+
+// IteratePhyObjects iterates over all physically stored objects in DB and
+// passes their addresses and storage IDs to h. Storage ID is nil if it is
+// not indexed (e.g. the object is located in the write-cache). Inhumed
+// objects are also included.
+//
+// Handler must not modify the DB, it is called inside a read transaction.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+func (db *DB) IteratePhyObjects(h func(addr oid.Address, storageID []byte) error) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var addr oid.Address
+
+		return iteratePhyObjects(tx, func(cnr cid.ID, obj oid.ID) error {
+			addr.SetContainer(cnr)
+			addr.SetObject(obj)
+
+			storageID, err := db.storageID(tx, addr)
+			if err != nil {
+				return err
+			}
+
+			return h(addr, storageID)
+		})
+	})
+
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return err
+}
@@ -119,3 +119,42 @@ func TestDB_IterateCoveredByTombstones(t *testing.T) {
 	require.Len(t, handled, 2)
 	require.NotContains(t, handled, protectedLocked)
 }
+
+func TestDB_IteratePhyObjects(t *testing.T) {
+	db := newDB(t)
+
+	big := generateObject(t)
+	require.NoError(t, putBig(db, big))
+
+	small := generateObject(t)
+	small.SetType(object.TypeLock)
+	require.NoError(t, metaPut(db, small, []byte("storage-id")))
+
+	inhumed := generateObject(t)
+	require.NoError(t, metaPut(db, inhumed, []byte{}))
+
+	var prm meta.InhumePrm
+	prm.SetAddresses(object2.AddressOf(inhumed))
+	prm.SetGCMark()
+
+	_, err := db.Inhume(prm)
+	require.NoError(t, err)
+
+	exp := map[oid.Address][]byte{
+		object2.AddressOf(big):     nil,
+		object2.AddressOf(small):   []byte("storage-id"),
+		object2.AddressOf(inhumed): {},
+	}
+
+	err = db.IteratePhyObjects(func(addr oid.Address, storageID []byte) error {
+		id, ok := exp[addr]
+		require.True(t, ok)
+		require.Equal(t, id, storageID)
+
+		delete(exp, addr)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, exp)
+}
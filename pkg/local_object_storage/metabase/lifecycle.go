@@ -0,0 +1,292 @@
+package meta
+
+import (
+	"bytes"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// LifecycleExpiredPrm groups the parameters of ListLifecycleExpired operation.
+type LifecycleExpiredPrm struct {
+	cnr    cid.ID
+	rules  container.LifecycleRules
+	epoch  uint64
+	count  int
+	cursor *Cursor
+}
+
+// SetContainer sets container which objects are checked.
+func (p *LifecycleExpiredPrm) SetContainer(cnr cid.ID) {
+	p.cnr = cnr
+}
+
+// SetRules sets lifecycle rules the objects are checked against.
+func (p *LifecycleExpiredPrm) SetRules(rules container.LifecycleRules) {
+	p.rules = rules
+}
+
+// SetEpoch sets epoch the objects are checked at.
+func (p *LifecycleExpiredPrm) SetEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+// SetCount sets maximum number of objects checked by one
+// ListLifecycleExpired call.
+func (p *LifecycleExpiredPrm) SetCount(count uint32) {
+	p.count = int(count)
+}
+
+// SetCursor sets cursor for ListLifecycleExpired operation. For initial
+// request set Cursor to nil.
+func (p *LifecycleExpiredPrm) SetCursor(cursor *Cursor) {
+	p.cursor = cursor
+}
+
+// LifecycleExpiredRes groups the resulting values of ListLifecycleExpired operation.
+type LifecycleExpiredRes struct {
+	addrList []oid.Address
+	versions []LifecycleVersion
+	cursor   *Cursor
+	next     uint64
+}
+
+// LifecycleVersion describes a non-expired object which versions are limited
+// by the lifecycle rule. Whether the object is outdated depends on the other
+// versions in the network, so it is decided by the caller.
+type LifecycleVersion struct {
+	// Index of the rule in the checked rules.
+	Rule int
+
+	// Value of the rule attribute.
+	Value string
+
+	// Creation epoch of the object.
+	Epoch uint64
+
+	// Addresses of the object and the related split chain objects
+	// to be removed along with it.
+	Addresses []oid.Address
+}
+
+// AddressList returns addresses of the expired objects. Split chain objects
+// carrying the expired parent are followed by the parent and the children
+// listed in them.
+func (r LifecycleExpiredRes) AddressList() []oid.Address {
+	return r.addrList
+}
+
+// Versions returns non-expired objects limited by the versions lifecycle
+// rules. Locked objects are not returned.
+func (r LifecycleExpiredRes) Versions() []LifecycleVersion {
+	return r.versions
+}
+
+// Cursor returns cursor for the consecutive listing. Nil cursor means that
+// all container objects have been checked.
+func (r LifecycleExpiredRes) Cursor() *Cursor {
+	return r.cursor
+}
+
+// NextExpiration returns the earliest epoch any of the checked non-expired
+// objects is expired at. Zero means that none of them is ever expired.
+func (r LifecycleExpiredRes) NextExpiration() uint64 {
+	return r.next
+}
+
+// ListLifecycleExpired checks a batch of available regular objects of the
+// container against the lifecycle rules and returns the expired ones along
+// with the objects limited by the versions rules. Locked objects are not
+// returned, they are considered to expire at the next epoch instead. Use cursor value from the response to continue
+// checking.
+func (db *DB) ListLifecycleExpired(prm LifecycleExpiredPrm) (res LifecycleExpiredRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	if len(prm.rules) == 0 || prm.count <= 0 {
+		return res, nil
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res = db.listLifecycleExpired(tx, prm)
+		return nil
+	})
+
+	return res, err
+}
+
+func (db *DB) listLifecycleExpired(tx *bbolt.Tx, prm LifecycleExpiredPrm) (res LifecycleExpiredRes) {
+	bucketName := primaryBucketName(prm.cnr, make([]byte, bucketKeySize))
+
+	bkt := tx.Bucket(bucketName)
+	if bkt == nil {
+		return
+	}
+
+	graveyardBkt := tx.Bucket(graveyardBucketName)
+	garbageBkt := tx.Bucket(garbageBucketName)
+
+	c := bkt.Cursor()
+	k, v := c.First()
+
+	if prm.cursor != nil {
+		k, v = c.Seek(prm.cursor.inBucketOffset)
+		if bytes.Equal(k, prm.cursor.inBucketOffset) {
+			k, v = c.Next()
+		}
+	}
+
+	type versionKey struct {
+		rule int
+		id   oid.ID
+	}
+
+	var (
+		id       oid.ID
+		checked  int
+		lastKey  []byte
+		seen     = make(map[oid.ID]struct{})
+		versions = make(map[versionKey]int)
+		addrKey  = make([]byte, addressKeySize)
+		limitVer = prm.rules.LimitVersions()
+	)
+
+	prm.cnr.Encode(addrKey)
+
+	for ; k != nil; k, v = c.Next() {
+		if checked == prm.count {
+			// copy is needed because the key exists during bbolt tx only
+			res.cursor = &Cursor{
+				bucketName:     bucketName,
+				inBucketOffset: make([]byte, len(lastKey)),
+			}
+
+			copy(res.cursor.inBucketOffset, lastKey)
+
+			break
+		}
+
+		checked++
+		lastKey = k
+
+		if id.Decode(k) != nil {
+			continue
+		}
+
+		copy(addrKey[cidSize:], k)
+
+		var hdr objectSDK.Object
+
+		if inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt) > 0 || hdr.Unmarshal(v) != nil {
+			continue
+		}
+
+		lhdr := lifecycleHeader(&hdr)
+
+		expiresAt, ok := prm.rules.ExpirationEpoch(*lhdr)
+		if ok && expiresAt <= prm.epoch && objectLocked(tx, prm.cnr, id) {
+			expiresAt = prm.epoch + 1
+		}
+
+		if ok && expiresAt <= prm.epoch {
+			for _, rel := range relatedObjects(id, &hdr) {
+				if _, ok := seen[rel]; !ok {
+					seen[rel] = struct{}{}
+					res.addrList = append(res.addrList, lifecycleAddress(prm.cnr, rel))
+				}
+			}
+
+			continue
+		}
+
+		if ok && (res.next == 0 || expiresAt < res.next) {
+			res.next = expiresAt
+		}
+
+		if !limitVer || objectLocked(tx, prm.cnr, id) {
+			continue
+		}
+
+		verID, ok := lhdr.ID()
+		if !ok {
+			verID = id
+		}
+
+		for i := range prm.rules {
+			val, ok := prm.rules[i].Version(*lhdr)
+			if !ok {
+				continue
+			}
+
+			// split chain objects carrying the same parent are merged
+			key := versionKey{rule: i, id: verID}
+
+			ind, ok := versions[key]
+			if !ok {
+				ind = len(res.versions)
+				versions[key] = ind
+
+				res.versions = append(res.versions, LifecycleVersion{
+					Rule:  i,
+					Value: val,
+					Epoch: lhdr.CreationEpoch(),
+				})
+			}
+
+			v := &res.versions[ind]
+
+		nextRelated:
+			for _, rel := range relatedObjects(id, &hdr) {
+				for j := range v.Addresses {
+					if v.Addresses[j].Object() == rel {
+						continue nextRelated
+					}
+				}
+
+				v.Addresses = append(v.Addresses, lifecycleAddress(prm.cnr, rel))
+			}
+		}
+	}
+
+	return
+}
+
+// relatedObjects returns the object with the given header and the split
+// chain objects the header refers to: the parent and the children.
+func relatedObjects(id oid.ID, hdr *objectSDK.Object) []oid.ID {
+	res := []oid.ID{id}
+
+	if par := hdr.Parent(); par != nil {
+		if parID, ok := par.ID(); ok {
+			res = append(res, parID)
+		}
+	}
+
+	return append(res, hdr.Children()...)
+}
+
+func lifecycleAddress(cnr cid.ID, id oid.ID) oid.Address {
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	return addr
+}
+
+// lifecycleHeader returns header the lifecycle rules are applied to: the
+// parent header for the split chain objects carrying it, the object header
+// otherwise.
+func lifecycleHeader(hdr *objectSDK.Object) *objectSDK.Object {
+	if par := hdr.Parent(); par != nil {
+		return par
+	}
+
+	return hdr
+}
@@ -0,0 +1,262 @@
+package meta_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_LifecycleRules(t *testing.T) {
+	const epoch = 10
+
+	cnr := cidtest.ID()
+	rules := container.LifecycleRules{{Attribute: "Type", Prefix: "tmp", ExpireAfter: 2}}
+
+	db := newDB(t, meta.WithEpochState(epochState{e: epoch}))
+
+	newObject := func(creationEpoch uint64, typ string) *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(creationEpoch)
+
+		if typ != "" {
+			addAttribute(obj, "Type", typ)
+		}
+
+		return obj
+	}
+
+	var (
+		expired = newObject(5, "tmp/cache")
+		fresh   = newObject(9, "tmp/cache")
+		other   = newObject(1, "image")
+		locked  = newObject(1, "tmp/cache")
+
+		parent = newObject(1, "tmp/large")
+		middle = newObject(1, "")
+		last   = newObject(1, "")
+		link   = newObject(1, "")
+	)
+
+	parentID, _ := parent.ID()
+	middleID, _ := middle.ID()
+	lastID, _ := last.ID()
+
+	parent.SetPayload(nil)
+
+	splitID := objectSDK.NewSplitID()
+
+	for _, child := range []*objectSDK.Object{middle, last, link} {
+		child.SetSplitID(splitID)
+	}
+
+	// intermediate children know neither parent ID nor header
+	for _, child := range []*objectSDK.Object{last, link} {
+		child.SetParentID(parentID)
+		child.SetParent(parent)
+	}
+
+	last.SetPreviousID(middleID)
+	link.SetChildren(middleID, lastID)
+
+	for _, obj := range []*objectSDK.Object{expired, fresh, other, locked, middle, last, link} {
+		require.NoError(t, putBig(db, obj))
+	}
+
+	lockedID, _ := locked.ID()
+	require.NoError(t, db.Lock(cnr, oidtest.ID(), []oid.ID{lockedID}))
+
+	t.Run("reads are not filtered", func(t *testing.T) {
+		for _, obj := range []*objectSDK.Object{expired, fresh, other, locked, middle, last, link} {
+			exists, err := metaExists(db, objectcore.AddressOf(obj))
+			require.NoError(t, err)
+			require.True(t, exists)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		var (
+			prm   meta.LifecycleExpiredPrm
+			addrs []oid.Address
+			next  uint64
+			calls int
+		)
+
+		prm.SetContainer(cnr)
+		prm.SetRules(rules)
+		prm.SetEpoch(epoch)
+		prm.SetCount(2)
+
+		for {
+			res, err := db.ListLifecycleExpired(prm)
+			require.NoError(t, err)
+
+			calls++
+			addrs = append(addrs, res.AddressList()...)
+
+			if e := res.NextExpiration(); e != 0 && (next == 0 || e < next) {
+				next = e
+			}
+
+			if res.Cursor() == nil {
+				break
+			}
+
+			prm.SetCursor(res.Cursor())
+		}
+
+		require.Equal(t, 4, calls)
+		require.ElementsMatch(t, []oid.Address{
+			objectcore.AddressOf(expired),
+			objectcore.AddressOf(parent),
+			objectcore.AddressOf(middle),
+			objectcore.AddressOf(last),
+			objectcore.AddressOf(link),
+		}, deduplicate(addrs))
+
+		// locked object is rechecked at the next epoch
+		require.EqualValues(t, epoch+1, next)
+	})
+
+	t.Run("garbage is skipped", func(t *testing.T) {
+		var inhumePrm meta.InhumePrm
+		inhumePrm.SetAddresses(objectcore.AddressOf(expired))
+		inhumePrm.SetGCMark()
+
+		_, err := db.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		var prm meta.LifecycleExpiredPrm
+		prm.SetContainer(cnr)
+		prm.SetRules(rules)
+		prm.SetEpoch(epoch)
+		prm.SetCount(100)
+
+		res, err := db.ListLifecycleExpired(prm)
+		require.NoError(t, err)
+		require.Nil(t, res.Cursor())
+		require.NotContains(t, res.AddressList(), objectcore.AddressOf(expired))
+	})
+}
+
+func deduplicate(addrs []oid.Address) []oid.Address {
+	res := make([]oid.Address, 0, len(addrs))
+	seen := make(map[oid.Address]struct{}, len(addrs))
+
+	for _, a := range addrs {
+		if _, ok := seen[a]; !ok {
+			seen[a] = struct{}{}
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+func TestDB_LifecycleVersions(t *testing.T) {
+	const epoch = 10
+
+	cnr := cidtest.ID()
+	rules := container.LifecycleRules{
+		{Attribute: "Type", Prefix: "tmp", ExpireAfter: 2},
+		{Attribute: "FilePath", Prefix: "backup/", Versions: 1},
+	}
+
+	db := newDB(t, meta.WithEpochState(epochState{e: epoch}))
+
+	newObject := func(creationEpoch uint64, attrs ...string) *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(creationEpoch)
+
+		for i := 0; i < len(attrs); i += 2 {
+			addAttribute(obj, attrs[i], attrs[i+1])
+		}
+
+		return obj
+	}
+
+	var (
+		v1      = newObject(1, "FilePath", "backup/db")
+		v2      = newObject(5, "FilePath", "backup/db")
+		locked  = newObject(2, "FilePath", "backup/db")
+		other   = newObject(1, "FilePath", "image")
+		expired = newObject(1, "FilePath", "backup/tmp", "Type", "tmp")
+
+		parent = newObject(3, "FilePath", "backup/large")
+		last   = newObject(3)
+		link   = newObject(3)
+	)
+
+	parentID, _ := parent.ID()
+	lastID, _ := last.ID()
+
+	parent.SetPayload(nil)
+
+	splitID := objectSDK.NewSplitID()
+
+	for _, child := range []*objectSDK.Object{last, link} {
+		child.SetSplitID(splitID)
+		child.SetParentID(parentID)
+		child.SetParent(parent)
+	}
+
+	link.SetChildren(lastID)
+
+	for _, obj := range []*objectSDK.Object{v1, v2, locked, other, expired, last, link} {
+		require.NoError(t, putBig(db, obj))
+	}
+
+	lockedID, _ := locked.ID()
+	require.NoError(t, db.Lock(cnr, oidtest.ID(), []oid.ID{lockedID}))
+
+	var prm meta.LifecycleExpiredPrm
+	prm.SetContainer(cnr)
+	prm.SetRules(rules)
+	prm.SetEpoch(epoch)
+	prm.SetCount(100)
+
+	res, err := db.ListLifecycleExpired(prm)
+	require.NoError(t, err)
+	require.Nil(t, res.Cursor())
+
+	// expired objects are not checked against versions rules
+	require.Equal(t, []oid.Address{objectcore.AddressOf(expired)}, res.AddressList())
+
+	versions := res.Versions()
+	require.Len(t, versions, 3)
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Epoch < versions[j].Epoch
+	})
+
+	require.Equal(t, meta.LifecycleVersion{
+		Rule:      1,
+		Value:     "backup/db",
+		Epoch:     1,
+		Addresses: []oid.Address{objectcore.AddressOf(v1)},
+	}, versions[0])
+
+	// split chain objects carrying the same parent are merged
+	require.Equal(t, 1, versions[1].Rule)
+	require.Equal(t, "backup/large", versions[1].Value)
+	require.EqualValues(t, 3, versions[1].Epoch)
+	require.ElementsMatch(t, []oid.Address{
+		objectcore.AddressOf(parent),
+		objectcore.AddressOf(last),
+		objectcore.AddressOf(link),
+	}, versions[1].Addresses)
+
+	require.Equal(t, meta.LifecycleVersion{
+		Rule:      1,
+		Value:     "backup/db",
+		Epoch:     5,
+		Addresses: []oid.Address{objectcore.AddressOf(v2)},
+	}, versions[2])
+}
@@ -532,3 +532,66 @@ func isLinkObject(obj *objectSDK.Object) bool {
 func isLastObject(obj *objectSDK.Object) bool {
 	return len(obj.Children()) == 0 && obj.Parent() != nil
 }
+
+// IndexObject saves the object stored in the BLOB storage with the given
+// storage ID in the metabase. Unlike Put, relations declared by the object
+// are restored too: tombstone members are inhumed and lock members are
+// locked. It is intended to rebuild the metabase from the stored objects.
+//
+// Returns an error of type apistatus.ObjectAlreadyRemoved if the object has
+// been placed in graveyard and ErrObjectIsExpired if the object is expired.
+func (db *DB) IndexObject(obj *objectSDK.Object, storageID []byte) error {
+	//nolint: exhaustive
+	switch obj.Type() {
+	case objectSDK.TypeTombstone:
+		tombstone := objectSDK.NewTombstone()
+
+		if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not unmarshal tombstone content: %w", err)
+		}
+
+		tombAddr := object.AddressOf(obj)
+		memberIDs := tombstone.Members()
+		tombMembers := make([]oid.Address, 0, len(memberIDs))
+
+		for i := range memberIDs {
+			a := tombAddr
+			a.SetObject(memberIDs[i])
+
+			tombMembers = append(tombMembers, a)
+		}
+
+		var inhumePrm InhumePrm
+
+		inhumePrm.SetTombstoneAddress(tombAddr)
+		inhumePrm.SetAddresses(tombMembers...)
+
+		_, err := db.Inhume(inhumePrm)
+		if err != nil {
+			return fmt.Errorf("could not inhume objects: %w", err)
+		}
+	case objectSDK.TypeLock:
+		var lock objectSDK.Lock
+		if err := lock.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not unmarshal lock content: %w", err)
+		}
+
+		locked := make([]oid.ID, lock.NumberOfMembers())
+		lock.ReadMembers(locked)
+
+		cnr, _ := obj.ContainerID()
+		id, _ := obj.ID()
+		err := db.Lock(cnr, id, locked)
+		if err != nil {
+			return fmt.Errorf("could not lock objects: %w", err)
+		}
+	}
+
+	var prm PutPrm
+	prm.SetObject(obj)
+	prm.SetStorageID(storageID)
+
+	_, err := db.Put(prm)
+
+	return err
+}
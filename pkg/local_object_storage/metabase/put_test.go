@@ -12,6 +12,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util/rand"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
@@ -122,3 +123,60 @@ func metaPut(db *meta.DB, obj *objectSDK.Object, id []byte) error {
 
 	return err
 }
+
+func TestDB_IndexObject(t *testing.T) {
+	db := newDB(t)
+	cnr := cidtest.ID()
+
+	removed := generateObjectWithCID(t, cnr)
+	locked := generateObjectWithCID(t, cnr)
+
+	tombstone := objectSDK.NewTombstone()
+	tombstone.SetMembers([]oid.ID{object.AddressOf(removed).Object()})
+
+	data, err := tombstone.Marshal()
+	require.NoError(t, err)
+
+	ts := generateObjectWithCID(t, cnr)
+	ts.SetType(objectSDK.TypeTombstone)
+	ts.SetPayload(data)
+
+	var lock objectSDK.Lock
+	lock.WriteMembers([]oid.ID{object.AddressOf(locked).Object()})
+
+	lockObj := generateObjectWithCID(t, cnr)
+	lockObj.SetType(objectSDK.TypeLock)
+	lockObj.SetPayload(lock.Marshal())
+
+	storageID := []byte{1, 2, 3}
+
+	require.NoError(t, db.IndexObject(ts, storageID))
+	require.NoError(t, db.IndexObject(lockObj, nil))
+	require.NoError(t, db.IndexObject(locked, []byte{}))
+
+	err = db.IndexObject(removed, nil)
+	require.True(t, meta.IsErrRemoved(err))
+
+	fetchedStorageID, err := metaStorageID(db, object.AddressOf(ts))
+	require.NoError(t, err)
+	require.Equal(t, storageID, fetchedStorageID)
+
+	var lockedPrm meta.IsLockedPrm
+	lockedPrm.SetAddress(object.AddressOf(locked))
+
+	res, err := db.IsLocked(lockedPrm)
+	require.NoError(t, err)
+	require.True(t, res.Locked())
+
+	exists, err := metaExists(db, object.AddressOf(locked))
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	t.Run("invalid tombstone", func(t *testing.T) {
+		invalid := generateObjectWithCID(t, cnr)
+		invalid.SetType(objectSDK.TypeTombstone)
+		invalid.SetPayload([]byte("not a tombstone"))
+
+		require.Error(t, db.IndexObject(invalid, nil))
+	})
+}
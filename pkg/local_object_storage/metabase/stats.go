@@ -0,0 +1,247 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.etcd.io/bbolt"
+)
+
+// Stats groups statistics of the metabase content.
+type Stats struct {
+	// Object counters tracked by the metabase.
+	Counters ObjectCounters
+
+	// Statistics of the containers which objects are stored in the
+	// metabase, in no particular order.
+	Containers []ContainerStats
+
+	// Number of objects covered with tombstones.
+	Graveyard uint64
+	// Number of objects marked as garbage.
+	Garbage uint64
+	// Number of locked objects.
+	Locked uint64
+
+	// Statistics of the buckets by their kind (e.g. "primary",
+	// "graveyard", "attribute").
+	Buckets map[string]BucketStats
+
+	// Size of the database in bytes.
+	Size int64
+	// Size of the database page in bytes.
+	PageSize int
+	// Total number of pages.
+	Pages int
+	// Number of free and pending pages. Negative if the free pages are
+	// not loaded (see bbolt.Options.PreLoadFreelist).
+	FreePages int
+}
+
+// ContainerStats groups statistics of the container objects.
+type ContainerStats struct {
+	// Container identifier.
+	ID cid.ID
+
+	// Numbers of the physically stored objects by type.
+	Regular      uint64
+	Tombstone    uint64
+	StorageGroup uint64
+	Lock         uint64
+
+	// Estimated size of the container payload in bytes.
+	Size uint64
+}
+
+// Objects returns the total number of physically stored container objects.
+func (s ContainerStats) Objects() uint64 {
+	return s.Regular + s.Tombstone + s.StorageGroup + s.Lock
+}
+
+// BucketStats groups statistics of the buckets of the same kind.
+type BucketStats struct {
+	// Number of buckets including the nested ones.
+	Buckets int
+	// Number of keys.
+	Keys int
+	// Number of bytes allocated for the buckets.
+	Alloc int
+	// Number of bytes actually used by the buckets.
+	InUse int
+}
+
+// bucketKinds maps bucket name prefixes to the human-readable names.
+var bucketKinds = map[byte]string{
+	graveyardPrefix:       "graveyard",
+	garbagePrefix:         "garbage",
+	toMoveItPrefix:        "to-move-it",
+	containerVolumePrefix: "container-volume",
+	lockedPrefix:          "locked",
+	shardInfoPrefix:       "shard-info",
+	primaryPrefix:         "primary",
+	lockersPrefix:         "lockers",
+	storageGroupPrefix:    "storage-group",
+	tombstonePrefix:       "tombstone",
+	smallPrefix:           "small",
+	rootPrefix:            "root",
+	ownerPrefix:           "owner",
+	userAttributePrefix:   "attribute",
+	payloadHashPrefix:     "payload-hash",
+	parentPrefix:          "parent",
+	splitPrefix:           "split",
+}
+
+// Stats collects statistics of the metabase content. It traverses the
+// whole database, so it may take a while for the big ones.
+func (db *DB) Stats() (Stats, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return Stats{}, ErrDegradedMode
+	}
+
+	var res Stats
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.Counters = readObjectCounters(tx)
+		res.Size = tx.Size()
+		res.PageSize = tx.DB().Info().PageSize
+		res.Pages, res.FreePages = countPages(tx)
+
+		res.Buckets = make(map[string]BucketStats)
+		containers := make(map[cid.ID]*ContainerStats)
+
+		containerStats := func(cnr cid.ID) *ContainerStats {
+			s, ok := containers[cnr]
+			if !ok {
+				s = &ContainerStats{ID: cnr}
+				containers[cnr] = s
+			}
+
+			return s
+		}
+
+		err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			bStats := b.Stats()
+
+			kind, ok := bucketKinds[name[0]]
+			if !ok {
+				kind = "unknown"
+			}
+
+			s := res.Buckets[kind]
+			s.Buckets += bStats.BucketN
+			s.Keys += bStats.KeyN
+			s.Alloc += bStats.BranchAlloc + bStats.LeafAlloc
+			s.InUse += bStats.BranchInuse + bStats.LeafInuse + bStats.InlineBucketInuse
+			res.Buckets[kind] = s
+
+			if len(name) == 1 {
+				return nil
+			}
+
+			var cnr cid.ID
+			if rawID, _ := parseContainerIDWithPrefix(&cnr, name); rawID == nil || len(name) != bucketKeySize {
+				return nil
+			}
+
+			switch name[0] {
+			case primaryPrefix:
+				containerStats(cnr).Regular = uint64(bStats.KeyN)
+			case tombstonePrefix:
+				containerStats(cnr).Tombstone = uint64(bStats.KeyN)
+			case storageGroupPrefix:
+				containerStats(cnr).StorageGroup = uint64(bStats.KeyN)
+			case lockersPrefix:
+				containerStats(cnr).Lock = uint64(bStats.KeyN)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if b := tx.Bucket(graveyardBucketName); b != nil {
+			res.Graveyard = uint64(b.Stats().KeyN)
+		}
+
+		if b := tx.Bucket(garbageBucketName); b != nil {
+			res.Garbage = uint64(b.Stats().KeyN)
+		}
+
+		if b := tx.Bucket(bucketNameLocked); b != nil {
+			err = b.ForEach(func(k, _ []byte) error {
+				if cnrLocked := b.Bucket(k); cnrLocked != nil {
+					res.Locked += uint64(cnrLocked.Stats().KeyN)
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("could not count locked objects: %w", err)
+			}
+		}
+
+		if b := tx.Bucket(containerVolumeBucketName); b != nil {
+			err = b.ForEach(func(k, v []byte) error {
+				var cnr cid.ID
+				if cnr.Decode(k) != nil {
+					return nil
+				}
+
+				size := parseContainerSize(v)
+				if _, ok := containers[cnr]; ok || size > 0 {
+					containerStats(cnr).Size = size
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("could not read container sizes: %w", err)
+			}
+		}
+
+		res.Containers = make([]ContainerStats, 0, len(containers))
+		for _, s := range containers {
+			res.Containers = append(res.Containers, *s)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return res, nil
+}
+
+// countPages returns the total number of pages and the number of free ones,
+// the latter is negative if the free pages are not loaded.
+func countPages(tx *bbolt.Tx) (int, int) {
+	var total, free int
+
+	for id := 0; ; {
+		p, err := tx.Page(id)
+		if errors.Is(err, bbolt.ErrFreePagesNotLoaded) {
+			return int(tx.Size() / int64(tx.DB().Info().PageSize)), -1
+		} else if err != nil || p == nil {
+			break
+		}
+
+		if p.Type == "free" {
+			free++
+			total++
+			id++
+
+			continue
+		}
+
+		total += 1 + p.OverflowCount
+		id += 1 + p.OverflowCount
+	}
+
+	return total, free
+}
@@ -0,0 +1,85 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Stats(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	var regular []*objectSDK.Object
+	for i := 0; i < 3; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, putBig(db, obj))
+
+		regular = append(regular, obj)
+	}
+
+	ts := generateObjectWithCID(t, cnr)
+	ts.SetType(objectSDK.TypeTombstone)
+	require.NoError(t, putBig(db, ts))
+
+	lock := generateObjectWithCID(t, cnr)
+	lock.SetType(objectSDK.TypeLock)
+	require.NoError(t, putBig(db, lock))
+
+	other := generateObject(t)
+	require.NoError(t, putBig(db, other))
+
+	var inhumePrm meta.InhumePrm
+	inhumePrm.SetAddresses(objectcore.AddressOf(regular[0]))
+	inhumePrm.SetTombstoneAddress(objectcore.AddressOf(ts))
+
+	_, err := db.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	inhumePrm.SetAddresses(objectcore.AddressOf(regular[1]))
+	inhumePrm.SetGCMark()
+
+	_, err = db.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Lock(cnr, oidtest.ID(), []oid.ID{oidtest.ID(), oidtest.ID()}))
+
+	st, err := db.Stats()
+	require.NoError(t, err)
+
+	require.EqualValues(t, 6, st.Counters.Phy())
+	require.EqualValues(t, 4, st.Counters.Logic())
+	require.EqualValues(t, 1, st.Graveyard)
+	require.EqualValues(t, 2, st.Garbage) // tombstoned objects are marked too
+	require.EqualValues(t, 2, st.Locked)
+
+	require.Len(t, st.Containers, 2)
+	for _, c := range st.Containers {
+		if c.ID.Equals(cnr) {
+			require.EqualValues(t, 3, c.Regular)
+			require.EqualValues(t, 1, c.Tombstone)
+			require.EqualValues(t, 1, c.Lock)
+			require.Zero(t, c.StorageGroup)
+			require.EqualValues(t, 5, c.Objects())
+		} else {
+			require.Equal(t, objectcore.AddressOf(other).Container(), c.ID)
+			require.EqualValues(t, 1, c.Objects())
+			require.Equal(t, other.PayloadSize(), c.Size)
+		}
+	}
+
+	require.Equal(t, 4, st.Buckets["primary"].Keys)
+	require.Equal(t, 1, st.Buckets["tombstone"].Keys)
+	require.Positive(t, st.Buckets["primary"].InUse)
+	require.Positive(t, st.Size)
+	require.Positive(t, st.PageSize)
+	require.GreaterOrEqual(t, st.FreePages, 0)
+	require.Less(t, st.FreePages, st.Pages)
+}
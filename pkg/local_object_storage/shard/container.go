@@ -39,3 +39,22 @@ func (s *Shard) ContainerSize(prm ContainerSizePrm) (ContainerSizeRes, error) {
 		size: size,
 	}, nil
 }
+
+// ContainerObjects returns the number of available root objects of the
+// container stored in the shard. Requires healthy metabase, returns
+// ErrDegradedMode otherwise.
+func (s *Shard) ContainerObjects(cnr cid.ID) (uint64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	n, err := s.metaBase.ContainerObjects(cnr)
+	if err != nil {
+		return 0, fmt.Errorf("could not count container objects: %w", err)
+	}
+
+	return n, nil
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
@@ -146,6 +145,7 @@ func (s *Shard) Init() error {
 					s.collectExpiredObjects,
 					s.collectExpiredTombstones,
 					s.collectExpiredLocks,
+					s.collectOutdatedObjects,
 				},
 			},
 		},
@@ -172,57 +172,7 @@ func (s *Shard) refillMetabase() error {
 			return nil
 		}
 
-		//nolint: exhaustive
-		switch obj.Type() {
-		case objectSDK.TypeTombstone:
-			tombstone := objectSDK.NewTombstone()
-
-			if err := tombstone.Unmarshal(obj.Payload()); err != nil {
-				return fmt.Errorf("could not unmarshal tombstone content: %w", err)
-			}
-
-			tombAddr := object.AddressOf(obj)
-			memberIDs := tombstone.Members()
-			tombMembers := make([]oid.Address, 0, len(memberIDs))
-
-			for i := range memberIDs {
-				a := tombAddr
-				a.SetObject(memberIDs[i])
-
-				tombMembers = append(tombMembers, a)
-			}
-
-			var inhumePrm meta.InhumePrm
-
-			inhumePrm.SetTombstoneAddress(tombAddr)
-			inhumePrm.SetAddresses(tombMembers...)
-
-			_, err = s.metaBase.Inhume(inhumePrm)
-			if err != nil {
-				return fmt.Errorf("could not inhume objects: %w", err)
-			}
-		case objectSDK.TypeLock:
-			var lock objectSDK.Lock
-			if err := lock.Unmarshal(obj.Payload()); err != nil {
-				return fmt.Errorf("could not unmarshal lock content: %w", err)
-			}
-
-			locked := make([]oid.ID, lock.NumberOfMembers())
-			lock.ReadMembers(locked)
-
-			cnr, _ := obj.ContainerID()
-			id, _ := obj.ID()
-			err = s.metaBase.Lock(cnr, id, locked)
-			if err != nil {
-				return fmt.Errorf("could not lock objects: %w", err)
-			}
-		}
-
-		var mPrm meta.PutPrm
-		mPrm.SetObject(obj)
-		mPrm.SetStorageID(descriptor)
-
-		_, err := s.metaBase.Put(mPrm)
+		err := s.metaBase.IndexObject(obj, descriptor)
 		if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, meta.ErrObjectIsExpired) {
 			return err
 		}
@@ -0,0 +1,415 @@
+package shard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// FsckIssueType is a type of inconsistency found by Fsck operation.
+type FsckIssueType uint8
+
+const (
+	// FsckDanglingRecord is a metabase record which refers to the
+	// missing object.
+	FsckDanglingRecord FsckIssueType = iota
+	// FsckOrphanObject is a stored object which is not indexed in the
+	// metabase or is indexed with another storage ID.
+	FsckOrphanObject
+	// FsckCorruptedObject is a stored object which can't be read or has
+	// invalid verification fields (ID, signature, payload checksum).
+	FsckCorruptedObject
+)
+
+// String implements fmt.Stringer.
+func (t FsckIssueType) String() string {
+	switch t {
+	case FsckDanglingRecord:
+		return "dangling record"
+	case FsckOrphanObject:
+		return "orphan object"
+	case FsckCorruptedObject:
+		return "corrupted object"
+	default:
+		return fmt.Sprintf("unknown issue %d", t)
+	}
+}
+
+// FsckIssue describes an inconsistency found by Fsck operation.
+type FsckIssue struct {
+	// Type of the inconsistency.
+	Type FsckIssueType
+	// Address of the object. Zero if the object can't be decoded.
+	Address oid.Address
+	// StorageID of the object location, nil for the write-cache
+	// or unknown location.
+	StorageID []byte
+	// Err describes the problem or the repair failure in details.
+	Err error
+	// Repaired is set if the inconsistency has been fixed.
+	Repaired bool
+
+	// indexed is set for orphan copies of indexed objects.
+	indexed bool
+	// writeCache is set for objects located in the write-cache.
+	writeCache bool
+}
+
+// FsckPrm groups the parameters of Fsck operation.
+type FsckPrm struct {
+	repair  bool
+	handler func(FsckIssue)
+}
+
+// WithRepair is a Fsck option to fix found inconsistencies: dangling
+// records and corrupted objects are removed, orphan objects are indexed.
+func (p *FsckPrm) WithRepair(repair bool) {
+	p.repair = repair
+}
+
+// WithIssueHandler is a Fsck option to set a callback to be executed on
+// every found inconsistency.
+func (p *FsckPrm) WithIssueHandler(f func(FsckIssue)) {
+	p.handler = f
+}
+
+// FsckRes groups the resulting values of Fsck operation.
+type FsckRes struct {
+	checked   int
+	dangling  int
+	orphan    int
+	corrupted int
+	repaired  int
+}
+
+// Checked returns the number of checked metabase records.
+func (r FsckRes) Checked() int {
+	return r.checked
+}
+
+// DanglingRecords returns the number of found dangling records.
+func (r FsckRes) DanglingRecords() int {
+	return r.dangling
+}
+
+// OrphanObjects returns the number of found orphan objects.
+func (r FsckRes) OrphanObjects() int {
+	return r.orphan
+}
+
+// CorruptedObjects returns the number of found corrupted objects.
+func (r FsckRes) CorruptedObjects() int {
+	return r.corrupted
+}
+
+// Repaired returns the number of fixed inconsistencies.
+func (r FsckRes) Repaired() int {
+	return r.repaired
+}
+
+// Fsck checks consistency of the shard components: every metabase record
+// must refer to the stored object, every stored object must be indexed
+// and must have valid verification fields.
+//
+// Shard must be in read-only mode for checking and in read-write mode for
+// repairing. Before repairing the write-cache is flushed.
+//
+// Returns any error encountered that did not allow to completely check the
+// shard. Repair failures are reported in the issues.
+func (s *Shard) Fsck(prm FsckPrm) (FsckRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	m := s.info.Mode
+	if m.NoMetabase() {
+		return FsckRes{}, ErrDegradedMode
+	} else if prm.repair && m.ReadOnly() {
+		return FsckRes{}, ErrReadOnlyMode
+	} else if !prm.repair && !m.ReadOnly() {
+		return FsckRes{}, ErrMustBeReadOnly
+	}
+
+	if prm.repair && s.hasWriteCache() {
+		err := s.writeCache.Flush(true)
+		if err != nil {
+			return FsckRes{}, fmt.Errorf("could not flush write-cache: %w", err)
+		}
+	}
+
+	var (
+		res    FsckRes
+		issues []FsckIssue
+	)
+
+	err := s.metaBase.IteratePhyObjects(func(addr oid.Address, storageID []byte) error {
+		res.checked++
+
+		obj, err := s.fsckGet(addr, storageID)
+		if err == nil {
+			err = verifyStoredObject(addr, obj)
+		} else if IsErrNotFound(err) {
+			issues = append(issues, FsckIssue{
+				Type:      FsckDanglingRecord,
+				Address:   addr,
+				StorageID: storageID,
+			})
+
+			return nil
+		}
+
+		if err != nil {
+			issues = append(issues, FsckIssue{
+				Type:      FsckCorruptedObject,
+				Address:   addr,
+				StorageID: storageID,
+				Err:       err,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return FsckRes{}, fmt.Errorf("could not iterate over metabase: %w", err)
+	}
+
+	var iterPrm common.IteratePrm
+	iterPrm.IgnoreErrors = true
+	iterPrm.ErrorHandler = func(addr oid.Address, err error) error {
+		var sPrm meta.StorageIDPrm
+		sPrm.SetAddress(addr)
+
+		sRes, sErr := s.metaBase.StorageID(sPrm)
+		if sErr == nil && sRes.StorageID() != nil {
+			// reported in the metabase iteration
+			return nil
+		}
+
+		issues = append(issues, FsckIssue{
+			Type:    FsckCorruptedObject,
+			Address: addr,
+			Err:     err,
+		})
+
+		return nil
+	}
+	iterPrm.Handler = func(elem common.IterationElement) error {
+		var sPrm meta.StorageIDPrm
+		sPrm.SetAddress(elem.Address)
+
+		sRes, err := s.metaBase.StorageID(sPrm)
+		if err != nil {
+			return fmt.Errorf("could not get storage ID of %s from metabase: %w", elem.Address, err)
+		}
+
+		if bytes.Equal(sRes.StorageID(), elem.StorageID) && sRes.StorageID() != nil {
+			// checked in the metabase iteration
+			return nil
+		}
+
+		issue := FsckIssue{
+			Type:      FsckOrphanObject,
+			Address:   elem.Address,
+			StorageID: elem.StorageID,
+			indexed:   sRes.StorageID() != nil,
+		}
+
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(elem.ObjectData); err != nil {
+			issue.Type, issue.Err = FsckCorruptedObject, fmt.Errorf("could not unmarshal object: %w", err)
+		} else if err := verifyStoredObject(elem.Address, obj); err != nil {
+			issue.Type, issue.Err = FsckCorruptedObject, err
+		}
+
+		issues = append(issues, issue)
+
+		return nil
+	}
+
+	_, err = s.blobStor.Iterate(iterPrm)
+	if err != nil {
+		return FsckRes{}, fmt.Errorf("could not iterate over blobstor: %w", err)
+	}
+
+	if !prm.repair && s.hasWriteCache() {
+		var wcPrm writecache.IterationPrm
+		wcPrm.WithIgnoreErrors(true)
+		wcPrm.WithHandler(func(data []byte) error {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(data); err != nil {
+				issues = append(issues, FsckIssue{
+					Type:       FsckCorruptedObject,
+					Err:        fmt.Errorf("could not unmarshal object: %w", err),
+					writeCache: true,
+				})
+
+				return nil
+			}
+
+			addr := object.AddressOf(obj)
+
+			var ePrm meta.ExistsPrm
+			ePrm.SetAddress(addr)
+
+			eRes, err := s.metaBase.Exists(ePrm)
+			if err != nil && !IsErrRemoved(err) && !IsErrObjectExpired(err) {
+				return fmt.Errorf("could not check %s existence in metabase: %w", addr, err)
+			}
+
+			indexed := err != nil || eRes.Exists()
+
+			if err := verifyStoredObject(addr, obj); err != nil {
+				issues = append(issues, FsckIssue{
+					Type:       FsckCorruptedObject,
+					Address:    addr,
+					Err:        err,
+					writeCache: true,
+				})
+			} else if !indexed {
+				issues = append(issues, FsckIssue{
+					Type:       FsckOrphanObject,
+					Address:    addr,
+					writeCache: true,
+				})
+			}
+
+			return nil
+		})
+
+		err = s.writeCache.Iterate(wcPrm)
+		if err != nil {
+			return FsckRes{}, fmt.Errorf("could not iterate over write-cache: %w", err)
+		}
+	}
+
+	for i := range issues {
+		switch issues[i].Type {
+		case FsckDanglingRecord:
+			res.dangling++
+		case FsckOrphanObject:
+			res.orphan++
+		case FsckCorruptedObject:
+			res.corrupted++
+		}
+
+		if prm.repair {
+			err = s.fsckRepair(issues[i])
+			if err != nil {
+				issues[i].Err = fmt.Errorf("could not repair: %w", err)
+			} else {
+				issues[i].Repaired = true
+				res.repaired++
+			}
+		}
+
+		if prm.handler != nil {
+			prm.handler(issues[i])
+		}
+	}
+
+	return res, nil
+}
+
+// fsckGet reads the object from the location pointed by the storage ID.
+func (s *Shard) fsckGet(addr oid.Address, storageID []byte) (*objectSDK.Object, error) {
+	if storageID == nil && s.hasWriteCache() {
+		obj, err := s.writeCache.Get(addr)
+		if err == nil || !IsErrNotFound(err) {
+			return obj, err
+		}
+	}
+
+	var prm common.GetPrm
+	prm.Address = addr
+	prm.StorageID = storageID
+
+	res, err := s.blobStor.Get(prm)
+
+	return res.Object, err
+}
+
+// fsckRepair fixes the found inconsistency.
+func (s *Shard) fsckRepair(issue FsckIssue) error {
+	if issue.writeCache {
+		return errors.New("write-cache objects can't be repaired")
+	}
+
+	switch issue.Type {
+	case FsckDanglingRecord:
+		var prm DeletePrm
+		prm.SetAddresses(issue.Address)
+
+		_, err := s.delete(prm)
+		return err
+	case FsckCorruptedObject:
+		var sPrm meta.StorageIDPrm
+		sPrm.SetAddress(issue.Address)
+
+		sRes, err := s.metaBase.StorageID(sPrm)
+		if err != nil {
+			return fmt.Errorf("could not get storage ID from metabase: %w", err)
+		}
+
+		if issue.StorageID == nil || bytes.Equal(sRes.StorageID(), issue.StorageID) {
+			var prm DeletePrm
+			prm.SetAddresses(issue.Address)
+
+			_, err := s.delete(prm)
+			return err
+		}
+
+		return s.fsckDeleteBlob(issue)
+	case FsckOrphanObject:
+		if issue.indexed {
+			return s.fsckDeleteBlob(issue)
+		}
+
+		var prm common.GetPrm
+		prm.Address = issue.Address
+		prm.StorageID = issue.StorageID
+
+		res, err := s.blobStor.Get(prm)
+		if err != nil {
+			return fmt.Errorf("could not read object: %w", err)
+		}
+
+		err = s.metaBase.IndexObject(res.Object, issue.StorageID)
+		if meta.IsErrRemoved(err) || errors.Is(err, meta.ErrObjectIsExpired) {
+			return s.fsckDeleteBlob(issue)
+		} else if err != nil {
+			return fmt.Errorf("could not put object to metabase: %w", err)
+		}
+
+		s.incObjectCounter()
+		s.addToContainerSize(issue.Address.Container().EncodeToString(), int64(res.Object.PayloadSize()))
+
+		return nil
+	default:
+		return fmt.Errorf("unexpected issue type %d", issue.Type)
+	}
+}
+
+func (s *Shard) fsckDeleteBlob(issue FsckIssue) error {
+	var prm common.DeletePrm
+	prm.Address = issue.Address
+	prm.StorageID = issue.StorageID
+
+	_, err := s.blobStor.Delete(prm)
+	return err
+}
+
+// verifyStoredObject checks that the object is stored by its own address
+// and has valid verification fields.
+func verifyStoredObject(addr oid.Address, obj *objectSDK.Object) error {
+	if objAddr := object.AddressOf(obj); objAddr != addr {
+		return fmt.Errorf("object %s is stored by the wrong address", objAddr)
+	}
+
+	return obj.CheckVerificationFields()
+}
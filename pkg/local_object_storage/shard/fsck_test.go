@@ -0,0 +1,156 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_Fsck(t *testing.T) {
+	rootPath := t.TempDir()
+	blobPath := filepath.Join(rootPath, "blob")
+
+	sh := newCustomShard(t, rootPath, false, nil, []blobstor.Option{
+		blobstor.WithLogger(zaptest.NewLogger(t)),
+		blobstor.WithStorages([]blobstor.SubStorage{
+			{Storage: fstree.New(fstree.WithPath(blobPath))},
+		}),
+	})
+	defer releaseShard(sh, t)
+
+	// second instance of the same FSTree to break the shard consistency
+	fsTree := fstree.New(fstree.WithPath(blobPath))
+	require.NoError(t, fsTree.Open(false))
+	require.NoError(t, fsTree.Init())
+
+	signer := test.RandomSignerRFC6979(t)
+	cnr := cidtest.ID()
+
+	newObject := func() *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, obj.SetVerificationFields(signer))
+		return obj
+	}
+
+	put := func(obj *objectSDK.Object) {
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	putRaw := func(addr oid.Address, obj *objectSDK.Object) {
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		_, err = fsTree.Put(common.PutPrm{Address: addr, RawData: data})
+		require.NoError(t, err)
+	}
+
+	good := newObject()
+	put(good)
+
+	var err error
+
+	dangling := newObject()
+	put(dangling)
+
+	_, err = fsTree.Delete(common.DeletePrm{Address: object.AddressOf(dangling)})
+	require.NoError(t, err)
+
+	orphan := newObject()
+	putRaw(object.AddressOf(orphan), orphan)
+
+	corrupted := newObject()
+	put(corrupted)
+
+	data, err := corrupted.Marshal()
+	require.NoError(t, err)
+
+	tampered := objectSDK.New()
+	require.NoError(t, tampered.Unmarshal(data))
+	tampered.SetPayload([]byte("tampered payload"))
+
+	_, err = fsTree.Delete(common.DeletePrm{Address: object.AddressOf(corrupted)})
+	require.NoError(t, err)
+	putRaw(object.AddressOf(corrupted), tampered)
+
+	fsck := func(repair bool) (shard.FsckRes, map[oid.Address]shard.FsckIssue) {
+		issues := make(map[oid.Address]shard.FsckIssue)
+
+		var prm shard.FsckPrm
+		prm.WithRepair(repair)
+		prm.WithIssueHandler(func(issue shard.FsckIssue) {
+			issues[issue.Address] = issue
+		})
+
+		res, err := sh.Fsck(prm)
+		require.NoError(t, err)
+
+		return res, issues
+	}
+
+	t.Run("check requires read-only mode", func(t *testing.T) {
+		_, err := sh.Fsck(shard.FsckPrm{})
+		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
+	})
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	t.Run("repair requires read-write mode", func(t *testing.T) {
+		var prm shard.FsckPrm
+		prm.WithRepair(true)
+
+		_, err := sh.Fsck(prm)
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	})
+
+	res, issues := fsck(false)
+	require.Equal(t, 3, res.Checked())
+	require.Equal(t, 1, res.DanglingRecords())
+	require.Equal(t, 1, res.OrphanObjects())
+	require.Equal(t, 1, res.CorruptedObjects())
+	require.Equal(t, 0, res.Repaired())
+	require.Len(t, issues, 3)
+
+	require.Equal(t, shard.FsckDanglingRecord, issues[object.AddressOf(dangling)].Type)
+	require.Equal(t, shard.FsckOrphanObject, issues[object.AddressOf(orphan)].Type)
+	require.Equal(t, shard.FsckCorruptedObject, issues[object.AddressOf(corrupted)].Type)
+	require.Error(t, issues[object.AddressOf(corrupted)].Err)
+
+	require.NoError(t, sh.SetMode(mode.ReadWrite))
+
+	res, issues = fsck(true)
+	require.Equal(t, 3, res.Repaired())
+
+	for _, issue := range issues {
+		require.True(t, issue.Repaired, issue.Type)
+	}
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	res, issues = fsck(false)
+	require.Equal(t, 2, res.Checked())
+	require.Empty(t, issues)
+
+	for _, obj := range []*objectSDK.Object{good, orphan} {
+		var prm shard.GetPrm
+		prm.SetAddress(object.AddressOf(obj))
+
+		_, err := sh.Get(prm)
+		require.NoError(t, err)
+	}
+}
@@ -2,12 +2,16 @@ package shard
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"sync"
 	"time"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -22,6 +26,22 @@ type TombstoneSource interface {
 	IsTombstoneAvailable(ctx context.Context, addr oid.Address, epoch uint64) bool
 }
 
+// LifecycleRulesSource is an interface that provides
+// lifecycle rules of the containers.
+type LifecycleRulesSource interface {
+	// LifecycleRules must return lifecycle rules of the container.
+	// Empty result means that the container has no rules.
+	LifecycleRules(cid.ID) (container.LifecycleRules, error)
+}
+
+// ObjectVersions is an interface that provides the versions of
+// the objects in the NeoFS network.
+type ObjectVersions interface {
+	// Versions must return creation epochs of the container objects having
+	// the attribute with the given value.
+	Versions(ctx context.Context, cnr cid.ID, attr, value string) ([]uint64, error)
+}
+
 // Event represents class of external events.
 type Event interface {
 	typ() eventType
@@ -348,6 +368,299 @@ func (s *Shard) collectExpiredLocks(ctx context.Context, e Event) {
 	s.expiredLocksCallback(ctx, expired)
 }
 
+// lifecycleState tracks the epochs the containers need to be checked against
+// their lifecycle rules at. Containers missing in the state are checked at
+// the nearest epoch.
+type lifecycleState struct {
+	mtx  sync.Mutex
+	next map[cid.ID]uint64
+}
+
+// due checks whether the container needs to be checked at the given epoch.
+func (x *lifecycleState) due(cnr cid.ID, epoch uint64) bool {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	next, ok := x.next[cnr]
+
+	return !ok || next <= epoch
+}
+
+// set schedules the next check of the container. Zero epoch means that
+// none of the container objects is ever expired unless new objects arrive.
+func (x *lifecycleState) set(cnr cid.ID, next uint64) {
+	if next == 0 {
+		next = math.MaxUint64
+	}
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.next == nil {
+		x.next = make(map[cid.ID]uint64)
+	}
+
+	x.next[cnr] = next
+}
+
+// reset makes the container to be checked at the nearest epoch.
+func (x *lifecycleState) reset(cnr cid.ID) {
+	x.mtx.Lock()
+	delete(x.next, cnr)
+	x.mtx.Unlock()
+}
+
+// retain forgets the containers missing in the list.
+func (x *lifecycleState) retain(cnrs []cid.ID) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if len(x.next) == 0 {
+		return
+	}
+
+	keep := make(map[cid.ID]struct{}, len(cnrs))
+	for i := range cnrs {
+		keep[cnrs[i]] = struct{}{}
+	}
+
+	for cnr := range x.next {
+		if _, ok := keep[cnr]; !ok {
+			delete(x.next, cnr)
+		}
+	}
+}
+
+// collectOutdatedObjects marks as garbage objects expired according
+// to the lifecycle rules of their containers and outdated versions of
+// the objects. Containers are skipped until their objects are expected
+// to expire or new objects arrive. Containers limiting object versions
+// are checked every epoch since new versions may arrive to other nodes.
+func (s *Shard) collectOutdatedObjects(ctx context.Context, e Event) {
+	if s.lifecycleSource == nil {
+		return
+	}
+
+	epoch := e.(newEpoch).epoch
+	log := s.log.With(zap.Uint64("epoch", epoch))
+
+	s.m.RLock()
+
+	if s.info.Mode.NoMetabase() {
+		s.m.RUnlock()
+		return
+	}
+
+	cnrs, err := s.metaBase.Containers()
+
+	s.m.RUnlock()
+
+	if err != nil {
+		log.Warn("could not list containers for lifecycle rules", zap.Error(err))
+		return
+	}
+
+	s.lifecycle.retain(cnrs)
+
+	for _, cnr := range cnrs {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if !s.lifecycle.due(cnr, epoch) {
+			continue
+		}
+
+		rules, err := s.lifecycleSource.LifecycleRules(cnr)
+		if err != nil {
+			log.Debug("could not get container lifecycle rules",
+				zap.Stringer("cid", cnr),
+				zap.Error(err),
+			)
+
+			continue
+		} else if len(rules) == 0 {
+			// container attributes are immutable
+			s.lifecycle.set(cnr, 0)
+			continue
+		}
+
+		next, err := s.collectOutdatedInContainer(ctx, cnr, rules, epoch)
+		if err != nil {
+			log.Warn("could not collect objects expired by lifecycle rules",
+				zap.Stringer("cid", cnr),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		if s.objectVersions != nil && rules.LimitVersions() {
+			next = epoch + 1
+		}
+
+		s.lifecycle.set(cnr, next)
+	}
+}
+
+// collectOutdatedInContainer marks as garbage container objects expired
+// according to the rules and outdated object versions in batches and returns
+// the next epoch any of the remaining objects is expired at.
+func (s *Shard) collectOutdatedInContainer(ctx context.Context, cnr cid.ID, rules container.LifecycleRules, epoch uint64) (uint64, error) {
+	var (
+		prm  meta.LifecycleExpiredPrm
+		next uint64
+		// network versions by the attribute and value
+		versions = make(map[versionsKey]versionsResult)
+	)
+
+	prm.SetContainer(cnr)
+	prm.SetRules(rules)
+	prm.SetEpoch(epoch)
+	prm.SetCount(uint32(s.rmBatchSize))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+		}
+
+		res, err := s.listLifecycleExpired(prm)
+		if err != nil {
+			return 0, err
+		}
+
+		if e := res.NextExpiration(); e != 0 && (next == 0 || e < next) {
+			next = e
+		}
+
+		if addrs := res.AddressList(); len(addrs) > 0 {
+			s.log.Debug("marking objects expired by lifecycle rules as garbage",
+				zap.Stringer("cid", cnr),
+				zap.Int("number", len(addrs)),
+			)
+
+			err = s.inhumeOutdatedObjects(addrs)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if addrs := s.outdatedVersions(ctx, cnr, rules, res.Versions(), versions); len(addrs) > 0 {
+			s.log.Debug("marking outdated object versions as garbage",
+				zap.Stringer("cid", cnr),
+				zap.Int("number", len(addrs)),
+			)
+
+			err = s.inhumeOutdatedObjects(addrs)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if res.Cursor() == nil {
+			return next, nil
+		}
+
+		prm.SetCursor(res.Cursor())
+	}
+}
+
+type versionsKey struct {
+	attr, value string
+}
+
+type versionsResult struct {
+	epochs []uint64
+	err    error
+}
+
+// outdatedVersions returns addresses of the objects having at least the
+// allowed number of newer versions in the network. Objects are kept if the
+// versions can not be read. Network versions are cached in the given map.
+func (s *Shard) outdatedVersions(ctx context.Context, cnr cid.ID, rules container.LifecycleRules, versions []meta.LifecycleVersion, cache map[versionsKey]versionsResult) []oid.Address {
+	if s.objectVersions == nil {
+		return nil
+	}
+
+	var res []oid.Address
+
+	for _, v := range versions {
+		rule := rules[v.Rule]
+		key := versionsKey{attr: rule.Attribute, value: v.Value}
+
+		nv, ok := cache[key]
+		if !ok {
+			nv.epochs, nv.err = s.objectVersions.Versions(ctx, cnr, rule.Attribute, v.Value)
+			if nv.err != nil {
+				s.log.Debug("could not read object versions",
+					zap.Stringer("cid", cnr),
+					zap.String("attribute", rule.Attribute),
+					zap.String("value", v.Value),
+					zap.Error(nv.err),
+				)
+			}
+
+			cache[key] = nv
+		}
+
+		if nv.err != nil {
+			continue
+		}
+
+		var newer uint64
+
+		for _, e := range nv.epochs {
+			if e > v.Epoch {
+				newer++
+			}
+		}
+
+		if newer >= rule.Versions {
+			res = append(res, v.Addresses...)
+		}
+	}
+
+	return res
+}
+
+func (s *Shard) listLifecycleExpired(prm meta.LifecycleExpiredPrm) (meta.LifecycleExpiredRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return meta.LifecycleExpiredRes{}, ErrDegradedMode
+	}
+
+	return s.metaBase.ListLifecycleExpired(prm)
+}
+
+func (s *Shard) inhumeOutdatedObjects(addrs []oid.Address) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	var inhumePrm meta.InhumePrm
+
+	inhumePrm.SetAddresses(addrs...)
+	inhumePrm.SetGCMark()
+
+	res, err := s.metaBase.Inhume(inhumePrm)
+	if err != nil {
+		return fmt.Errorf("could not inhume the objects: %w", err)
+	}
+
+	s.decObjectCounterBy(logical, res.AvailableInhumed())
+
+	return nil
+}
+
 func (s *Shard) getExpiredObjects(ctx context.Context, epoch uint64, typeCond func(object.Type) bool) ([]oid.Address, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
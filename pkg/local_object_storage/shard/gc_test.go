@@ -2,11 +2,14 @@ package shard_test
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	containerCore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
@@ -14,6 +17,7 @@ import (
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -117,3 +121,238 @@ func TestGC_ExpiredObjectWithExpiredLock(t *testing.T) {
 		return shard.IsErrNotFound(err)
 	}, 3*time.Second, 1*time.Second, "lock expiration should free object removal")
 }
+
+type lifecycleRulesSource containerCore.LifecycleRules
+
+func (x lifecycleRulesSource) LifecycleRules(cid.ID) (containerCore.LifecycleRules, error) {
+	return containerCore.LifecycleRules(x), nil
+}
+
+func TestGC_LifecycleRules(t *testing.T) {
+	rootPath := t.TempDir()
+
+	sh := shard.New(
+		shard.WithLogger(zap.NewNop()),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: fstree.New(
+						fstree.WithPath(filepath.Join(rootPath, "blob"))),
+				},
+			}),
+		),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(rootPath, "meta")),
+			meta.WithEpochState(epochState{}),
+		),
+		shard.WithLifecycleRulesSource(lifecycleRulesSource{
+			{Attribute: "Type", Prefix: "tmp", ExpireAfter: 2},
+		}),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+
+			return pool
+		}),
+	)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+
+	t.Cleanup(func() {
+		releaseShard(sh, t)
+	})
+
+	cnr := cidtest.ID()
+
+	newObject := func(creationEpoch uint64, typ string) *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(creationEpoch)
+		addAttribute(obj, "Type", typ)
+
+		return obj
+	}
+
+	var putPrm shard.PutPrm
+	var getPrm shard.GetPrm
+
+	put := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			putPrm.SetObject(obj)
+
+			_, err := sh.Put(putPrm)
+			require.NoError(t, err)
+		}
+	}
+
+	requireRemoved := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			getPrm.SetAddress(objectCore.AddressOf(obj))
+			require.Eventually(t, func() bool {
+				_, err := sh.Get(getPrm)
+				return shard.IsErrNotFound(err)
+			}, 3*time.Second, 100*time.Millisecond, "expired object should be removed")
+		}
+	}
+
+	requireAvailable := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			getPrm.SetAddress(objectCore.AddressOf(obj))
+			_, err := sh.Get(getPrm)
+			require.NoError(t, err)
+		}
+	}
+
+	var (
+		expired = newObject(1, "tmp/cache")
+		fresh   = newObject(4, "tmp/cache")
+		other   = newObject(1, "image")
+	)
+
+	put(expired, fresh, other)
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(5)
+
+	requireRemoved(expired)
+	requireAvailable(fresh, other)
+
+	// object with old creation epoch arrives (e.g. replicated) before
+	// the scheduled check of the container
+	late := newObject(1, "tmp/cache")
+	put(late)
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(6)
+
+	requireRemoved(late)
+	requireAvailable(fresh, other)
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(7)
+
+	requireRemoved(fresh)
+	requireAvailable(other)
+}
+
+// networkVersions provides creation epochs of the objects stored in the
+// network.
+type networkVersions struct {
+	mtx    sync.Mutex
+	epochs map[string][]uint64
+}
+
+func (x *networkVersions) add(value string, epoch uint64) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.epochs[value] = append(x.epochs[value], epoch)
+}
+
+func (x *networkVersions) Versions(_ context.Context, _ cid.ID, _, value string) ([]uint64, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if value == "unavailable" {
+		return nil, errors.New("any error")
+	}
+
+	return append([]uint64(nil), x.epochs[value]...), nil
+}
+
+func TestGC_LifecycleVersions(t *testing.T) {
+	rootPath := t.TempDir()
+	versions := &networkVersions{epochs: make(map[string][]uint64)}
+
+	sh := shard.New(
+		shard.WithLogger(zap.NewNop()),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: fstree.New(
+						fstree.WithPath(filepath.Join(rootPath, "blob"))),
+				},
+			}),
+		),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(rootPath, "meta")),
+			meta.WithEpochState(epochState{}),
+		),
+		shard.WithLifecycleRulesSource(lifecycleRulesSource{
+			{Attribute: "FilePath", Versions: 1},
+		}),
+		shard.WithObjectVersions(versions),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+
+			return pool
+		}),
+	)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+
+	t.Cleanup(func() {
+		releaseShard(sh, t)
+	})
+
+	cnr := cidtest.ID()
+
+	newObject := func(creationEpoch uint64, path string) *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(creationEpoch)
+		addAttribute(obj, "FilePath", path)
+
+		versions.add(path, creationEpoch)
+
+		return obj
+	}
+
+	var putPrm shard.PutPrm
+	var getPrm shard.GetPrm
+
+	requireRemoved := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			getPrm.SetAddress(objectCore.AddressOf(obj))
+			require.Eventually(t, func() bool {
+				_, err := sh.Get(getPrm)
+				return shard.IsErrNotFound(err)
+			}, 3*time.Second, 100*time.Millisecond, "outdated object should be removed")
+		}
+	}
+
+	requireAvailable := func(objs ...*objectSDK.Object) {
+		for _, obj := range objs {
+			getPrm.SetAddress(objectCore.AddressOf(obj))
+			_, err := sh.Get(getPrm)
+			require.NoError(t, err)
+		}
+	}
+
+	var (
+		v1          = newObject(1, "file")
+		v2          = newObject(3, "file")
+		v3          = newObject(5, "file")
+		unavailable = newObject(1, "unavailable")
+		other       = newObject(1, "other")
+	)
+
+	// newer version is stored on other nodes only
+	_ = newObject(2, "other")
+
+	for _, obj := range []*objectSDK.Object{v1, v2, v3, unavailable, other} {
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(6)
+
+	requireRemoved(v1, v2, other)
+	requireAvailable(v3, unavailable)
+
+	// new version arrives to other nodes, container is checked anyway
+	_ = newObject(6, "file")
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(7)
+
+	requireRemoved(v3)
+	requireAvailable(unavailable)
+}
@@ -1,6 +1,7 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
@@ -8,9 +9,11 @@ import (
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +23,8 @@ type storFetcher = func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object,
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
+	ctx context.Context
+
 	addr     oid.Address
 	skipMeta bool
 }
@@ -53,6 +58,11 @@ func (r GetRes) HasMeta() bool {
 	return r.hasMeta
 }
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *GetPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Get reads an object from shard.
 //
 // Returns any error encountered that
@@ -61,11 +71,16 @@ func (r GetRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(prm GetPrm) (GetRes, error) {
+func (s *Shard) Get(prm GetPrm) (res GetRes, err error) {
+	span := s.startSpan(prm.ctx, "Get", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
 	cb := func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error) {
+		span.AddEvent("fetch from blobstor")
+
 		var getPrm common.GetPrm
 		getPrm.Address = prm.addr
 		getPrm.StorageID = id
@@ -79,6 +94,8 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 	}
 
 	wc := func(c writecache.Cache) (*objectSDK.Object, error) {
+		span.AddEvent("fetch from write-cache")
+
 		return c.Get(prm.addr)
 	}
 
@@ -1,13 +1,19 @@
 package shard
 
 import (
+	"context"
+
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
+	ctx context.Context
+
 	addr oid.Address
 	raw  bool
 }
@@ -36,6 +42,11 @@ func (r HeadRes) Object() *objectSDK.Object {
 	return r.obj
 }
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *HeadPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Head reads header of the object from the shard.
 //
 // Returns any error encountered.
@@ -43,9 +54,11 @@ func (r HeadRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
+func (s *Shard) Head(prm HeadPrm) (res HeadRes, err error) {
+	span := s.startSpan(prm.ctx, "Head", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var obj *objectSDK.Object
-	var err error
 	if s.GetMode().NoMetabase() {
 		var getPrm GetPrm
 		getPrm.SetAddress(prm.addr)
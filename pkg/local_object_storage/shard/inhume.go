@@ -6,12 +6,16 @@ import (
 	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // InhumePrm encapsulates parameters for inhume operation.
 type InhumePrm struct {
+	ctx context.Context
+
 	target       []oid.Address
 	tombstone    *oid.Address
 	forceRemoval bool
@@ -53,6 +57,11 @@ func (p *InhumePrm) ForceRemoval() {
 // performed on lock object, and it is not a forced object removal.
 var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *InhumePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Inhume calls metabase. Inhume method to mark object as removed. It won't be
 // removed physically from blobStor and metabase until `Delete` operation.
 //
@@ -60,7 +69,10 @@ var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
 // if at least one object is locked.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
+func (s *Shard) Inhume(prm InhumePrm) (_ InhumeRes, err error) {
+	span := s.startSpan(prm.ctx, "Inhume", attribute.Int("count", len(prm.target)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s.m.RLock()
 
 	if s.info.Mode.ReadOnly() {
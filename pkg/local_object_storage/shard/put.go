@@ -1,17 +1,22 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
+	ctx context.Context
+
 	obj *object.Object
 }
 
@@ -23,13 +28,21 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 	p.obj = obj
 }
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *PutPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Put saves the object in shard.
 //
 // Returns any error encountered that
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(prm PutPrm) (PutRes, error) {
+func (s *Shard) Put(prm PutPrm) (_ PutRes, err error) {
+	span := s.startSpan(prm.ctx, "Put", attribute.String("address", objectCore.AddressOf(prm.obj).EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -80,6 +93,10 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 
 		s.incObjectCounter()
 		s.addToContainerSize(putPrm.Address.Container().EncodeToString(), int64(prm.obj.PayloadSize()))
+
+		if s.lifecycleSource != nil {
+			s.lifecycle.reset(putPrm.Address.Container())
+		}
 	}
 
 	return PutRes{}, nil
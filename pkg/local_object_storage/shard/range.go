@@ -1,17 +1,23 @@
 package shard
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // RngPrm groups the parameters of GetRange operation.
 type RngPrm struct {
+	ctx context.Context
+
 	ln uint64
 
 	off uint64
@@ -57,6 +63,11 @@ func (r RngRes) HasMeta() bool {
 	return r.hasMeta
 }
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *RngPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // GetRange reads part of an object from shard.
 //
 // Returns any error encountered that
@@ -66,7 +77,10 @@ func (r RngRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
+func (s *Shard) GetRange(prm RngPrm) (res RngRes, err error) {
+	span := s.startSpan(prm.ctx, "GetRange", attribute.String("address", prm.addr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -1,16 +1,21 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
+	ctx context.Context
+
 	cnr     cid.ID
 	filters object.SearchFilters
 }
@@ -35,11 +40,19 @@ func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// SetContext sets context of the operation. It is used for tracing only.
+func (p *SelectPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
 // did not allow to completely select the objects.
-func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
+func (s *Shard) Select(prm SelectPrm) (res SelectRes, err error) {
+	span := s.startSpan(prm.ctx, "Select", attribute.String("container", prm.cnr.EncodeToString()))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -11,7 +11,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -30,6 +33,8 @@ type Shard struct {
 	metaBase *meta.DB
 
 	tsSource TombstoneSource
+
+	lifecycle *lifecycleState
 }
 
 // Option represents Shard's constructor option.
@@ -102,6 +107,10 @@ type cfg struct {
 
 	tsSource TombstoneSource
 
+	lifecycleSource LifecycleRulesSource
+
+	objectVersions ObjectVersions
+
 	metricsWriter MetricsWriter
 
 	reportErrorFunc func(selfID string, message string, err error)
@@ -128,10 +137,11 @@ func New(opts ...Option) *Shard {
 	mb := meta.New(c.metaOpts...)
 
 	s := &Shard{
-		cfg:      c,
-		blobStor: bs,
-		metaBase: mb,
-		tsSource: c.tsSource,
+		cfg:       c,
+		blobStor:  bs,
+		metaBase:  mb,
+		tsSource:  c.tsSource,
+		lifecycle: new(lifecycleState),
 	}
 
 	reportFunc := func(msg string, err error) {
@@ -280,6 +290,25 @@ func WithTombstoneSource(v TombstoneSource) Option {
 	}
 }
 
+// WithLifecycleRulesSource returns option to set source of the container
+// lifecycle rules. Objects expired according to the rules are collected
+// by GC. Objects are not checked against the rules if the source is not set.
+func WithLifecycleRulesSource(v LifecycleRulesSource) Option {
+	return func(c *cfg) {
+		c.lifecycleSource = v
+	}
+}
+
+// WithObjectVersions returns option to set source of the object versions in
+// the network. Outdated versions of the objects limited by the container
+// lifecycle rules are collected by GC. Versions limits are ignored if the
+// source is not set.
+func WithObjectVersions(v ObjectVersions) Option {
+	return func(c *cfg) {
+		c.objectVersions = v
+	}
+}
+
 // WithDeletedLockCallback returns option to specify callback
 // of the deleted LOCK objects handler.
 func WithDeletedLockCallback(v DeletedLockCallback) Option {
@@ -392,3 +421,15 @@ func (s *Shard) addToPayloadCounter(size int64) {
 		s.cfg.metricsWriter.AddToPayloadSize(size)
 	}
 }
+
+// startSpan starts span of the shard operation with the given name.
+func (s *Shard) startSpan(ctx context.Context, op string, attrs ...attribute.KeyValue) trace.Span {
+	var id string
+	if s.info.ID != nil {
+		id = s.info.ID.String()
+	}
+
+	_, span := tracing.StartSpan(ctx, "Shard."+op, append(attrs, attribute.String("shard_id", id))...)
+
+	return span
+}
@@ -8,6 +8,8 @@ const innerRingNameSpace = "neofs_ir"
 
 // InnerRingServiceMetrics contains metrics collected by inner ring.
 type InnerRingServiceMetrics struct {
+	tlsMetrics
+	rpcMetrics
 	epoch prometheus.Gauge
 }
 
@@ -23,8 +25,16 @@ func NewInnerRingMetrics(version string) InnerRingServiceMetrics {
 	})
 	prometheus.MustRegister(epoch)
 
+	tls := newTLSMetrics(innerRingNameSpace)
+	tls.register()
+
+	rpc := newRPCMetrics(innerRingNameSpace)
+	rpc.register()
+
 	return InnerRingServiceMetrics{
-		epoch: epoch,
+		tlsMetrics: tls,
+		rpcMetrics: rpc,
+		epoch:      epoch,
 	}
 }
 
@@ -10,6 +10,8 @@ type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
 	stateMetrics
+	tlsMetrics
+	rpcMetrics
 	epoch prometheus.Gauge
 }
 
@@ -25,6 +27,12 @@ func NewNodeMetrics(version string) *NodeMetrics {
 	state := newStateMetrics()
 	state.register()
 
+	tls := newTLSMetrics(storageNodeNameSpace)
+	tls.register()
+
+	rpc := newRPCMetrics(storageNodeNameSpace)
+	rpc.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: storageNodeNameSpace,
 		Subsystem: stateSubsystem,
@@ -37,6 +45,7 @@ func NewNodeMetrics(version string) *NodeMetrics {
 		objectServiceMetrics: objectService,
 		engineMetrics:        engine,
 		stateMetrics:         state,
+		tlsMetrics:           tls,
 		epoch:                epoch,
 	}
 }
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const rpcSubsystem = "rpc"
+
+type rpcMetrics struct {
+	currentEndpoint *prometheus.GaugeVec
+	endpointHealthy *prometheus.GaugeVec
+	endpointHeight  *prometheus.GaugeVec
+	endpointLatency *prometheus.GaugeVec
+}
+
+func newRPCMetrics(namespace string) rpcMetrics {
+	labels := []string{"chain", "endpoint"}
+
+	return rpcMetrics{
+		currentEndpoint: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: rpcSubsystem,
+			Name:      "current_endpoint",
+			Help:      "Currently used RPC endpoint of the blockchain, always 1.",
+		}, labels),
+		endpointHealthy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: rpcSubsystem,
+			Name:      "endpoint_healthy",
+			Help:      "Whether the RPC endpoint passed the latest health check.",
+		}, labels),
+		endpointHeight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: rpcSubsystem,
+			Name:      "endpoint_block_height",
+			Help:      "Block height reported by the RPC endpoint.",
+		}, labels),
+		endpointLatency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: rpcSubsystem,
+			Name:      "endpoint_latency_seconds",
+			Help:      "Smoothed latency of the RPC endpoint health checks.",
+		}, labels),
+	}
+}
+
+func (m rpcMetrics) register() {
+	prometheus.MustRegister(m.currentEndpoint)
+	prometheus.MustRegister(m.endpointHealthy)
+	prometheus.MustRegister(m.endpointHeight)
+	prometheus.MustRegister(m.endpointLatency)
+}
+
+// RPCMetrics returns collector of the RPC endpoints metrics of the given
+// blockchain.
+func (m rpcMetrics) RPCMetrics(chain string) ChainRPCMetrics {
+	return ChainRPCMetrics{
+		rpcMetrics: m,
+		chain:      chain,
+	}
+}
+
+// ChainRPCMetrics collects RPC endpoints metrics of the particular blockchain.
+type ChainRPCMetrics struct {
+	rpcMetrics rpcMetrics
+	chain      string
+}
+
+// SetRPCEndpoint updates currently used RPC endpoint.
+func (m ChainRPCMetrics) SetRPCEndpoint(endpoint string) {
+	m.rpcMetrics.currentEndpoint.DeletePartialMatch(prometheus.Labels{"chain": m.chain})
+	m.rpcMetrics.currentEndpoint.WithLabelValues(m.chain, endpoint).Set(1)
+}
+
+// SetRPCEndpointHealth updates health state of the RPC endpoint.
+func (m ChainRPCMetrics) SetRPCEndpointHealth(endpoint string, healthy bool, height uint32, latency time.Duration) {
+	var v float64
+	if healthy {
+		v = 1
+	}
+
+	m.rpcMetrics.endpointHealthy.WithLabelValues(m.chain, endpoint).Set(v)
+	m.rpcMetrics.endpointHeight.WithLabelValues(m.chain, endpoint).Set(float64(height))
+	m.rpcMetrics.endpointLatency.WithLabelValues(m.chain, endpoint).Set(latency.Seconds())
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const tlsSubsystem = "tls"
+
+type tlsMetrics struct {
+	certificateExpiry *prometheus.GaugeVec
+}
+
+func newTLSMetrics(namespace string) tlsMetrics {
+	return tlsMetrics{
+		certificateExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: tlsSubsystem,
+			Name:      "certificate_expiry_timestamp_seconds",
+			Help:      "Expiration time of the TLS certificate served on the gRPC endpoint as Unix time.",
+		}, []string{"endpoint"}),
+	}
+}
+
+func (m tlsMetrics) register() {
+	prometheus.MustRegister(m.certificateExpiry)
+}
+
+// SetCertificateExpiry updates expiration time of the TLS certificate served
+// on the given endpoint.
+func (m tlsMetrics) SetCertificateExpiry(endpoint string, notAfter time.Time) {
+	m.certificateExpiry.WithLabelValues(endpoint).Set(float64(notAfter.Unix()))
+}
+
+// DeleteCertificateExpiry removes expiration time of the TLS certificate
+// served on the endpoint which is not served anymore.
+func (m tlsMetrics) DeleteCertificateExpiry(endpoint string) {
+	m.certificateExpiry.DeleteLabelValues(endpoint)
+}
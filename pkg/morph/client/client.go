@@ -10,10 +10,12 @@ import (
 
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/nspcc-dev/neo-go/pkg/config"
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
 	"github.com/nspcc-dev/neo-go/pkg/core/native/noderoles"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
@@ -62,8 +64,12 @@ type Client struct {
 	cfg cfg
 
 	endpoints []string
+	// currently used element of endpoints
+	endpoint string
 
-	// switchLock protects endpoints, inactive, and subscription-related fields.
+	health *healthMonitor
+
+	// switchLock protects endpoints, endpoint, inactive, and subscription-related fields.
 	// It is taken exclusively during endpoint switch and locked in shared mode
 	// on every normal call.
 	switchLock *sync.RWMutex
@@ -192,6 +198,29 @@ func (c *Client) TestInvoke(contract util.Uint160, method string, args ...any) (
 	return val.Stack, nil
 }
 
+// TestInvokeScript performs test invocation of the given script. It allows
+// to read results of several contract calls at once. Returns the resulting
+// stack, one item per call in the order of the calls.
+func (c *Client) TestInvokeScript(script []byte) (res []stackitem.Item, err error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	val, err := c.rpcActor.Run(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if val.State != HaltState {
+		return nil, &notHaltStateError{state: val.State, exception: val.FaultException}
+	}
+
+	return val.Stack, nil
+}
+
 // TestInvokeIterator is the same [Client.TestInvoke] but expands an iterator placed
 // on the stack. Returned values are the values an iterator provides.
 func (c *Client) TestInvokeIterator(contract util.Uint160, method string, args ...any) (res []stackitem.Item, err error) {
@@ -412,6 +441,32 @@ func (c *Client) BlockCount() (res uint32, err error) {
 	return c.rpcActor.GetBlockCount()
 }
 
+// BlockByIndex returns block with the given index from the network to which
+// the underlying RPC node client is connected.
+func (c *Client) BlockByIndex(index uint32) (*block.Block, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return c.client.GetBlockByIndex(index)
+}
+
+// ApplicationLog returns execution results of the transaction or block (with
+// all triggers) with the given hash.
+func (c *Client) ApplicationLog(h util.Uint256) (*result.ApplicationLog, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return nil, ErrConnectionLost
+	}
+
+	return c.client.GetApplicationLog(h, nil)
+}
+
 // MsPerBlock returns MillisecondsPerBlock network parameter.
 func (c *Client) MsPerBlock() (res int64, err error) {
 	c.switchLock.RLock()
@@ -45,11 +45,19 @@ type cfg struct {
 
 	reconnectionRetries int
 	reconnectionDelay   time.Duration
+
+	healthCheckInterval time.Duration
+	healthThresholds    healthThresholds
+
+	metrics Metrics
 }
 
 const (
 	defaultDialTimeout  = 5 * time.Second
 	defaultWaitInterval = 500 * time.Millisecond
+
+	defaultMaxBlockLag  = 10
+	defaultMaxErrorRate = 0.5
 )
 
 func defaultConfig() *cfg {
@@ -63,6 +71,10 @@ func defaultConfig() *cfg {
 		},
 		reconnectionDelay:   5 * time.Second,
 		reconnectionRetries: 5,
+		healthThresholds: healthThresholds{
+			maxBlockLag:  defaultMaxBlockLag,
+			maxErrorRate: defaultMaxErrorRate,
+		},
 	}
 }
 
@@ -78,7 +90,11 @@ func defaultConfig() *cfg {
 //   - blockchain network type: netmode.PrivNet;
 //   - signer with the global scope;
 //   - wait interval: 500ms;
-//   - logger: &zap.Logger{Logger: zap.L()}.
+//   - logger: &zap.Logger{Logger: zap.L()};
+//   - endpoints health checks: disabled;
+//   - max block lag of a healthy endpoint: 10;
+//   - max error rate of a healthy endpoint: 0.5;
+//   - max latency of a healthy endpoint: unlimited.
 //
 // If desired option satisfies the default value, it can be omitted.
 // If multiple options of the same config value are supplied,
@@ -107,6 +123,7 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		cfg:        *cfg,
 		switchLock: &sync.RWMutex{},
 		closeChan:  make(chan struct{}),
+		health:     newHealthMonitor(cfg.healthThresholds),
 	}
 
 	var err error
@@ -131,16 +148,25 @@ func New(key *keys.PrivateKey, opts ...Option) (*Client, error) {
 		}
 
 		cli.endpoints = cfg.endpoints
+		cli.endpoint = cli.endpoints[0]
 
-		cli.client, act, err = cli.newCli(cli.endpoints[0])
+		cli.client, act, err = cli.newCli(cli.endpoint)
 		if err != nil {
 			return nil, fmt.Errorf("could not create RPC client: %w", err)
 		}
+
+		if cfg.metrics != nil {
+			cfg.metrics.SetRPCEndpoint(cli.endpoint)
+		}
 	}
 	cli.setActor(act)
 
 	go cli.closeWaiter()
 
+	if cfg.singleCli == nil && cfg.healthCheckInterval > 0 {
+		go cli.healthRoutine()
+	}
+
 	return cli, nil
 }
 
@@ -307,3 +333,61 @@ func WithConnSwitchCallback(cb Callback) Option {
 		c.rpcSwitchCb = cb
 	}
 }
+
+// WithHealthCheckInterval returns a client constructor option that specifies
+// interval between health checks of all RPC endpoints provided via
+// [WithEndpoints]. The Client switches from the current endpoint if it becomes
+// unhealthy or if an endpoint with higher priority (closer to the beginning of
+// the list) becomes healthy. Non-positive values disable health checks.
+//
+// Has no effect if WithSingleClient is provided.
+func WithHealthCheckInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.healthCheckInterval = d
+	}
+}
+
+// WithMaxBlockLag returns a client constructor option that specifies the
+// number of blocks an endpoint can lag behind the highest known one while
+// still being healthy.
+func WithMaxBlockLag(lag uint32) Option {
+	return func(c *cfg) {
+		c.healthThresholds.maxBlockLag = lag
+	}
+}
+
+// WithMaxErrorRate returns a client constructor option that specifies the max
+// ratio of failed health checks of a healthy endpoint. The ratio is smoothed
+// over recent checks and is in the [0, 1] range.
+//
+// Ignores values out of the range.
+func WithMaxErrorRate(r float64) Option {
+	return func(c *cfg) {
+		if r >= 0 && r <= 1 {
+			c.healthThresholds.maxErrorRate = r
+		}
+	}
+}
+
+// WithMaxLatency returns a client constructor option that specifies the max
+// smoothed health check latency of a healthy endpoint. Non-positive values
+// mean no limit.
+func WithMaxLatency(d time.Duration) Option {
+	return func(c *cfg) {
+		if d > 0 {
+			c.healthThresholds.maxLatency = d
+		}
+	}
+}
+
+// WithMetrics returns a client constructor option that specifies the metrics
+// collector of the RPC endpoints.
+//
+// Ignores nil value.
+func WithMetrics(m Metrics) Option {
+	return func(c *cfg) {
+		if m != nil {
+			c.metrics = m
+		}
+	}
+}
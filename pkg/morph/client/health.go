@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
+	"go.uber.org/zap"
+)
+
+// Metrics is an interface of the RPC endpoints metrics collector.
+type Metrics interface {
+	// SetRPCEndpoint sets currently used RPC endpoint.
+	SetRPCEndpoint(endpoint string)
+	// SetRPCEndpointHealth sets the latest health state of the RPC endpoint.
+	SetRPCEndpointHealth(endpoint string, healthy bool, height uint32, latency time.Duration)
+}
+
+// healthSmoothing is a weight of the latest probe in the smoothed error rate
+// and latency of the endpoint.
+const healthSmoothing = 0.3
+
+// endpointHealth is a health state of the RPC endpoint built from the
+// periodic probes.
+type endpointHealth struct {
+	// whether the latest probe was successful
+	ok bool
+	// block height reported by the latest successful probe
+	height uint32
+	// smoothed probe latency
+	latency time.Duration
+	// smoothed ratio of failed probes
+	errorRate float64
+}
+
+// update accounts the probe result in the health state.
+func (h *endpointHealth) update(height uint32, latency time.Duration, err error) {
+	h.ok = err == nil
+
+	var failure float64
+	if err != nil {
+		failure = 1
+	} else {
+		h.height = height
+
+		if h.latency == 0 {
+			h.latency = latency
+		} else {
+			h.latency = time.Duration(healthSmoothing*float64(latency) + (1-healthSmoothing)*float64(h.latency))
+		}
+	}
+
+	h.errorRate = healthSmoothing*failure + (1-healthSmoothing)*h.errorRate
+}
+
+// healthThresholds are the limits an endpoint must fit to be healthy.
+type healthThresholds struct {
+	maxBlockLag  uint32
+	maxErrorRate float64
+	// zero means no limit
+	maxLatency time.Duration
+}
+
+// healthy checks whether the endpoint is healthy given the highest block
+// height among all endpoints.
+func (h endpointHealth) healthy(maxHeight uint32, t healthThresholds) bool {
+	return h.ok &&
+		h.height+t.maxBlockLag >= maxHeight &&
+		h.errorRate <= t.maxErrorRate &&
+		(t.maxLatency == 0 || h.latency <= t.maxLatency)
+}
+
+// healthMonitor keeps health states of the RPC endpoints.
+type healthMonitor struct {
+	thresholds healthThresholds
+
+	mtx    sync.RWMutex
+	states map[string]*endpointHealth
+}
+
+func newHealthMonitor(t healthThresholds) *healthMonitor {
+	return &healthMonitor{
+		thresholds: t,
+		states:     make(map[string]*endpointHealth),
+	}
+}
+
+func (m *healthMonitor) update(endpoint string, height uint32, latency time.Duration, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	h, ok := m.states[endpoint]
+	if !ok {
+		h = new(endpointHealth)
+		m.states[endpoint] = h
+	}
+
+	h.update(height, latency, err)
+}
+
+// maxHeight returns the highest block height reported by the endpoints.
+// Must be called under the lock.
+func (m *healthMonitor) maxHeight() uint32 {
+	var res uint32
+	for _, h := range m.states {
+		if h.ok && h.height > res {
+			res = h.height
+		}
+	}
+
+	return res
+}
+
+// isHealthy checks whether the endpoint is healthy. Never probed endpoints
+// are not healthy.
+func (m *healthMonitor) isHealthy(endpoint string) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	h, ok := m.states[endpoint]
+	return ok && h.healthy(m.maxHeight(), m.thresholds)
+}
+
+// order returns endpoints sorted for connection: healthy endpoints first,
+// priority order is kept within healthy and unhealthy ones.
+func (m *healthMonitor) order(endpoints []string) []string {
+	res := make([]string, 0, len(endpoints))
+	var unhealthy []string
+
+	for _, e := range endpoints {
+		if m.isHealthy(e) {
+			res = append(res, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(res, unhealthy...)
+}
+
+// best returns the highest priority healthy endpoint. Returns false if there
+// are no healthy endpoints.
+func (m *healthMonitor) best(endpoints []string) (string, bool) {
+	for _, e := range endpoints {
+		if m.isHealthy(e) {
+			return e, true
+		}
+	}
+
+	return "", false
+}
+
+// probe requests block height from the endpoint via a new short-lived
+// connection. Returned latency does not include connection establishment to
+// be comparable with the one of probeCurrent.
+func (c *Client) probe(endpoint string) (uint32, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(c.cfg.ctx, c.cfg.dialTimeout)
+	defer cancel()
+
+	cli, err := rpcclient.NewWS(ctx, endpoint, rpcclient.Options{
+		DialTimeout:    c.cfg.dialTimeout,
+		RequestTimeout: c.cfg.dialTimeout,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cli.Close()
+
+	start := time.Now()
+	height, err := cli.GetBlockCount()
+
+	return height, time.Since(start), err
+}
+
+// probeCurrent requests block height via the current connection.
+func (c *Client) probeCurrent() (uint32, time.Duration, error) {
+	c.switchLock.RLock()
+	defer c.switchLock.RUnlock()
+
+	if c.inactive {
+		return 0, 0, ErrConnectionLost
+	}
+
+	start := time.Now()
+	height, err := c.client.GetBlockCount()
+
+	return height, time.Since(start), err
+}
+
+// checkHealth probes all the endpoints and updates their health states.
+func (c *Client) checkHealth() {
+	c.switchLock.RLock()
+	endpoints := c.endpoints
+	current := c.endpoint
+	c.switchLock.RUnlock()
+
+	var wg sync.WaitGroup
+
+	for _, e := range endpoints {
+		wg.Add(1)
+
+		go func(e string) {
+			defer wg.Done()
+
+			var (
+				height  uint32
+				latency time.Duration
+				err     error
+			)
+
+			if e == current {
+				height, latency, err = c.probeCurrent()
+			} else {
+				height, latency, err = c.probe(e)
+			}
+
+			c.health.update(e, height, latency, err)
+
+			if err != nil {
+				c.logger.Debug("RPC endpoint health probe failed",
+					zap.String("endpoint", e),
+					zap.Error(err),
+				)
+			}
+		}(e)
+	}
+
+	wg.Wait()
+
+	if c.cfg.metrics != nil {
+		c.health.mtx.RLock()
+		maxHeight := c.health.maxHeight()
+		for _, e := range endpoints {
+			if h, ok := c.health.states[e]; ok {
+				c.cfg.metrics.SetRPCEndpointHealth(e, h.healthy(maxHeight, c.health.thresholds), h.height, h.latency)
+			}
+		}
+		c.health.mtx.RUnlock()
+	}
+}
+
+// healthRoutine periodically checks health of the endpoints and drops the
+// current connection if there is a healthy endpoint with a higher priority or
+// the current one is unhealthy. Reconnection is done by the connection loss
+// handler, see SwitchRPC.
+func (c *Client) healthRoutine() {
+	t := time.NewTicker(c.cfg.healthCheckInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.cfg.ctx.Done():
+			return
+		case <-c.closeChan:
+			return
+		case <-t.C:
+		}
+
+		c.checkHealth()
+
+		c.switchLock.RLock()
+		endpoints := c.endpoints
+		current := c.endpoint
+		inactive := c.inactive
+		c.switchLock.RUnlock()
+
+		if inactive {
+			return
+		}
+
+		best, ok := c.health.best(endpoints)
+		if !ok || best == current {
+			continue
+		}
+
+		c.logger.Info("switching to another RPC endpoint",
+			zap.String("current", current),
+			zap.Bool("current healthy", c.health.isHealthy(current)),
+			zap.String("preferred", best),
+		)
+
+		c.switchLock.Lock()
+		// repeat the check since the connection could have been switched
+		if c.endpoint == current && !c.inactive {
+			c.client.Close()
+		}
+		c.switchLock.Unlock()
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthMonitor(t *testing.T) {
+	endpoints := []string{"first", "second", "third"}
+	errProbe := errors.New("any error")
+
+	m := newHealthMonitor(healthThresholds{
+		maxBlockLag:  2,
+		maxErrorRate: 0.5,
+		maxLatency:   time.Second,
+	})
+
+	// never probed endpoints are unhealthy and keep priority order
+	_, ok := m.best(endpoints)
+	require.False(t, ok)
+	require.Equal(t, endpoints, m.order(endpoints))
+
+	for _, e := range endpoints {
+		m.update(e, 100, time.Millisecond, nil)
+	}
+
+	best, ok := m.best(endpoints)
+	require.True(t, ok)
+	require.Equal(t, "first", best)
+
+	t.Run("lag", func(t *testing.T) {
+		m.update("second", 103, time.Millisecond, nil)
+		m.update("third", 102, time.Millisecond, nil)
+
+		require.False(t, m.isHealthy("first"))
+		require.Equal(t, []string{"second", "third", "first"}, m.order(endpoints))
+
+		m.update("first", 103, time.Millisecond, nil)
+		require.True(t, m.isHealthy("first"))
+		require.Equal(t, endpoints, m.order(endpoints))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		m.update("first", 0, 0, errProbe)
+		require.False(t, m.isHealthy("first"))
+
+		best, _ := m.best(endpoints)
+		require.Equal(t, "second", best)
+
+		// single success after a failure is enough while error rate is low
+		m.update("first", 103, time.Millisecond, nil)
+		require.True(t, m.isHealthy("first"))
+
+		// frequent failures make endpoint unhealthy even if the last probe passed
+		for i := 0; i < 3; i++ {
+			m.update("first", 0, 0, errProbe)
+		}
+		m.update("first", 103, time.Millisecond, nil)
+		require.False(t, m.isHealthy("first"))
+
+		// returns back after the endpoint recovers
+		for i := 0; i < 3; i++ {
+			m.update("first", 103, time.Millisecond, nil)
+		}
+		require.True(t, m.isHealthy("first"))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			m.update("first", 103, 2*time.Second, nil)
+		}
+		require.False(t, m.isHealthy("first"))
+
+		best, _ := m.best(endpoints)
+		require.Equal(t, "second", best)
+	})
+}
@@ -45,11 +45,20 @@ func (c *Client) SwitchRPC() bool {
 	return false
 }
 
+// SetEndpoints replaces the list of RPC endpoints used by the Client. Current
+// connection is kept; new list is used on the next reconnection.
+func (c *Client) SetEndpoints(endpoints []string) {
+	c.switchLock.Lock()
+	c.endpoints = endpoints
+	c.switchLock.Unlock()
+}
+
 func (c *Client) switchPRC() bool {
 	c.client.Close()
 
-	// Iterate endpoints in the order of decreasing priority.
-	for _, e := range c.endpoints {
+	// Iterate endpoints in the order of decreasing priority, healthy ones
+	// first.
+	for _, e := range c.health.order(c.endpoints) {
 		cli, act, err := c.newCli(e)
 		if err != nil {
 			c.logger.Warn("could not establish connection to the switched RPC node",
@@ -66,8 +75,13 @@ func (c *Client) switchPRC() bool {
 			zap.String("endpoint", e))
 
 		c.client = cli
+		c.endpoint = e
 		c.setActor(act)
 
+		if c.cfg.metrics != nil {
+			c.cfg.metrics.SetRPCEndpoint(e)
+		}
+
 		return true
 	}
 	return false
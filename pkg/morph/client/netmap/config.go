@@ -23,6 +23,9 @@ const (
 	withdrawFeeConfig             = "WithdrawFee"
 	homomorphicHashingDisabledKey = "HomomorphicHashingDisabled"
 	maintenanceModeAllowedConfig  = "MaintenanceModeAllowed"
+	repRequestsWeightConfig       = "ReputationRequestsWeight"
+	repLatencyWeightConfig        = "ReputationLatencyWeight"
+	repAvailabilityWeightConfig   = "ReputationAvailabilityWeight"
 )
 
 // MaxObjectSize receives max object size configuration
@@ -112,6 +115,45 @@ func (c *Client) EigenTrustAlpha() (float64, error) {
 	return strconv.ParseFloat(strAlpha, 64)
 }
 
+// ReputationRequestsWeight returns global configuration value of the weight
+// of successful requests in the local trust of storage nodes.
+//
+// Returns ErrConfigNotFound if config key is not found in the contract.
+func (c *Client) ReputationRequestsWeight() (uint64, error) {
+	w, err := c.readUInt64Config(repRequestsWeightConfig)
+	if err != nil {
+		return 0, fmt.Errorf("(%T) could not get reputation requests weight: %w", c, err)
+	}
+
+	return w, nil
+}
+
+// ReputationLatencyWeight returns global configuration value of the weight
+// of request latency in the local trust of storage nodes.
+//
+// Returns ErrConfigNotFound if config key is not found in the contract.
+func (c *Client) ReputationLatencyWeight() (uint64, error) {
+	w, err := c.readUInt64Config(repLatencyWeightConfig)
+	if err != nil {
+		return 0, fmt.Errorf("(%T) could not get reputation latency weight: %w", c, err)
+	}
+
+	return w, nil
+}
+
+// ReputationAvailabilityWeight returns global configuration value of the
+// weight of peer availability in the local trust of storage nodes.
+//
+// Returns ErrConfigNotFound if config key is not found in the contract.
+func (c *Client) ReputationAvailabilityWeight() (uint64, error) {
+	w, err := c.readUInt64Config(repAvailabilityWeightConfig)
+	if err != nil {
+		return 0, fmt.Errorf("(%T) could not get reputation availability weight: %w", c, err)
+	}
+
+	return w, nil
+}
+
 // HomomorphicHashDisabled returns global configuration value of homomorphic hashing
 // settings.
 //
@@ -3,6 +3,9 @@ package reputation
 import (
 	"fmt"
 
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	"github.com/nspcc-dev/neofs-sdk-go/reputation"
@@ -51,6 +54,46 @@ func (c *Client) Get(p GetPrm) ([]reputation.GlobalTrust, error) {
 	return parseReputations(res, getMethod)
 }
 
+// GetBatch reads reputation values of the peers for the epoch with a single
+// test invocation. Result contains values of each peer in the order of the
+// peers.
+func (c *Client) GetBatch(epoch uint64, peers []reputation.PeerID) ([][]reputation.GlobalTrust, error) {
+	if len(peers) == 0 {
+		return nil, nil
+	}
+
+	w := io.NewBufBinWriter()
+	contract := c.client.ContractAddress()
+
+	for i := range peers {
+		emit.AppCall(w.BinWriter, contract, getMethod, callflag.ReadStates, int64(epoch), peers[i].PublicKey())
+	}
+
+	if w.Err != nil {
+		return nil, fmt.Errorf("could not build script (%s): %w", getMethod, w.Err)
+	}
+
+	items, err := c.client.Morph().TestInvokeScript(w.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("could not perform test invocation (%s): %w", getMethod, err)
+	}
+
+	if len(items) != len(peers) {
+		return nil, fmt.Errorf("unexpected stack item count (%s): %d instead of %d", getMethod, len(items), len(peers))
+	}
+
+	res := make([][]reputation.GlobalTrust, len(items))
+
+	for i := range items {
+		res[i], err = parseReputations(items[i:i+1], getMethod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
 // GetByID invokes the call of "get reputation value by reputation id" method
 // of reputation contract.
 func (c *Client) GetByID(p GetByIDPrm) ([]reputation.GlobalTrust, error) {
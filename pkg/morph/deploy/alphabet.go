@@ -0,0 +1,108 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/native/noderoles"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/rolemgmt"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"go.uber.org/zap"
+)
+
+// designateAlphabetRolePrm groups parameters of NeoFS Alphabet role designation
+// to the committee.
+type designateAlphabetRolePrm struct {
+	logger *zap.Logger
+
+	blockchain Blockchain
+
+	// based on blockchain
+	monitor *blockchainMonitor
+
+	localAcc *wallet.Account
+
+	committee keys.PublicKeys
+}
+
+// designateAlphabetRole makes all committee members NeoFS Alphabet nodes.
+// Success is the presence of the NeoFS Alphabet role for each committee member.
+// Transaction designating the role is sent through the Notary service, so it
+// must be already enabled for the committee.
+//
+// Function behaves similar to initNNSContract in terms of context.
+func designateAlphabetRole(ctx context.Context, prm designateAlphabetRolePrm) error {
+	committeeActor, err := newCommitteeNotaryActor(prm.blockchain, prm.localAcc, prm.committee)
+	if err != nil {
+		return fmt.Errorf("create Notary service client sending transactions to be signed by the committee: %w", err)
+	}
+
+	// wrap the parent context into the context of the current function so that
+	// transaction wait routines do not leak
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	roleContract := rolemgmt.New(committeeActor)
+	txMonitor := newTransactionGroupMonitor(committeeActor)
+
+	for ; ; prm.monitor.waitForNextBlock(ctx) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for NeoFS Alphabet role to be designated to the committee: %w", ctx.Err())
+		default:
+		}
+
+		prm.logger.Info("checking NeoFS Alphabet role of the committee members...")
+
+		accsWithAlphabetRole, err := roleContract.GetDesignatedByRole(noderoles.NeoFSAlphabet, prm.monitor.currentHeight())
+		if err != nil {
+			prm.logger.Error("failed to check role of the committee, will try again later", zap.Error(err))
+			continue
+		}
+
+		someoneWithoutAlphabetRole := len(accsWithAlphabetRole) < len(prm.committee)
+		if !someoneWithoutAlphabetRole {
+			for i := range prm.committee {
+				if !accsWithAlphabetRole.Contains(prm.committee[i]) {
+					someoneWithoutAlphabetRole = true
+					break
+				}
+			}
+		}
+		if !someoneWithoutAlphabetRole {
+			prm.logger.Info("all committee members have a NeoFS Alphabet role")
+			return nil
+		}
+
+		prm.logger.Info("not all members of the committee have a NeoFS Alphabet role, designation is needed")
+
+		if txMonitor.isPending() {
+			prm.logger.Info("previously sent notary request designating NeoFS Alphabet role to the committee is still pending, will wait for the outcome")
+			continue
+		}
+
+		tx, err := roleContract.DesignateAsRoleTransaction(noderoles.NeoFSAlphabet, prm.committee)
+		if err != nil {
+			prm.logger.Error("failed to make transaction designating NeoFS Alphabet role to the committee, will try again later", zap.Error(err))
+			continue
+		}
+
+		prm.logger.Info("sending new Notary request designating NeoFS Alphabet role to the committee...")
+
+		mainTxID, fallbackTxID, vub, err := committeeActor.Notarize(tx, nil)
+		if err != nil {
+			if isErrNotEnoughGAS(err) {
+				prm.logger.Info("insufficient Notary balance to send new Notary request designating NeoFS Alphabet role to the committee, will try again later")
+			} else {
+				prm.logger.Error("failed to send new Notary request designating NeoFS Alphabet role to the committee, will try again later", zap.Error(err))
+			}
+			continue
+		}
+
+		prm.logger.Info("notary request designating NeoFS Alphabet role to the committee has been successfully sent, will wait for the outcome",
+			zap.Stringer("main tx", mainTxID), zap.Stringer("fallback tx", fallbackTxID), zap.Uint32("vub", vub))
+
+		txMonitor.trackPendingTransactionsAsync(ctx, vub, mainTxID, fallbackTxID)
+	}
+}
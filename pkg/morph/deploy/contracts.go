@@ -1,7 +1,506 @@
 package deploy
 
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/management"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/nns"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/manifest"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/nef"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"go.uber.org/zap"
+)
+
 // various common methods of the NeoFS contracts.
 const (
 	methodUpdate  = "update"
 	methodVersion = "version"
 )
+
+// names of the NeoFS system contracts. Names are also used as the labels of
+// the NNS domains with contract addresses.
+const (
+	contractNameAlphabet   = "alphabet"
+	contractNameAudit      = "audit"
+	contractNameBalance    = "balance"
+	contractNameContainer  = "container"
+	contractNameNeoFSID    = "neofsid"
+	contractNameNetmap     = "netmap"
+	contractNameProxy      = "proxy"
+	contractNameReputation = "reputation"
+)
+
+// domainContainers is an NNS root domain of the container aliases registered
+// by the Container contract.
+const domainContainers = "container"
+
+// domainCommitteeGroup is an NNS domain with public key of the committee group.
+const domainCommitteeGroup = "group." + domainContractAddresses
+
+// contractDomain returns NNS domain with address of the NeoFS system contract
+// with the given name.
+func contractDomain(name string) string {
+	return name + "." + domainContractAddresses
+}
+
+// alphabetContractName returns name of the NeoFS Alphabet contract belonging to
+// the committee member with the given index.
+func alphabetContractName(memberIndex int) string {
+	return contractNameAlphabet + strconv.Itoa(memberIndex)
+}
+
+// glagoliticLetters lists names of the NeoFS Alphabet contracts. Names are
+// used in the same order as Inner Ring application does.
+var glagoliticLetters = [...]string{
+	"az", "buky", "vedi", "glagoli", "dobro", "yest", "zhivete", "dzelo", "zemlja", "izhe",
+	"izhei", "gerv", "kako", "ljudi", "mislete", "nash", "on", "pokoj", "rtsi", "slovo",
+	"tverdo", "uk", "fert", "kher", "oht", "shta", "tsi", "cherv", "sha", "yer",
+	"yeri", "yerj", "yat", "jo", "yu", "small.yus", "small.iotated.yus", "big.yus", "big.iotated.yus", "fita",
+	"izhitsa",
+}
+
+// keys of the NeoFS network configuration stored in the Netmap contract.
+const (
+	netConfigKeyAuditFee                   = "AuditFee"
+	netConfigKeyBasicIncomeRate            = "BasicIncomeRate"
+	netConfigKeyContainerAliasFee          = "ContainerAliasFee"
+	netConfigKeyContainerFee               = "ContainerFee"
+	netConfigKeyEigenTrustAlpha            = "EigenTrustAlpha"
+	netConfigKeyEigenTrustIterations       = "EigenTrustIterations"
+	netConfigKeyEpochDuration              = "EpochDuration"
+	netConfigKeyHomomorphicHashingDisabled = "HomomorphicHashingDisabled"
+	netConfigKeyInnerRingCandidateFee      = "InnerRingCandidateFee"
+	netConfigKeyMaintenanceModeAllowed     = "MaintenanceModeAllowed"
+	netConfigKeyMaxObjectSize              = "MaxObjectSize"
+	netConfigKeyWithdrawFee                = "WithdrawFee"
+)
+
+// encodeNetworkConfiguration encodes NetworkConfiguration into the list of
+// key-value pairs accepted by the Netmap contract.
+func encodeNetworkConfiguration(c NetworkConfiguration) []interface{} {
+	return []interface{}{
+		netConfigKeyMaxObjectSize, int64(c.MaxObjectSize),
+		netConfigKeyEpochDuration, int64(c.EpochDuration),
+		netConfigKeyContainerFee, int64(c.ContainerFee),
+		netConfigKeyContainerAliasFee, int64(c.ContainerAliasFee),
+		netConfigKeyAuditFee, int64(c.AuditFee),
+		netConfigKeyBasicIncomeRate, int64(c.BasicIncomeRate),
+		netConfigKeyInnerRingCandidateFee, int64(c.InnerRingCandidateFee),
+		netConfigKeyWithdrawFee, int64(c.WithdrawFee),
+		netConfigKeyEigenTrustIterations, int64(c.EigenTrustIterations),
+		netConfigKeyEigenTrustAlpha, strconv.FormatFloat(c.EigenTrustAlpha, 'f', -1, 64),
+		netConfigKeyHomomorphicHashingDisabled, c.HomomorphicHashingDisabled,
+		netConfigKeyMaintenanceModeAllowed, c.MaintenanceModeAllowed,
+	}
+}
+
+// syncNeoFSContractPrm groups parameters of NeoFS system contract
+// synchronization.
+type syncNeoFSContractPrm struct {
+	logger *zap.Logger
+
+	blockchain Blockchain
+
+	// based on blockchain
+	monitor *blockchainMonitor
+
+	localAcc *wallet.Account
+
+	localNEF      nef.File
+	localManifest manifest.Manifest
+	systemEmail   string
+
+	committee         keys.PublicKeys
+	committeeGroupKey *keys.PrivateKey
+
+	nnsOnChainAddress util.Uint160
+
+	// NNS domain with the contract address record
+	domainName string
+
+	// account sending transaction deploying the contract. Determines contract
+	// address.
+	deployer util.Uint160
+
+	// flag to deploy missing contract on behalf of the local account. Must be set
+	// only if deployer is the local account.
+	tryDeploy bool
+
+	// flag to register domain and set address record in the NNS on behalf of the
+	// local account.
+	tryRegister bool
+
+	// constructor of arguments to be passed into method deploying or updating
+	// the contract.
+	buildDeployArgs func() ([]interface{}, error)
+}
+
+// syncNeoFSContract synchronizes NeoFS system contract with the chain and
+// returns its address. Success is the presence of the contract in the NNS
+// domain record and its on-chain version being not lower than the local one.
+//
+// Missing contract is deployed if syncNeoFSContractPrm.tryDeploy is set,
+// otherwise syncNeoFSContract waits for the background deployment. Similarly,
+// NNS domain is registered only if syncNeoFSContractPrm.tryRegister is set. If
+// on-chain contract has lower version, transaction calling 'update' method is
+// sent.
+//
+// Function behaves similar to initNNSContract in terms of context.
+func syncNeoFSContract(ctx context.Context, prm syncNeoFSContractPrm) (util.Uint160, error) {
+	var res util.Uint160
+
+	bLocalNEF, err := prm.localNEF.Bytes()
+	if err != nil {
+		// not really expected
+		return res, fmt.Errorf("encode local NEF of the contract into binary: %w", err)
+	}
+
+	localActor, err := actor.NewSimple(prm.blockchain, prm.localAcc)
+	if err != nil {
+		return res, fmt.Errorf("init transaction sender from local account: %w", err)
+	}
+
+	committeeActor, err := newCommitteeNotaryActorWithCustomCommitteeSigner(prm.blockchain, prm.localAcc, prm.committee, func(s *transaction.Signer) {
+		// contracts may require committee witness in nested calls, e.g. Container
+		// contract registers NNS domain on deployment
+		s.Scopes = transaction.CalledByEntry | transaction.CustomGroups
+		s.AllowedGroups = keys.PublicKeys{prm.committeeGroupKey.PublicKey()}
+	})
+	if err != nil {
+		return res, fmt.Errorf("create Notary service client sending transactions to be signed by the committee: %w", err)
+	}
+
+	localVersion, err := readContractLocalVersion(prm.blockchain, prm.localNEF, prm.localManifest)
+	if err != nil {
+		return res, fmt.Errorf("read version of the local contract: %w", err)
+	}
+
+	// wrap the parent context into the context of the current function so that
+	// transaction wait routines do not leak
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	l := prm.logger.With(zap.String("domain", prm.domainName))
+	inv := invoker.New(prm.blockchain, nil)
+	expectedAddress := state.CreateContractHash(prm.deployer, prm.localNEF.Checksum, prm.localManifest.Name)
+	deployTxMonitor := newTransactionGroupMonitor(committeeActor)
+	updateTxMonitor := newTransactionGroupMonitor(committeeActor)
+	setDomainRecordTick := initSetNNSDomainRecordTick(ctx, l, localActor, prm.nnsOnChainAddress, prm.domainName, prm.systemEmail)
+
+	for ; ; prm.monitor.waitForNextBlock(ctx) {
+		select {
+		case <-ctx.Done():
+			return res, fmt.Errorf("wait for contract synchronization: %w", ctx.Err())
+		default:
+		}
+
+		l.Info("reading contract address from the NNS...")
+
+		rec, err := lookupNNSDomainRecord(inv, prm.nnsOnChainAddress, prm.domainName)
+		if err == nil {
+			onChainAddress, err := util.Uint160DecodeStringLE(rec)
+			if err != nil {
+				l.Error("invalid contract address in the NNS domain record, will wait for a background fix",
+					zap.String("record", rec), zap.Error(err))
+				continue
+			}
+
+			l.Info("reading on-chain state of the contract...", zap.Stringer("address", onChainAddress))
+
+			stateOnChain, err := prm.blockchain.GetContractStateByHash(onChainAddress)
+			if err != nil {
+				l.Error("failed to read on-chain state of the contract, will try again later", zap.Error(err))
+				continue
+			}
+
+			if stateOnChain.NEF.Checksum == prm.localNEF.Checksum {
+				// see updateNNSContract for details
+				l.Info("same local and on-chain checksums of the contract NEF, update is not needed")
+				return onChainAddress, nil
+			}
+
+			l.Info("NEF checksums of the on-chain and local contracts differ, need an update")
+
+			versionOnChain, err := readContractOnChainVersion(prm.blockchain, onChainAddress)
+			if err != nil {
+				l.Error("failed to read on-chain version of the contract, will try again later", zap.Error(err))
+				continue
+			}
+
+			if v := localVersion.cmp(versionOnChain); v == -1 {
+				l.Info("local contract version is < than the on-chain one, update is not needed",
+					zap.Stringer("local", localVersion), zap.Stringer("on-chain", versionOnChain))
+				return onChainAddress, nil
+			} else if v == 0 {
+				return res, fmt.Errorf("local and on-chain contracts have different NEF checksums but same version '%s'", versionOnChain)
+			}
+
+			updateArgs, err := prm.buildDeployArgs()
+			if err != nil {
+				l.Error("failed to prepare arguments for contract update, will try again later", zap.Error(err))
+				continue
+			}
+
+			setGroupInManifest(&prm.localManifest, onChainAddress, prm.committeeGroupKey)
+
+			jLocalManifest, err := json.Marshal(prm.localManifest)
+			if err != nil {
+				// not really expected
+				return res, fmt.Errorf("encode local manifest of the contract into JSON: %w", err)
+			}
+
+			// pre-check 'already updated' case, see updateNNSContract for details
+			tx, err := committeeActor.MakeCall(onChainAddress, methodUpdate, bLocalNEF, jLocalManifest, updateArgs)
+			if err != nil {
+				if isErrContractAlreadyUpdated(err) {
+					l.Info("contract has already been updated, skip")
+					return onChainAddress, nil
+				}
+
+				l.Error("failed to make transaction updating contract, will try again later", zap.Error(err))
+				continue
+			}
+
+			if updateTxMonitor.isPending() {
+				l.Info("previously sent notary request updating contract is still pending, will wait for the outcome")
+				continue
+			}
+
+			mainTxID, fallbackTxID, vub, err := committeeActor.Notarize(tx, nil)
+			if err != nil {
+				if isErrNotEnoughGAS(err) {
+					l.Info("insufficient Notary balance to send new Notary request updating contract, will try again later")
+				} else {
+					l.Error("failed to send new Notary request updating contract, will try again later", zap.Error(err))
+				}
+				continue
+			}
+
+			l.Info("notary request updating contract has been successfully sent, will wait for the outcome",
+				zap.Stringer("main tx", mainTxID), zap.Stringer("fallback tx", fallbackTxID), zap.Uint32("vub", vub))
+
+			updateTxMonitor.trackPendingTransactionsAsync(ctx, vub, mainTxID, fallbackTxID)
+
+			continue
+		} else if !errors.Is(err, errMissingDomain) && !errors.Is(err, errMissingDomainRecord) {
+			l.Error("failed to lookup NNS domain record, will try again later", zap.Error(err))
+			continue
+		}
+
+		domainMissing := errors.Is(err, errMissingDomain)
+
+		l.Info("contract address is missing in the NNS, checking the contract presence on the chain...",
+			zap.Stringer("expected address", expectedAddress))
+
+		_, err = prm.blockchain.GetContractStateByHash(expectedAddress)
+		if err != nil {
+			if !isErrContractNotFound(err) {
+				l.Error("failed to read on-chain state of the contract, will try again later", zap.Error(err))
+				continue
+			}
+
+			if !prm.tryDeploy {
+				l.Info("contract is missing on the chain but attempts to deploy are disabled, will wait for background deployment")
+				continue
+			}
+
+			l.Info("contract is missing on the chain, contract needs to be deployed")
+
+			if deployTxMonitor.isPending() {
+				l.Info("previously sent notary request deploying contract is still pending, will wait for the outcome")
+				continue
+			}
+
+			deployArgs, err := prm.buildDeployArgs()
+			if err != nil {
+				l.Error("failed to prepare arguments for contract deployment, will try again later", zap.Error(err))
+				continue
+			}
+
+			setGroupInManifest(&prm.localManifest, expectedAddress, prm.committeeGroupKey)
+
+			jLocalManifest, err := json.Marshal(prm.localManifest)
+			if err != nil {
+				// not really expected
+				return res, fmt.Errorf("encode local manifest of the contract into JSON: %w", err)
+			}
+
+			tx, err := committeeActor.MakeCall(management.Hash, "deploy", bLocalNEF, jLocalManifest, deployArgs)
+			if err != nil {
+				l.Error("failed to make transaction deploying contract, will try again later", zap.Error(err))
+				continue
+			}
+
+			l.Info("sending new Notary request deploying contract...")
+
+			mainTxID, fallbackTxID, vub, err := committeeActor.Notarize(tx, nil)
+			if err != nil {
+				if isErrNotEnoughGAS(err) {
+					l.Info("insufficient Notary balance to send new Notary request deploying contract, will try again later")
+				} else {
+					l.Error("failed to send new Notary request deploying contract, will try again later", zap.Error(err))
+				}
+				continue
+			}
+
+			l.Info("notary request deploying contract has been successfully sent, will wait for the outcome",
+				zap.Stringer("main tx", mainTxID), zap.Stringer("fallback tx", fallbackTxID), zap.Uint32("vub", vub))
+
+			deployTxMonitor.trackPendingTransactionsAsync(ctx, vub, mainTxID, fallbackTxID)
+
+			continue
+		}
+
+		if !prm.tryRegister {
+			l.Info("contract is present on the chain but attempts to register it in the NNS are disabled, will wait for background registration")
+			continue
+		}
+
+		setDomainRecordTick(domainMissing, expectedAddress.StringLE())
+	}
+}
+
+// initSetNNSDomainRecordTick returns a function that preserves context of the
+// NNS domain registration and setting its record on behalf of the local account
+// between calls. The function accepts domain presence flag and the record to be
+// set.
+func initSetNNSDomainRecordTick(ctx context.Context, l *zap.Logger, localActor *actor.Actor,
+	nnsOnChainAddress util.Uint160, domain, systemEmail string) func(domainMissing bool, record string) {
+	// multi-tick context
+	registerDomainTxMonitor := newTransactionGroupMonitor(localActor)
+	setDomainRecordTxMonitor := newTransactionGroupMonitor(localActor)
+
+	return func(domainMissing bool, record string) {
+		if domainMissing {
+			l.Info("NNS domain is missing, registration is needed")
+
+			if registerDomainTxMonitor.isPending() {
+				l.Info("previously sent transaction registering NNS domain is still pending, will wait for the outcome")
+				return
+			}
+
+			l.Info("sending new transaction registering domain in the NNS...")
+
+			txID, vub, err := localActor.SendCall(nnsOnChainAddress, methodNNSRegister,
+				domain, localActor.Sender(), systemEmail, nnsRefresh, nnsRetry, nnsExpire, nnsMinimum)
+			if err != nil {
+				if isErrNotEnoughGAS(err) {
+					l.Info("not enough GAS to register domain in the NNS, will try again later")
+				} else {
+					l.Error("failed to send transaction registering domain in the NNS, will try again later", zap.Error(err))
+				}
+				return
+			}
+
+			l.Info("transaction registering domain in the NNS has been successfully sent, will wait for the outcome",
+				zap.Stringer("tx", txID), zap.Uint32("vub", vub))
+
+			registerDomainTxMonitor.trackPendingTransactionsAsync(ctx, vub, txID)
+
+			return
+		}
+
+		l.Info("missing record of the NNS domain, needed to be set")
+
+		if setDomainRecordTxMonitor.isPending() {
+			l.Info("previously sent transaction setting NNS domain record is still pending, will wait for the outcome")
+			return
+		}
+
+		l.Info("sending new transaction setting domain record in the NNS...")
+
+		txID, vub, err := localActor.SendCall(nnsOnChainAddress, methodNNSAddRecord,
+			domain, int64(nns.TXT), record)
+		if err != nil {
+			if isErrNotEnoughGAS(err) {
+				l.Info("not enough GAS to set NNS domain record, will try again later")
+			} else {
+				l.Error("failed to send transaction setting NNS domain record, will try again later", zap.Error(err))
+			}
+			return
+		}
+
+		l.Info("transaction setting NNS domain record has been successfully sent, will wait for the outcome",
+			zap.Stringer("tx", txID), zap.Uint32("vub", vub))
+
+		setDomainRecordTxMonitor.trackPendingTransactionsAsync(ctx, vub, txID)
+	}
+}
+
+// syncCommitteeGroupDomainRecordPrm groups parameters of
+// syncCommitteeGroupDomainRecord.
+type syncCommitteeGroupDomainRecordPrm struct {
+	logger *zap.Logger
+
+	blockchain Blockchain
+
+	// based on blockchain
+	monitor *blockchainMonitor
+
+	localAcc *wallet.Account
+
+	nnsOnChainAddress util.Uint160
+	systemEmail       string
+
+	committeeGroupKey *keys.PrivateKey
+}
+
+// syncCommitteeGroupDomainRecord makes public key of the committee group
+// available in the NNS domain record. Success is the presence of the key in the
+// record. The key is used by NeoFS applications to limit witness scope of the
+// transactions to the NeoFS contracts.
+//
+// Function behaves similar to initNNSContract in terms of context.
+func syncCommitteeGroupDomainRecord(ctx context.Context, prm syncCommitteeGroupDomainRecordPrm) error {
+	localActor, err := actor.NewSimple(prm.blockchain, prm.localAcc)
+	if err != nil {
+		return fmt.Errorf("init transaction sender from local account: %w", err)
+	}
+
+	// wrap the parent context into the context of the current function so that
+	// transaction wait routines do not leak
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	l := prm.logger.With(zap.String("domain", domainCommitteeGroup))
+	inv := invoker.New(prm.blockchain, nil)
+	strPubKey := hex.EncodeToString(prm.committeeGroupKey.PublicKey().Bytes())
+	setDomainRecordTick := initSetNNSDomainRecordTick(ctx, l, localActor, prm.nnsOnChainAddress, domainCommitteeGroup, prm.systemEmail)
+
+	for ; ; prm.monitor.waitForNextBlock(ctx) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for committee group key to be set in the NNS: %w", ctx.Err())
+		default:
+		}
+
+		l.Info("reading committee group key from the NNS...")
+
+		rec, err := lookupNNSDomainRecord(inv, prm.nnsOnChainAddress, domainCommitteeGroup)
+		if err == nil {
+			if rec != strPubKey {
+				// there is no need to distinguish the key of the current group from the
+				// previous ones
+				return fmt.Errorf("NNS domain record contains unexpected committee group key %s", rec)
+			}
+
+			return nil
+		} else if !errors.Is(err, errMissingDomain) && !errors.Is(err, errMissingDomainRecord) {
+			l.Error("failed to lookup NNS domain record, will try again later", zap.Error(err))
+			continue
+		}
+
+		setDomainRecordTick(errors.Is(err, errMissingDomain), strPubKey)
+	}
+}
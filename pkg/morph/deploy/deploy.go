@@ -15,6 +15,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/notary"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/manifest"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/nef"
+	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
 	"go.uber.org/zap"
 )
@@ -35,6 +36,11 @@ type Blockchain interface {
 	// requested contract is missing.
 	GetContractStateByID(id int32) (*state.Contract, error)
 
+	// GetContractStateByHash is similar to GetContractStateByID but accepts address.
+	// GetContractStateByHash returns error with 'Unknown contract' substring if
+	// requested contract is missing.
+	GetContractStateByHash(util.Uint160) (*state.Contract, error)
+
 	// ReceiveBlocks starts background process that forwards new blocks of the
 	// blockchain to the provided channel. The process handles all new blocks when
 	// ReceiveBlocks is called with nil filter. Returns unique identifier to be used
@@ -72,6 +78,41 @@ type NNSPrm struct {
 	SystemEmail string
 }
 
+// NetworkConfiguration groups NeoFS network configuration parameters stored
+// in the Netmap contract.
+type NetworkConfiguration struct {
+	// Maximum size of the stored object payload in bytes.
+	MaxObjectSize uint64
+	// Duration of the NeoFS epoch in blocks.
+	EpochDuration uint64
+	// Fee for the container creation paid by the container owner.
+	ContainerFee uint64
+	// Fee for the container alias registration paid by the container owner.
+	ContainerAliasFee uint64
+	// Fee for the data audit paid by the container owner.
+	AuditFee uint64
+	// Storage node basic income rate.
+	BasicIncomeRate uint64
+	// Fee for the Inner Ring candidate registration.
+	InnerRingCandidateFee uint64
+	// Fee for the GAS withdrawal from the NeoFS.
+	WithdrawFee uint64
+	// Number of EigenTrust algorithm iterations.
+	EigenTrustIterations uint64
+	// Alpha parameter of the EigenTrust algorithm.
+	EigenTrustAlpha float64
+	// Flag to disable homomorphic hashing of the object payloads.
+	HomomorphicHashingDisabled bool
+	// Flag to allow storage nodes to switch into maintenance mode.
+	MaintenanceModeAllowed bool
+}
+
+// NetmapContractPrm groups deployment parameters of the NeoFS Netmap contract.
+type NetmapContractPrm struct {
+	Common CommonDeployPrm
+	Config NetworkConfiguration
+}
+
 // Prm groups all parameters of the NeoFS Sidechain deployment procedure.
 type Prm struct {
 	// Writes progress into the log.
@@ -87,6 +128,15 @@ type Prm struct {
 	KeyStorage KeyStorage
 
 	NNS NNSPrm
+
+	AlphabetContract   CommonDeployPrm
+	AuditContract      CommonDeployPrm
+	BalanceContract    CommonDeployPrm
+	ContainerContract  CommonDeployPrm
+	NeoFSIDContract    CommonDeployPrm
+	NetmapContract     NetmapContractPrm
+	ProxyContract      CommonDeployPrm
+	ReputationContract CommonDeployPrm
 }
 
 // Deploy initializes Neo network represented by given Prm.Blockchain as NeoFS
@@ -101,8 +151,13 @@ type Prm struct {
 //  1. NNS contract deployment
 //  2. launch of a notary service for the committee
 //  3. committee group initialization
-//  4. deployment/update of the NeoFS system contracts (currently only NNS)
-//  5. deployment of custom contracts
+//  4. deployment/update of the NeoFS system contracts
+//  5. designation of the NeoFS Alphabet role to the committee
+//  6. deployment of custom contracts (not supported yet)
+//
+// NeoFS system contracts except Alphabet ones are deployed by the first
+// committee member which also registers their addresses in the NNS. Each
+// committee member deploys its own Alphabet contract.
 //
 // See project documentation for details.
 func Deploy(ctx context.Context, prm Prm) error {
@@ -256,7 +311,202 @@ func Deploy(ctx context.Context, prm Prm) error {
 
 	prm.Logger.Info("on-chain NNS contract successfully updated")
 
-	// TODO: deploy/update other contracts
+	leaderAcc := committee[0].GetScriptHash()
+
+	syncPrm := syncNeoFSContractPrm{
+		logger:            prm.Logger,
+		blockchain:        prm.Blockchain,
+		monitor:           monitor,
+		localAcc:          prm.LocalAccount,
+		systemEmail:       prm.NNS.SystemEmail,
+		committee:         committee,
+		committeeGroupKey: committeeGroupKey,
+		nnsOnChainAddress: nnsOnChainAddress,
+		deployer:          leaderAcc,
+		tryDeploy:         localAccCommitteeIndex == 0,
+		tryRegister:       localAccCommitteeIndex == 0,
+	}
+
+	// addresses of the synchronized NeoFS system contracts. Contracts reference
+	// each other, so addresses of the ones yet to be deployed are predicted
+	syncedContracts := make(map[string]util.Uint160)
+	contractAddress := func(name string, p CommonDeployPrm) util.Uint160 {
+		if res, ok := syncedContracts[name]; ok {
+			return res
+		}
+		return state.CreateContractHash(leaderAcc, p.NEF.Checksum, p.Manifest.Name)
+	}
+
+	systemContracts := []struct {
+		name string
+		prm  CommonDeployPrm
+		args func() []interface{}
+	}{
+		{
+			name: contractNameProxy,
+			prm:  prm.ProxyContract,
+			args: func() []interface{} { return nil },
+		},
+		{
+			name: contractNameNetmap,
+			prm:  prm.NetmapContract.Common,
+			args: func() []interface{} {
+				return []interface{}{
+					false, // notary is always enabled
+					contractAddress(contractNameBalance, prm.BalanceContract),
+					contractAddress(contractNameContainer, prm.ContainerContract),
+					[]interface{}(nil), // Inner Ring keys are used with disabled notary only
+					encodeNetworkConfiguration(prm.NetmapContract.Config),
+				}
+			},
+		},
+		{
+			name: contractNameBalance,
+			prm:  prm.BalanceContract,
+			args: func() []interface{} {
+				return []interface{}{
+					false,
+					contractAddress(contractNameNetmap, prm.NetmapContract.Common),
+					contractAddress(contractNameContainer, prm.ContainerContract),
+				}
+			},
+		},
+		{
+			name: contractNameContainer,
+			prm:  prm.ContainerContract,
+			args: func() []interface{} {
+				return []interface{}{
+					false,
+					contractAddress(contractNameNetmap, prm.NetmapContract.Common),
+					contractAddress(contractNameBalance, prm.BalanceContract),
+					contractAddress(contractNameNeoFSID, prm.NeoFSIDContract),
+					nnsOnChainAddress,
+					domainContainers,
+				}
+			},
+		},
+		{
+			name: contractNameNeoFSID,
+			prm:  prm.NeoFSIDContract,
+			args: func() []interface{} {
+				return []interface{}{
+					false,
+					contractAddress(contractNameNetmap, prm.NetmapContract.Common),
+					contractAddress(contractNameContainer, prm.ContainerContract),
+				}
+			},
+		},
+		{
+			name: contractNameAudit,
+			prm:  prm.AuditContract,
+			args: func() []interface{} {
+				return []interface{}{
+					false,
+					contractAddress(contractNameNetmap, prm.NetmapContract.Common),
+				}
+			},
+		},
+		{
+			name: contractNameReputation,
+			prm:  prm.ReputationContract,
+			args: func() []interface{} { return []interface{}{false} },
+		},
+	}
+
+	if localAccCommitteeIndex == 0 {
+		for i := range systemContracts {
+			c := systemContracts[i]
+			l := prm.Logger.With(zap.String("contract", c.name))
+
+			l.Info("synchronizing NeoFS system contract with the chain...")
+
+			syncPrm.logger = l
+			syncPrm.localNEF = c.prm.NEF
+			syncPrm.localManifest = c.prm.Manifest
+			syncPrm.domainName = contractDomain(c.name)
+			syncPrm.buildDeployArgs = func() ([]interface{}, error) { return c.args(), nil }
+
+			syncedContracts[c.name], err = syncNeoFSContract(ctx, syncPrm)
+			if err != nil {
+				return fmt.Errorf("sync NeoFS %s contract with the chain: %w", c.name, err)
+			}
+
+			l.Info("NeoFS system contract successfully synchronized with the chain", zap.Stringer("address", syncedContracts[c.name]))
+		}
+
+		prm.Logger.Info("setting committee group key in the NNS...")
+
+		err = syncCommitteeGroupDomainRecord(ctx, syncCommitteeGroupDomainRecordPrm{
+			logger:            prm.Logger,
+			blockchain:        prm.Blockchain,
+			monitor:           monitor,
+			localAcc:          prm.LocalAccount,
+			nnsOnChainAddress: nnsOnChainAddress,
+			systemEmail:       prm.NNS.SystemEmail,
+			committeeGroupKey: committeeGroupKey,
+		})
+		if err != nil {
+			return fmt.Errorf("set committee group key in the NNS: %w", err)
+		}
+
+		prm.Logger.Info("committee group key successfully set in the NNS")
+
+		prm.Logger.Info("designating NeoFS Alphabet role to the committee...")
+
+		err = designateAlphabetRole(ctx, designateAlphabetRolePrm{
+			logger:     prm.Logger,
+			blockchain: prm.Blockchain,
+			monitor:    monitor,
+			localAcc:   prm.LocalAccount,
+			committee:  committee,
+		})
+		if err != nil {
+			return fmt.Errorf("designate NeoFS Alphabet role to the committee: %w", err)
+		}
+
+		prm.Logger.Info("NeoFS Alphabet role successfully designated to the committee")
+	}
+
+	if len(committee) > len(glagoliticLetters) {
+		return fmt.Errorf("committee size %d overflows number of Alphabet contracts %d", len(committee), len(glagoliticLetters))
+	}
+
+	// the leader registers all Alphabet contracts in the NNS while other members
+	// only deploy own ones
+	for i := range committee {
+		if localAccCommitteeIndex != 0 && i != localAccCommitteeIndex {
+			continue
+		}
+
+		name := alphabetContractName(i)
+		l := prm.Logger.With(zap.String("contract", name))
+
+		l.Info("synchronizing NeoFS Alphabet contract with the chain...")
+
+		syncPrm.logger = l
+		syncPrm.localNEF = prm.AlphabetContract.NEF
+		syncPrm.localManifest = prm.AlphabetContract.Manifest
+		syncPrm.domainName = contractDomain(name)
+		syncPrm.deployer = committee[i].GetScriptHash()
+		syncPrm.tryDeploy = i == localAccCommitteeIndex
+		syncPrm.buildDeployArgs = func() ([]interface{}, error) {
+			return []interface{}{
+				false,
+				contractAddress(contractNameNetmap, prm.NetmapContract.Common),
+				contractAddress(contractNameProxy, prm.ProxyContract),
+				glagoliticLetters[i],
+				int64(i),
+				int64(len(committee)),
+			}, nil
+		}
+
+		addr, err := syncNeoFSContract(ctx, syncPrm)
+		if err != nil {
+			return fmt.Errorf("sync NeoFS %s contract with the chain: %w", name, err)
+		}
+
+		l.Info("NeoFS Alphabet contract successfully synchronized with the chain", zap.Stringer("address", addr))
+	}
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/invoker"
@@ -151,7 +152,9 @@ func initNNSContract(ctx context.Context, prm deployNNSContractPrm) (res util.Ui
 				continue
 			}
 
-			setGroupInManifest(&prm.localManifest, prm.localNEF, committeeGroupKey, prm.localAcc.ScriptHash())
+			setGroupInManifest(&prm.localManifest,
+				state.CreateContractHash(prm.localAcc.ScriptHash(), prm.localNEF.Checksum, prm.localManifest.Name),
+				committeeGroupKey)
 
 			prm.logger.Info("private key of the committee group has been initialized", zap.Stringer("public key", committeeGroupKey.PublicKey()))
 		}
@@ -262,12 +265,6 @@ func updateNNSContract(ctx context.Context, prm updateNNSContractPrm) error {
 		return fmt.Errorf("encode local NEF of the NNS contract into binary: %w", err)
 	}
 
-	jLocalManifest, err := json.Marshal(prm.localManifest)
-	if err != nil {
-		// not really expected
-		return fmt.Errorf("encode local manifest of the NNS contract into JSON: %w", err)
-	}
-
 	committeeActor, err := newCommitteeNotaryActor(prm.blockchain, prm.localAcc, prm.committee)
 	if err != nil {
 		return fmt.Errorf("create Notary service client sending transactions to be signed by the committee: %w", err)
@@ -335,7 +332,13 @@ func updateNNSContract(ctx context.Context, prm updateNNSContractPrm) error {
 			continue
 		}
 
-		setGroupInManifest(&prm.localManifest, prm.localNEF, prm.committeeGroupKey, prm.localAcc.ScriptHash())
+		setGroupInManifest(&prm.localManifest, nnsOnChainState.Hash, prm.committeeGroupKey)
+
+		jLocalManifest, err := json.Marshal(prm.localManifest)
+		if err != nil {
+			// not really expected
+			return fmt.Errorf("encode local manifest of the NNS contract into JSON: %w", err)
+		}
 
 		// we pre-check 'already updated' case via MakeCall in order to not potentially
 		// wait for previously sent transaction to be expired (condition below) and
@@ -124,16 +124,20 @@ func enableNotary(ctx context.Context, prm enableNotaryPrm) error {
 
 // initDesignateNotaryRoleToLocalAccountTick returns a function that preserves
 // context of the Notary role designation to the local account between calls.
+//
+// Even single-acc committee is represented by 1/1 multi-signature account on
+// the chain, so the transaction is witnessed by both local and committee
+// accounts.
 func initDesignateNotaryRoleToLocalAccountTick(ctx context.Context, prm enableNotaryPrm) (func(), error) {
-	localActor, err := actor.NewSimple(prm.blockchain, prm.localAcc)
+	committeeActor, err := newCommitteeActor(prm.blockchain, prm.localAcc, prm.committee)
 	if err != nil {
-		return nil, fmt.Errorf("init transaction sender from local account: %w", err)
+		return nil, fmt.Errorf("init transaction sender with committee signers: %w", err)
 	}
 
-	roleContract := rolemgmt.New(localActor)
+	roleContract := rolemgmt.New(committeeActor)
 
 	// multi-tick context
-	txMonitor := newTransactionGroupMonitor(localActor)
+	txMonitor := newTransactionGroupMonitor(committeeActor)
 
 	return func() {
 		if txMonitor.isPending() {
@@ -846,6 +850,42 @@ func makeUnsignedDesignateCommitteeNotaryTx(roleContract *rolemgmt.Contract, com
 // service requests witnessed by the specified committee members to the provided
 // Blockchain. Given local account pays for transactions.
 func newCommitteeNotaryActor(b Blockchain, localAcc *wallet.Account, committee keys.PublicKeys) (*notary.Actor, error) {
+	return newCommitteeNotaryActorWithCustomCommitteeSigner(b, localAcc, committee, func(s *transaction.Signer) {
+		s.Scopes = transaction.CalledByEntry
+	})
+}
+
+// newCommitteeNotaryActorWithCustomCommitteeSigner is a version of
+// newCommitteeNotaryActor allowing to specify signer of the committee
+// multi-signature account (e.g. witness scope).
+func newCommitteeNotaryActorWithCustomCommitteeSigner(b Blockchain, localAcc *wallet.Account, committee keys.PublicKeys,
+	setCommitteeSigner func(*transaction.Signer)) (*notary.Actor, error) {
+	committeeSigners, err := makeCommitteeSigners(localAcc, committee, setCommitteeSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	return notary.NewActor(b, committeeSigners, localAcc)
+}
+
+// newCommitteeActor returns actor.Actor that builds and sends transactions
+// witnessed by the local account and the committee. It is applicable only if
+// the committee multi-signature can be completed by the local account, i.e.
+// for single-acc committee.
+func newCommitteeActor(b Blockchain, localAcc *wallet.Account, committee keys.PublicKeys) (*actor.Actor, error) {
+	committeeSigners, err := makeCommitteeSigners(localAcc, committee, func(s *transaction.Signer) {
+		s.Scopes = transaction.CalledByEntry
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return actor.New(b, committeeSigners)
+}
+
+// makeCommitteeSigners returns signers of the transactions paid by the local
+// account and witnessed by the committee.
+func makeCommitteeSigners(localAcc *wallet.Account, committee keys.PublicKeys, setCommitteeSigner func(*transaction.Signer)) ([]actor.SignerAccount, error) {
 	committeeMultiSigM := smartcontract.GetMajorityHonestNodeCount(len(committee))
 	committeeMultiSigAcc := wallet.NewAccountFromPrivateKey(localAcc.PrivateKey())
 
@@ -854,7 +894,13 @@ func newCommitteeNotaryActor(b Blockchain, localAcc *wallet.Account, committee k
 		return nil, fmt.Errorf("compose committee multi-signature account: %w", err)
 	}
 
-	return notary.NewActor(b, []actor.SignerAccount{
+	committeeSigner := transaction.Signer{
+		Account: committeeMultiSigAcc.ScriptHash(),
+	}
+
+	setCommitteeSigner(&committeeSigner)
+
+	return []actor.SignerAccount{
 		{
 			Signer: transaction.Signer{
 				Account: localAcc.ScriptHash(),
@@ -863,13 +909,10 @@ func newCommitteeNotaryActor(b Blockchain, localAcc *wallet.Account, committee k
 			Account: localAcc,
 		},
 		{
-			Signer: transaction.Signer{
-				Account: committeeMultiSigAcc.ScriptHash(),
-				Scopes:  transaction.CalledByEntry,
-			},
+			Signer:  committeeSigner,
 			Account: committeeMultiSigAcc,
 		},
-	}, localAcc)
+	}, nil
 }
 
 // Amount of GAS for the single local account's GAS->Notary transfer. Relatively
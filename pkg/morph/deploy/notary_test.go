@@ -0,0 +1,129 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/hash"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+// testTxBlockchain is a Blockchain allowing to make transactions only.
+type testTxBlockchain struct {
+	Blockchain
+}
+
+func (x testTxBlockchain) InvokeScript(script []byte, _ []transaction.Signer) (*result.Invoke, error) {
+	return &result.Invoke{State: vmstate.Halt.String(), Script: script}, nil
+}
+
+func (x testTxBlockchain) CalculateNetworkFee(*transaction.Transaction) (int64, error) {
+	return 0, nil
+}
+
+func (x testTxBlockchain) GetBlockCount() (uint32, error) {
+	return 1, nil
+}
+
+func (x testTxBlockchain) GetVersion() (*result.Version, error) {
+	return &result.Version{
+		Protocol: result.Protocol{
+			Network:                     netmode.UnitTestNet,
+			ValidatorsCount:             1,
+			MaxValidUntilBlockIncrement: 100,
+		},
+	}, nil
+}
+
+// requireSignedWitness checks that witness of the transaction contains
+// signature of the given key only.
+func requireSignedWitness(t testing.TB, tx *transaction.Transaction, w transaction.Witness, key *keys.PublicKey) {
+	const sigLen = keys.SignatureLen
+
+	require.Len(t, w.InvocationScript, 2+sigLen)
+	require.EqualValues(t, opcode.PUSHDATA1, w.InvocationScript[0])
+	require.EqualValues(t, sigLen, w.InvocationScript[1])
+	require.True(t, key.Verify(w.InvocationScript[2:], hash.NetSha256(uint32(netmode.UnitTestNet), tx).BytesBE()))
+}
+
+func TestNewCommitteeActor(t *testing.T) {
+	localKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	localAcc := wallet.NewAccountFromPrivateKey(localKey)
+	committee := keys.PublicKeys{localKey.PublicKey()}
+
+	committeeScript, err := smartcontract.CreateMajorityMultiSigRedeemScript(committee)
+	require.NoError(t, err)
+
+	committeeActor, err := newCommitteeActor(testTxBlockchain{}, localAcc, committee)
+	require.NoError(t, err)
+	require.Equal(t, localAcc.ScriptHash(), committeeActor.Sender())
+
+	tx, err := committeeActor.MakeRun([]byte{byte(opcode.RET)})
+	require.NoError(t, err)
+
+	require.Equal(t, []transaction.Signer{
+		{
+			Account: localAcc.ScriptHash(),
+			Scopes:  transaction.None,
+		},
+		{
+			// the chain checks witness of this account in committee methods
+			Account: hash.Hash160(committeeScript),
+			Scopes:  transaction.CalledByEntry,
+		},
+	}, tx.Signers)
+
+	// single-acc committee multi-signature is completed by the local account
+	require.Len(t, tx.Scripts, 2)
+	require.Equal(t, localAcc.Contract.Script, tx.Scripts[0].VerificationScript)
+	requireSignedWitness(t, tx, tx.Scripts[0], localKey.PublicKey())
+	require.Equal(t, committeeScript, tx.Scripts[1].VerificationScript)
+	requireSignedWitness(t, tx, tx.Scripts[1], localKey.PublicKey())
+}
+
+func TestMakeCommitteeSigners(t *testing.T) {
+	localKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	committee := keys.PublicKeys{localKey.PublicKey()}
+	for i := 0; i < 3; i++ {
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+		committee = append(committee, k.PublicKey())
+	}
+
+	committeeScript, err := smartcontract.CreateMajorityMultiSigRedeemScript(committee)
+	require.NoError(t, err)
+
+	localAcc := wallet.NewAccountFromPrivateKey(localKey)
+	groupKey := committee[1]
+
+	signers, err := makeCommitteeSigners(localAcc, committee, func(s *transaction.Signer) {
+		s.Scopes = transaction.CustomGroups
+		s.AllowedGroups = keys.PublicKeys{groupKey}
+	})
+	require.NoError(t, err)
+	require.Len(t, signers, 2)
+
+	require.Equal(t, transaction.Signer{
+		Account: localAcc.ScriptHash(),
+		Scopes:  transaction.None,
+	}, signers[0].Signer)
+	require.Equal(t, localAcc, signers[0].Account)
+
+	require.Equal(t, transaction.Signer{
+		Account:       hash.Hash160(committeeScript),
+		Scopes:        transaction.CustomGroups,
+		AllowedGroups: keys.PublicKeys{groupKey},
+	}, signers[1].Signer)
+	require.Equal(t, hash.Hash160(committeeScript), signers[1].Account.ScriptHash())
+}
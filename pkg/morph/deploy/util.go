@@ -48,8 +48,10 @@ func isErrContractAlreadyUpdated(err error) bool {
 	return strings.Contains(err.Error(), common.ErrAlreadyUpdated)
 }
 
-func setGroupInManifest(manif *manifest.Manifest, nefFile nef.File, groupPrivKey *keys.PrivateKey, deployerAcc util.Uint160) {
-	contractAddress := state.CreateContractHash(deployerAcc, nefFile.Checksum, manif.Name)
+// setGroupInManifest sets group with the given private key into the manifest of
+// the contract with specified address. Note that the address of the contract
+// does not change on update.
+func setGroupInManifest(manif *manifest.Manifest, contractAddress util.Uint160, groupPrivKey *keys.PrivateKey) {
 	sig := groupPrivKey.Sign(contractAddress.BytesBE())
 	groupPubKey := groupPrivKey.PublicKey()
 
@@ -0,0 +1,156 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	"go.uber.org/zap"
+)
+
+// replayRetryDelay is a delay b/w attempts to fetch the replayed block data.
+const replayRetryDelay = time.Second
+
+// replayResult is a result of the missed notifications replay.
+type replayResult struct {
+	// last block which notifications have been replayed
+	to uint32
+	// non-nil if replay has been interrupted
+	err error
+}
+
+// replay fetches notifications of the subscribed contracts from the blocks
+// starting from the given index up to the current chain height and sends them
+// to ch in the order they were emitted. Result is sent to res.
+func (s *subscriber) replay(ctx context.Context, from uint32, contracts map[util.Uint160]struct{},
+	ch chan<- *state.ContainedNotificationEvent, res chan<- replayResult) {
+	var (
+		count uint32
+		out   = replayResult{to: from - 1}
+	)
+
+	out.err = s.retry(ctx, func() (err error) {
+		count, err = s.client.BlockCount()
+		return err
+	})
+
+	if out.err == nil && from < count {
+		s.log.Info("replaying notifications of the missed blocks",
+			zap.Uint32("from", from),
+			zap.Uint32("to", count-1),
+		)
+	}
+
+	for i := from; out.err == nil && i < count; i++ {
+		var evs []*state.ContainedNotificationEvent
+
+		out.err = s.retry(ctx, func() (err error) {
+			evs, err = s.blockNotifications(i, contracts)
+			return err
+		})
+		if out.err != nil {
+			break
+		}
+
+		for _, ev := range evs {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- ev:
+			}
+		}
+
+		out.to = i
+	}
+
+	select {
+	case <-ctx.Done():
+	case res <- out:
+	}
+}
+
+// retry calls f until it succeeds, connection is lost or ctx is done.
+func (s *subscriber) retry(ctx context.Context, f func() error) error {
+	for {
+		err := f()
+		if err == nil || errors.Is(err, client.ErrConnectionLost) {
+			return err
+		}
+
+		s.log.Warn("could not fetch missed block data, retrying", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(replayRetryDelay):
+		}
+	}
+}
+
+// blockNotifications fetches notifications of the contracts emitted in the
+// block with the given index.
+func (s *subscriber) blockNotifications(index uint32, contracts map[util.Uint160]struct{}) ([]*state.ContainedNotificationEvent, error) {
+	b, err := s.client.BlockByIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	blockLog, err := s.client.ApplicationLog(b.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	txLogs := make([]*result.ApplicationLog, 0, len(b.Transactions))
+
+	for _, tx := range b.Transactions {
+		txLog, err := s.client.ApplicationLog(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+
+		txLogs = append(txLogs, txLog)
+	}
+
+	return filterNotifications(contracts, blockLog, txLogs), nil
+}
+
+// filterNotifications returns notifications of the contracts from the
+// successful executions of the block in the order they were emitted: block
+// OnPersist, transactions, block PostPersist.
+func filterNotifications(contracts map[util.Uint160]struct{}, blockLog *result.ApplicationLog,
+	txLogs []*result.ApplicationLog) []*state.ContainedNotificationEvent {
+	var res []*state.ContainedNotificationEvent
+
+	add := func(container util.Uint256, execs []state.Execution, trig trigger.Type) {
+		for _, exec := range execs {
+			if exec.Trigger != trig || exec.VMState != vmstate.Halt {
+				continue
+			}
+
+			for _, ev := range exec.Events {
+				if _, ok := contracts[ev.ScriptHash]; ok {
+					res = append(res, &state.ContainedNotificationEvent{
+						Container:         container,
+						NotificationEvent: ev,
+					})
+				}
+			}
+		}
+	}
+
+	add(blockLog.Container, blockLog.Executions, trigger.OnPersist)
+
+	for _, txLog := range txLogs {
+		add(txLog.Container, txLog.Executions, trigger.Application)
+	}
+
+	add(blockLog.Container, blockLog.Executions, trigger.PostPersist)
+
+	return res
+}
@@ -0,0 +1,69 @@
+package subscriber
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterNotifications(t *testing.T) {
+	var (
+		subscribed = util.Uint160{1}
+		other      = util.Uint160{2}
+
+		blockHash = util.Uint256{1}
+		txHash    = util.Uint256{2}
+		faultHash = util.Uint256{3}
+	)
+
+	exec := func(trig trigger.Type, st vmstate.State, names ...string) state.Execution {
+		res := state.Execution{Trigger: trig, VMState: st}
+		for _, name := range names {
+			res.Events = append(res.Events,
+				state.NotificationEvent{ScriptHash: subscribed, Name: name},
+				state.NotificationEvent{ScriptHash: other, Name: name},
+			)
+		}
+		return res
+	}
+
+	blockLog := &result.ApplicationLog{
+		Container: blockHash,
+		Executions: []state.Execution{
+			exec(trigger.PostPersist, vmstate.Halt, "post"),
+			exec(trigger.OnPersist, vmstate.Halt, "on"),
+		},
+	}
+
+	txLogs := []*result.ApplicationLog{
+		{
+			Container:     txHash,
+			IsTransaction: true,
+			Executions:    []state.Execution{exec(trigger.Application, vmstate.Halt, "tx1", "tx2")},
+		},
+		{
+			Container:     faultHash,
+			IsTransaction: true,
+			Executions:    []state.Execution{exec(trigger.Application, vmstate.Fault, "fault")},
+		},
+	}
+
+	res := filterNotifications(map[util.Uint160]struct{}{subscribed: {}}, blockLog, txLogs)
+
+	var names []string
+	for _, ev := range res {
+		require.Equal(t, subscribed, ev.ScriptHash)
+		names = append(names, ev.Name)
+	}
+
+	require.Equal(t, []string{"on", "tx1", "tx2", "post"}, names)
+	require.Equal(t, blockHash, res[0].Container)
+	require.Equal(t, txHash, res[1].Container)
+	require.Equal(t, txHash, res[2].Container)
+	require.Equal(t, blockHash, res[3].Container)
+}
@@ -49,9 +49,22 @@ type (
 		subscribedEvents       map[util.Uint160]bool
 		subscribedNotaryEvents map[util.Uint160]bool
 		subscribedToNewBlocks  bool
+
+		// replayRequested is set on the first notification subscription
+		replayRequested bool
+		replayReq       chan struct{}
+
+		// last block which notifications have been routed, accessed from
+		// routeNotifications only
+		lastBlock uint32
 	}
 
 	// Params is a group of Subscriber constructor parameters.
+	//
+	// StartFromBlock is the last processed block. If set, Subscriber requires
+	// RPC node to have it and replays notifications of the subsequent blocks
+	// on the first notification subscription before the live ones. Blocks
+	// missed while switching RPC nodes are replayed the same way.
 	Params struct {
 		Log            *zap.Logger
 		StartFromBlock uint32
@@ -106,6 +119,11 @@ func (s *subscriber) SubscribeForNotification(contracts ...util.Uint160) error {
 		s.subscribedEvents[contracts[i]] = true
 	}
 
+	if !s.replayRequested && len(contracts) > 0 {
+		s.replayRequested = true
+		s.replayReq <- struct{}{}
+	}
+
 	return nil
 }
 
@@ -172,6 +190,9 @@ func New(ctx context.Context, p *Params) (Subscriber, error) {
 
 		subscribedEvents:       make(map[util.Uint160]bool),
 		subscribedNotaryEvents: make(map[util.Uint160]bool),
+
+		replayReq: make(chan struct{}, 1),
+		lastBlock: p.StartFromBlock,
 	}
 	// Worker listens all events from temporary NeoGo channel and puts them
 	// into corresponding permanent channels.
@@ -186,8 +207,49 @@ func (s *subscriber) routeNotifications(ctx context.Context) {
 		cliCh             = s.client.NotificationChannel()
 		restoreCh         = make(chan bool)
 		restoreInProgress bool
+
+		// live notifications and blocks are queued while missed ones are
+		// replayed, replayed containers are tracked to skip duplicates
+		replayCh      = make(chan *state.ContainedNotificationEvent)
+		replayResCh   = make(chan replayResult)
+		replaying     bool
+		replayPending bool
+		replayed      = make(map[util.Uint256]struct{})
+		queue         []any
 	)
 
+	replayCtx, cancelReplay := context.WithCancel(ctx)
+	defer cancelReplay()
+
+	startReplay := func() {
+		if replaying {
+			replayPending = true
+			return
+		}
+
+		if s.lastBlock == 0 {
+			// nothing is known about processed blocks
+			return
+		}
+
+		s.RLock()
+		contracts := make(map[util.Uint160]struct{}, len(s.subscribedEvents))
+		for c := range s.subscribedEvents {
+			contracts[c] = struct{}{}
+		}
+		s.RUnlock()
+
+		replaying = true
+		go s.replay(replayCtx, s.lastBlock+1, contracts, replayCh, replayResCh)
+	}
+
+	routeBlock := func(b *block.Block) {
+		s.blockChan <- b
+		if b.Index > s.lastBlock {
+			s.lastBlock = b.Index
+		}
+	}
+
 routeloop:
 	for {
 		var connLost bool
@@ -200,16 +262,22 @@ routeloop:
 		case <-ctx.Done():
 			break routeloop
 		case ev, ok := <-notifCh:
-			if ok {
-				s.notifyChan <- ev
-			} else {
+			switch {
+			case !ok:
 				connLost = true
+			case replaying:
+				queue = append(queue, ev)
+			default:
+				s.notifyChan <- ev
 			}
 		case ev, ok := <-blCh:
-			if ok {
-				s.blockChan <- ev
-			} else {
+			switch {
+			case !ok:
 				connLost = true
+			case replaying:
+				queue = append(queue, ev)
+			default:
+				routeBlock(ev)
 			}
 		case ev, ok := <-notaryCh:
 			if ok {
@@ -223,6 +291,51 @@ routeloop:
 			restoreInProgress = false
 			if !ok {
 				connLost = true
+			} else {
+				startReplay()
+			}
+		case <-s.replayReq:
+			startReplay()
+		case ev := <-replayCh:
+			replayed[ev.Container] = struct{}{}
+			s.notifyChan <- ev
+		case res := <-replayResCh:
+			replaying = false
+
+			if res.to > s.lastBlock {
+				s.lastBlock = res.to
+			}
+
+			if res.err != nil {
+				s.log.Error("missed notifications replay has been interrupted",
+					zap.Uint32("last replayed block", res.to),
+					zap.Error(res.err),
+				)
+			} else {
+				s.log.Info("missed notifications have been replayed",
+					zap.Uint32("last replayed block", res.to),
+				)
+			}
+
+			if replayPending {
+				// connection was switched during replay, keep queueing
+				// until the blocks missed while switching are replayed
+				replayPending = false
+				startReplay()
+			} else {
+				for _, v := range queue {
+					switch v := v.(type) {
+					case *state.ContainedNotificationEvent:
+						if _, ok := replayed[v.Container]; !ok {
+							s.notifyChan <- v
+						}
+					case *block.Block:
+						routeBlock(v)
+					}
+				}
+
+				queue = nil
+				replayed = make(map[util.Uint256]struct{})
 			}
 		}
 		if connLost {
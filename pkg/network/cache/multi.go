@@ -10,6 +10,7 @@ import (
 	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -17,6 +18,7 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	reputationSDK "github.com/nspcc-dev/neofs-sdk-go/reputation"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -131,7 +133,7 @@ loop:
 
 var errRecentlyFailed = errors.New("client has recently failed, skipping")
 
-func (x *multiClient) iterateClients(ctx context.Context, f func(clientcore.Client) error) error {
+func (x *multiClient) iterateClients(ctx context.Context, f func(context.Context, clientcore.Client) error) error {
 	var firstErr error
 
 	x.addrMtx.RLock()
@@ -148,11 +150,16 @@ func (x *multiClient) iterateClients(ctx context.Context, f func(clientcore.Clie
 
 		var err error
 
+		reqCtx, span := tracing.StartSpan(ctx, "multiClient.request",
+			attribute.String("address", addr.String()))
+
 		c, err := x.client(addr)
 		if err == nil {
-			err = f(c)
+			err = f(tracing.InjectGRPC(reqCtx), c)
 		}
 
+		tracing.EndSpan(span, err)
+
 		// non-status logic error that could be returned
 		// from the SDK client; should not be considered
 		// as a connection error
@@ -210,7 +217,7 @@ func (s *singleClient) invalidate() {
 }
 
 func (x *multiClient) ObjectPutInit(ctx context.Context, header objectSDK.Object, signer user.Signer, p client.PrmObjectPutInit) (res client.ObjectWriter, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectPutInit(ctx, header, signer, p)
 		return err
 	})
@@ -219,13 +226,13 @@ func (x *multiClient) ObjectPutInit(ctx context.Context, header objectSDK.Object
 }
 
 func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, announcements []container.SizeEstimation, prm client.PrmAnnounceSpace) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		return c.ContainerAnnounceUsedSpace(ctx, announcements, prm)
 	})
 }
 
 func (x *multiClient) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (tombID oid.ID, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		tombID, err = c.ObjectDelete(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -234,7 +241,7 @@ func (x *multiClient) ObjectDelete(ctx context.Context, containerID cid.ID, obje
 }
 
 func (x *multiClient) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (hdr objectSDK.Object, rdr *client.PayloadReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		hdr, rdr, err = c.ObjectGetInit(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -243,7 +250,7 @@ func (x *multiClient) ObjectGetInit(ctx context.Context, containerID cid.ID, obj
 }
 
 func (x *multiClient) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm client.PrmObjectRange) (res *client.ObjectRangeReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectRangeInit(ctx, containerID, objectID, offset, length, signer, prm)
 		return err
 	})
@@ -252,7 +259,7 @@ func (x *multiClient) ObjectRangeInit(ctx context.Context, containerID cid.ID, o
 }
 
 func (x *multiClient) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (res *objectSDK.Object, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectHead(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -261,7 +268,7 @@ func (x *multiClient) ObjectHead(ctx context.Context, containerID cid.ID, object
 }
 
 func (x *multiClient) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHash) (res [][]byte, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectHash(ctx, containerID, objectID, signer, prm)
 		return err
 	})
@@ -270,7 +277,7 @@ func (x *multiClient) ObjectHash(ctx context.Context, containerID cid.ID, object
 }
 
 func (x *multiClient) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm client.PrmObjectSearch) (res *client.ObjectListReader, err error) {
-	err = x.iterateClients(ctx, func(c clientcore.Client) error {
+	err = x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		res, err = c.ObjectSearchInit(ctx, containerID, signer, prm)
 		return err
 	})
@@ -279,13 +286,13 @@ func (x *multiClient) ObjectSearchInit(ctx context.Context, containerID cid.ID,
 }
 
 func (x *multiClient) AnnounceLocalTrust(ctx context.Context, epoch uint64, trusts []reputationSDK.Trust, prm client.PrmAnnounceLocalTrust) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		return c.AnnounceLocalTrust(ctx, epoch, trusts, prm)
 	})
 }
 
 func (x *multiClient) AnnounceIntermediateTrust(ctx context.Context, epoch uint64, trust reputationSDK.PeerToPeerTrust, prm client.PrmAnnounceIntermediateTrust) error {
-	return x.iterateClients(ctx, func(c clientcore.Client) error {
+	return x.iterateClients(ctx, func(ctx context.Context, c clientcore.Client) error {
 		return c.AnnounceIntermediateTrust(ctx, epoch, trust, prm)
 	})
 }
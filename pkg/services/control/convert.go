@@ -200,3 +200,75 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type listTrustsResponseWrapper struct {
+	*ListTrustsResponse
+}
+
+func (w *listTrustsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ListTrustsResponse
+}
+
+func (w *listTrustsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ListTrustsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ListTrustsResponse)(nil))
+	}
+
+	w.ListTrustsResponse = r
+	return nil
+}
+
+type getContainerUsageResponseWrapper struct {
+	*GetContainerUsageResponse
+}
+
+func (w *getContainerUsageResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetContainerUsageResponse
+}
+
+func (w *getContainerUsageResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetContainerUsageResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetContainerUsageResponse)(nil))
+	}
+
+	w.GetContainerUsageResponse = r
+	return nil
+}
+
+type setDecommissionResponseWrapper struct {
+	*SetDecommissionResponse
+}
+
+func (w *setDecommissionResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.SetDecommissionResponse
+}
+
+func (w *setDecommissionResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*SetDecommissionResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*SetDecommissionResponse)(nil))
+	}
+
+	w.SetDecommissionResponse = r
+	return nil
+}
+
+type getDecommissionStatusResponseWrapper struct {
+	*GetDecommissionStatusResponse
+}
+
+func (w *getDecommissionStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetDecommissionStatusResponse
+}
+
+func (w *getDecommissionStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetDecommissionStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetDecommissionStatusResponse)(nil))
+	}
+
+	w.GetDecommissionStatusResponse = r
+	return nil
+}
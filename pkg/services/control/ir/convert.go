@@ -32,3 +32,22 @@ func (w *healthCheckResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 
 	return nil
 }
+
+type settlementReportResponseWrapper struct {
+	m *SettlementReportResponse
+}
+
+func (w *settlementReportResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *settlementReportResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*SettlementReportResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
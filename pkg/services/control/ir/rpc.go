@@ -8,7 +8,8 @@ import (
 const serviceName = "ircontrol.ControlService"
 
 const (
-	rpcHealthCheck = "HealthCheck"
+	rpcHealthCheck      = "HealthCheck"
+	rpcSettlementReport = "SettlementReport"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -32,3 +33,25 @@ func HealthCheck(
 
 	return wResp.m, nil
 }
+
+// SettlementReport executes ControlService.SettlementReport RPC.
+func SettlementReport(
+	cli *client.Client,
+	req *SettlementReportRequest,
+	opts ...client.CallOption,
+) (*SettlementReportResponse, error) {
+	wResp := &settlementReportResponseWrapper{
+		m: new(SettlementReportResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSettlementReport), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
@@ -1,6 +1,9 @@
 package control
 
-import control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+)
 
 // HealthChecker is component interface for calculating
 // the current health status of a node.
@@ -11,3 +14,11 @@ type HealthChecker interface {
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
 }
+
+// SettlementReporter is component interface for calculating
+// settlement payments without transferring them.
+type SettlementReporter interface {
+	// Must calculate and return all settlement payments
+	// for the data stored during the given epoch.
+	Report(epoch uint64) ([]common.Payment, error)
+}
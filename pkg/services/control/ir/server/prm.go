@@ -10,6 +10,8 @@ type Prm struct {
 	key keys.PrivateKey
 
 	healthChecker HealthChecker
+
+	settlementReporter SettlementReporter
 }
 
 // SetPrivateKey sets private key to sign responses.
@@ -22,3 +24,10 @@ func (x *Prm) SetPrivateKey(key keys.PrivateKey) {
 func (x *Prm) SetHealthChecker(hc HealthChecker) {
 	x.healthChecker = hc
 }
+
+// SetSettlementReporter sets SettlementReporter to calculate
+// settlement payments. If not set, settlement report
+// requests are not supported.
+func (x *Prm) SetSettlementReporter(r SettlementReporter) {
+	x.settlementReporter = r
+}
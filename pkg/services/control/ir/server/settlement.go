@@ -0,0 +1,98 @@
+package control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SettlementReport calculates settlement payments for the data stored
+// during the requested epoch without transferring them.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) SettlementReport(_ context.Context, req *control.SettlementReportRequest) (*control.SettlementReportResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.prm.settlementReporter == nil {
+		return nil, status.Error(codes.Unimplemented, "settlement reports are not supported")
+	}
+
+	payments, err := s.prm.settlementReporter.Report(req.GetBody().GetEpoch())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := &control.SettlementReportResponse_Body{
+		Payments: make([]*control.SettlementPayment, 0, len(payments)),
+	}
+
+	for i := range payments {
+		p, err := paymentToProto(payments[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		body.Payments = append(body.Payments, p)
+	}
+
+	resp := &control.SettlementReportResponse{Body: body}
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func paymentToProto(p common.Payment) (*control.SettlementPayment, error) {
+	res := &control.SettlementPayment{
+		NodeKey: p.Node,
+		From:    p.From.WalletBytes(),
+		To:      p.To.WalletBytes(),
+		Size:    p.Size,
+	}
+
+	switch p.Type {
+	case common.BasicIncomeCollection:
+		res.Type = control.SettlementType_BASIC_INCOME_COLLECTION
+	case common.BasicIncomeDistribution:
+		res.Type = control.SettlementType_BASIC_INCOME_DISTRIBUTION
+	case common.AuditReward:
+		res.Type = control.SettlementType_AUDIT_REWARD
+	case common.AuditFee:
+		res.Type = control.SettlementType_AUDIT_FEE
+	default:
+		res.Type = control.SettlementType_SETTLEMENT_TYPE_UNDEFINED
+	}
+
+	if p.Container != (cid.ID{}) {
+		res.ContainerId = p.Container[:]
+	}
+
+	if p.Price != nil {
+		if !p.Price.IsUint64() {
+			return nil, fmt.Errorf("price %s can not be represented as uint64", p.Price)
+		}
+
+		res.Price = p.Price.Uint64()
+	}
+
+	if p.Amount != nil {
+		if !p.Amount.IsUint64() {
+			return nil, fmt.Errorf("amount %s can not be represented as uint64", p.Amount)
+		}
+
+		res.Amount = p.Amount.Uint64()
+	}
+
+	return res, nil
+}
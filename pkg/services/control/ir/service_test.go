@@ -42,3 +42,34 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetHealthStatus() == b2.GetHealthStatus()
 }
+
+func TestSettlementReportResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateSettlementReportResponseBody(),
+		new(control.SettlementReportResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateSettlementReportResponseBody() *control.SettlementReportResponse_Body {
+	return &control.SettlementReportResponse_Body{
+		Payments: []*control.SettlementPayment{
+			{
+				Type:        control.SettlementType_BASIC_INCOME_COLLECTION,
+				ContainerId: []byte{1, 2, 3},
+				From:        []byte{4, 5, 6},
+				To:          []byte{7, 8, 9},
+				Size:        1 << 30,
+				Price:       100,
+				Amount:      200,
+			},
+			{
+				Type:    control.SettlementType_AUDIT_FEE,
+				NodeKey: []byte{10, 11, 12},
+				Amount:  300,
+			},
+		},
+	}
+}
@@ -8,16 +8,20 @@ import (
 const serviceName = "control.ControlService"
 
 const (
-	rpcHealthCheck     = "HealthCheck"
-	rpcSetNetmapStatus = "SetNetmapStatus"
-	rpcDropObjects     = "DropObjects"
-	rpcListShards      = "ListShards"
-	rpcSetShardMode    = "SetShardMode"
-	rpcDumpShard       = "DumpShard"
-	rpcRestoreShard    = "RestoreShard"
-	rpcSynchronizeTree = "SynchronizeTree"
-	rpcEvacuateShard   = "EvacuateShard"
-	rpcFlushCache      = "FlushCache"
+	rpcHealthCheck           = "HealthCheck"
+	rpcSetNetmapStatus       = "SetNetmapStatus"
+	rpcDropObjects           = "DropObjects"
+	rpcListShards            = "ListShards"
+	rpcSetShardMode          = "SetShardMode"
+	rpcDumpShard             = "DumpShard"
+	rpcRestoreShard          = "RestoreShard"
+	rpcSynchronizeTree       = "SynchronizeTree"
+	rpcEvacuateShard         = "EvacuateShard"
+	rpcFlushCache            = "FlushCache"
+	rpcListTrusts            = "ListTrusts"
+	rpcGetContainerUsage     = "GetContainerUsage"
+	rpcSetDecommission       = "SetDecommission"
+	rpcGetDecommissionStatus = "GetDecommissionStatus"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -191,3 +195,55 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// ListTrusts executes ControlService.ListTrusts RPC.
+func ListTrusts(cli *client.Client, req *ListTrustsRequest, opts ...client.CallOption) (*ListTrustsResponse, error) {
+	wResp := &listTrustsResponseWrapper{new(ListTrustsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListTrusts), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ListTrustsResponse, nil
+}
+
+// GetContainerUsage executes ControlService.GetContainerUsage RPC.
+func GetContainerUsage(cli *client.Client, req *GetContainerUsageRequest, opts ...client.CallOption) (*GetContainerUsageResponse, error) {
+	wResp := &getContainerUsageResponseWrapper{new(GetContainerUsageResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetContainerUsage), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetContainerUsageResponse, nil
+}
+
+// SetDecommission executes ControlService.SetDecommission RPC.
+func SetDecommission(cli *client.Client, req *SetDecommissionRequest, opts ...client.CallOption) (*SetDecommissionResponse, error) {
+	wResp := &setDecommissionResponseWrapper{new(SetDecommissionResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetDecommission), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.SetDecommissionResponse, nil
+}
+
+// GetDecommissionStatus executes ControlService.GetDecommissionStatus RPC.
+func GetDecommissionStatus(cli *client.Client, req *GetDecommissionStatusRequest, opts ...client.CallOption) (*GetDecommissionStatusResponse, error) {
+	wResp := &getDecommissionStatusResponseWrapper{new(GetDecommissionStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetDecommissionStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetDecommissionStatusResponse, nil
+}
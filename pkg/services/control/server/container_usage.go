@@ -0,0 +1,54 @@
+package control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetContainerUsage returns current usage of the container storage on the
+// storage node: number of the available root objects and their size.
+func (s *Server) GetContainerUsage(_ context.Context, req *control.GetContainerUsageRequest) (*control.GetContainerUsageResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var cnr cid.ID
+	if err := cnr.Decode(req.GetBody().GetContainerId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid container ID: %v", err))
+	}
+
+	objects, err := s.s.ContainerObjects(cnr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not count container objects: %v", err))
+	}
+
+	size, err := engine.ContainerSize(s.s, cnr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not get container size: %v", err))
+	}
+
+	resp := &control.GetContainerUsageResponse{
+		Body: &control.GetContainerUsageResponse_Body{
+			Report: &control.ContainerUsageReport{
+				PublicKey: (*keys.PublicKey)(&s.key.PublicKey).Bytes(),
+				Objects:   objects,
+				Size:      size,
+			},
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetDecommission starts or stops decommission of the storage node.
+func (s *Server) SetDecommission(_ context.Context, req *control.SetDecommissionRequest) (*control.SetDecommissionResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.decommissioner == nil {
+		return nil, status.Error(codes.Unimplemented, "decommission is not supported")
+	}
+
+	if req.GetBody().GetEnabled() {
+		err = s.decommissioner.StartDecommission()
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	} else {
+		s.decommissioner.StopDecommission()
+	}
+
+	resp := &control.SetDecommissionResponse{
+		Body: &control.SetDecommissionResponse_Body{},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// GetDecommissionStatus returns decommission progress of the storage node.
+func (s *Server) GetDecommissionStatus(_ context.Context, req *control.GetDecommissionStatusRequest) (*control.GetDecommissionStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.decommissioner == nil {
+		return nil, status.Error(codes.Unimplemented, "decommission is not supported")
+	}
+
+	st := s.decommissioner.DecommissionStatus()
+
+	resp := &control.GetDecommissionStatusResponse{
+		Body: &control.GetDecommissionStatusResponse_Body{
+			Status: &control.DecommissionStatus{
+				Enabled:    st.Enabled,
+				Completed:  st.Completed,
+				Passes:     st.Passes,
+				Processed:  st.Processed,
+				Objects:    st.Objects,
+				Replicated: st.Replicated,
+				Failed:     st.Failed,
+			},
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
+	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTrusts returns local and global trusts of the storage node to its peers.
+func (s *Server) ListTrusts(_ context.Context, req *control.ListTrustsRequest) (*control.ListTrustsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.trustSource == nil {
+		return nil, status.Error(codes.Unimplemented, "reputation service is not available")
+	}
+
+	epoch := req.GetBody().GetEpoch()
+	if epoch == 0 {
+		epoch, err = s.netMapSrc.Epoch()
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("could not get current epoch: %v", err))
+		}
+
+		if epoch > 0 {
+			epoch--
+		}
+	}
+
+	localTrusts, err := s.trustSource.LocalTrusts(epoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var sum reputation.TrustValue
+
+	for i := range localTrusts {
+		sum.Add(localTrusts[i].Value)
+	}
+
+	peers := make([]apireputation.PeerID, len(localTrusts))
+
+	for i := range localTrusts {
+		peers[i] = localTrusts[i].Peer
+	}
+
+	globalTrusts, err := s.trustSource.GlobalTrusts(epoch, peers)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	trusts := make([]*control.PeerTrust, 0, len(localTrusts))
+
+	for i, lt := range localTrusts {
+		t := &control.PeerTrust{
+			PublicKey:   lt.Peer.PublicKey(),
+			Scores:      make([]*control.TrustScore, 0, len(lt.Scores)),
+			GlobalTrust: globalTrusts[i],
+		}
+
+		if !sum.IsZero() {
+			t.LocalTrust = lt.Value.Div(sum).Float64()
+		}
+
+		for name, v := range lt.Scores {
+			t.Scores = append(t.Scores, &control.TrustScore{
+				Source: name,
+				Value:  v.Float64(),
+			})
+		}
+
+		sort.Slice(t.Scores, func(i, j int) bool {
+			return t.Scores[i].Source < t.Scores[j].Source
+		})
+
+		trusts = append(trusts, t)
+	}
+
+	resp := &control.ListTrustsResponse{
+		Body: &control.ListTrustsResponse_Body{
+			Epoch:  epoch,
+			Trusts: trusts,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/decommission"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
+	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
 )
 
 // Server is an entity that serves
@@ -45,6 +48,33 @@ type NodeState interface {
 	ForceMaintenance() error
 }
 
+// TrustSource is an interface of the storage node reputation values provider.
+type TrustSource interface {
+	// LocalTrusts must return not normalized local trusts of the node
+	// to its peers collected during the epoch.
+	LocalTrusts(epoch uint64) ([]truststorage.PeerTrust, error)
+
+	// GlobalTrusts must return global trusts of the peers calculated for
+	// the epoch in the order of the peers. Zero must be returned for the
+	// peers whose trust is not calculated.
+	GlobalTrusts(epoch uint64, peers []apireputation.PeerID) ([]float64, error)
+}
+
+// Decommissioner is an interface of the storage node decommission manager.
+type Decommissioner interface {
+	// StartDecommission starts decommission of the storage node. Must return
+	// an error if the node can not be decommissioned at the moment.
+	StartDecommission() error
+
+	// StopDecommission stops decommission of the storage node and returns it
+	// to normal operation.
+	StopDecommission()
+
+	// DecommissionStatus must return current decommission progress of the
+	// storage node.
+	DecommissionStatus() decommission.Status
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -65,6 +95,10 @@ type cfg struct {
 
 	treeService TreeService
 
+	trustSource TrustSource
+
+	decommissioner Decommissioner
+
 	s *engine.StorageEngine
 }
 
@@ -151,3 +185,18 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithTrustSource returns an option to set reputation values provider.
+func WithTrustSource(src TrustSource) Option {
+	return func(c *cfg) {
+		c.trustSource = src
+	}
+}
+
+// WithDecommissioner returns an option to set storage node decommission
+// manager.
+func WithDecommissioner(d Decommissioner) Option {
+	return func(c *cfg) {
+		c.decommissioner = d
+	}
+}
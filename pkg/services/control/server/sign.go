@@ -43,10 +43,20 @@ func (s *Server) isValidRequest(req SignedMessage) error {
 		return errDisallowedKey
 	}
 
-	// verify signature
-	binBody, err := req.ReadSignedData(nil)
+	return VerifyMessage(req)
+}
+
+// VerifyMessage checks signature of Control service message.
+func VerifyMessage(msg SignedMessage) error {
+	sign := msg.GetSignature()
+	if sign == nil {
+		// TODO(@cthulhu-rider): #1387 use "const" error
+		return errors.New("missing signature")
+	}
+
+	binBody, err := msg.ReadSignedData(nil)
 	if err != nil {
-		return fmt.Errorf("marshal request body: %w", err)
+		return fmt.Errorf("marshal message body: %w", err)
 	}
 
 	var pubKey neofsecdsa.PublicKey
@@ -176,3 +176,133 @@ func TestSynchronizeTreeRequest_Body_StableMarshal(t *testing.T) {
 		},
 	)
 }
+
+func TestListTrustsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.ListTrustsResponse_Body{
+			Epoch: 13,
+			Trusts: []*control.PeerTrust{
+				{
+					PublicKey:  []byte{1, 2, 3},
+					LocalTrust: 0.25,
+					Scores: []*control.TrustScore{
+						{Source: "requests", Value: 0.5},
+						{Source: "latency", Value: 1},
+					},
+					GlobalTrust: 0.125,
+				},
+				{
+					PublicKey:  []byte{4, 5, 6},
+					LocalTrust: 0.75,
+				},
+			},
+		},
+		new(control.ListTrustsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.ListTrustsResponse_Body)
+			b2 := m2.(*control.ListTrustsResponse_Body)
+
+			if b1.GetEpoch() != b2.GetEpoch() || len(b1.GetTrusts()) != len(b2.GetTrusts()) {
+				return false
+			}
+
+			for i, t1 := range b1.GetTrusts() {
+				t2 := b2.GetTrusts()[i]
+
+				if !bytes.Equal(t1.GetPublicKey(), t2.GetPublicKey()) ||
+					t1.GetLocalTrust() != t2.GetLocalTrust() ||
+					t1.GetGlobalTrust() != t2.GetGlobalTrust() ||
+					len(t1.GetScores()) != len(t2.GetScores()) {
+					return false
+				}
+
+				for j, s1 := range t1.GetScores() {
+					s2 := t2.GetScores()[j]
+					if s1.GetSource() != s2.GetSource() || s1.GetValue() != s2.GetValue() {
+						return false
+					}
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func TestGetContainerUsageRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetContainerUsageRequest_Body{
+			ContainerId: []byte{1, 2, 3},
+		},
+		new(control.GetContainerUsageRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return bytes.Equal(
+				m1.(*control.GetContainerUsageRequest_Body).GetContainerId(),
+				m2.(*control.GetContainerUsageRequest_Body).GetContainerId(),
+			)
+		},
+	)
+}
+
+func TestGetContainerUsageResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetContainerUsageResponse_Body{
+			Report: &control.ContainerUsageReport{
+				PublicKey: []byte{7, 8, 9},
+				Objects:   10,
+				Size:      4096,
+			},
+		},
+		new(control.GetContainerUsageResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			r1 := m1.(*control.GetContainerUsageResponse_Body).GetReport()
+			r2 := m2.(*control.GetContainerUsageResponse_Body).GetReport()
+
+			return bytes.Equal(r1.GetPublicKey(), r2.GetPublicKey()) &&
+				r1.GetObjects() == r2.GetObjects() &&
+				r1.GetSize() == r2.GetSize()
+		},
+	)
+}
+
+func TestSetDecommissionRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.SetDecommissionRequest_Body{
+			Enabled: true,
+		},
+		new(control.SetDecommissionRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return m1.(*control.SetDecommissionRequest_Body).GetEnabled() ==
+				m2.(*control.SetDecommissionRequest_Body).GetEnabled()
+		},
+	)
+}
+
+func TestGetDecommissionStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.GetDecommissionStatusResponse_Body{
+			Status: &control.DecommissionStatus{
+				Enabled:    true,
+				Completed:  true,
+				Passes:     2,
+				Processed:  5,
+				Objects:    10,
+				Replicated: 3,
+				Failed:     1,
+			},
+		},
+		new(control.GetDecommissionStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			s1 := m1.(*control.GetDecommissionStatusResponse_Body).GetStatus()
+			s2 := m2.(*control.GetDecommissionStatusResponse_Body).GetStatus()
+
+			return s1.GetEnabled() == s2.GetEnabled() &&
+				s1.GetCompleted() == s2.GetCompleted() &&
+				s1.GetPasses() == s2.GetPasses() &&
+				s1.GetProcessed() == s2.GetProcessed() &&
+				s1.GetObjects() == s2.GetObjects() &&
+				s1.GetReplicated() == s2.GetReplicated() &&
+				s1.GetFailed() == s2.GetFailed()
+		},
+	)
+}
@@ -0,0 +1,249 @@
+package decommission
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Decommissioner represents the utility that prepares the storage node for
+// the permanent shutdown. While the node is being decommissioned, it keeps
+// serving reads, but is no longer a primary target of the new objects.
+// Decommissioner walks through all local objects and makes sure that each of
+// them is stored on enough other container nodes, replicating it if needed.
+type Decommissioner struct {
+	*cfg
+
+	enabled atomic.Bool
+
+	// serializes Start and Stop calls
+	switchMtx sync.Mutex
+	// cancels the worker routine, nil if decommission is not running
+	cancel context.CancelFunc
+	// closed when the worker routine is finished
+	done chan struct{}
+
+	// protects status and stored
+	mtx    sync.Mutex
+	status Status
+	// objects stored locally since the current pass started
+	stored map[oid.Address]object.Type
+}
+
+// Status describes current decommission progress of the storage node.
+type Status struct {
+	// Enabled is set if the node is being decommissioned.
+	Enabled bool
+
+	// Completed is set if the last full pass over the local objects found no
+	// objects lacking replicas on other container nodes, all objects stored
+	// locally after the pass started have been checked too, and the
+	// decommission state had been published in the network map before the pass
+	// started. The node can be switched off safely in this case.
+	Completed bool
+
+	// Passes is a number of full passes over the local objects finished since
+	// the decommission start.
+	Passes uint64
+
+	// Processed is a number of objects processed during the current pass.
+	Processed uint64
+
+	// Objects is a number of local objects checked during the last full pass.
+	Objects uint64
+
+	// Replicated is a number of objects replicated to other container nodes
+	// during the last full pass.
+	Replicated uint64
+
+	// Failed is a number of objects which still lacked replicas on other
+	// container nodes after the last full pass.
+	Failed uint64
+}
+
+// Option is an option for Decommissioner constructor.
+type Option func(*cfg)
+
+// objectDrainer makes sure that the local object is stored on enough
+// container nodes other than the local one.
+type objectDrainer interface {
+	Drain(ctx context.Context, addr objectcore.AddressWithType) policer.DrainResult
+}
+
+// NetworkState provides the decommission state of the local node seen by the
+// other nodes.
+type NetworkState interface {
+	// IsLocalNodeDecommissioning checks if the local node announces the
+	// decommission in the current network map. Until then, other nodes count
+	// local replicas and may remove the copies handed over to them.
+	IsLocalNodeDecommissioning() bool
+}
+
+type cfg struct {
+	log *zap.Logger
+
+	netState NetworkState
+
+	localStorage *engine.StorageEngine
+
+	drainer objectDrainer
+
+	batchSize uint32
+
+	passInterval time.Duration
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log:          zap.L(),
+		batchSize:    10,
+		passInterval: time.Minute,
+	}
+}
+
+// New creates, initializes and returns Decommissioner instance.
+func New(opts ...Option) *Decommissioner {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	c.log = c.log.With(zap.String("component", "Decommissioner"))
+
+	return &Decommissioner{
+		cfg: c,
+	}
+}
+
+// IsDecommissioning checks if the storage node is being decommissioned.
+func (d *Decommissioner) IsDecommissioning() bool {
+	return d.enabled.Load()
+}
+
+// Start starts decommission of the storage node in the background. Does
+// nothing if decommission is already running.
+func (d *Decommissioner) Start() {
+	d.switchMtx.Lock()
+	defer d.switchMtx.Unlock()
+
+	if d.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	d.cancel = cancel
+	d.done = make(chan struct{})
+
+	d.mtx.Lock()
+	d.status = Status{Enabled: true}
+	d.mtx.Unlock()
+
+	d.enabled.Store(true)
+
+	go d.run(ctx, d.done)
+
+	d.log.Info("started local node's decommission")
+}
+
+// Stop stops decommission of the storage node and blocks until the background
+// routine is finished. Does nothing if decommission is not running.
+func (d *Decommissioner) Stop() {
+	d.switchMtx.Lock()
+	defer d.switchMtx.Unlock()
+
+	if d.cancel == nil {
+		return
+	}
+
+	d.cancel()
+	<-d.done
+
+	d.cancel, d.done = nil, nil
+
+	d.enabled.Store(false)
+
+	d.mtx.Lock()
+	d.status = Status{}
+	d.stored = nil
+	d.mtx.Unlock()
+
+	d.log.Info("stopped local node's decommission")
+}
+
+// HandleStoredObject submits the object stored locally while the node is being
+// decommissioned. Such objects may be missed by the current pass over the local
+// objects, so the decommission is not reported as completed until they are
+// checked too. Does nothing if decommission is not running.
+func (d *Decommissioner) HandleStoredObject(addr objectcore.AddressWithType) {
+	if !d.enabled.Load() {
+		return
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if d.stored == nil {
+		d.stored = make(map[oid.Address]object.Type)
+	}
+
+	d.stored[addr.Address] = addr.Type
+	d.status.Completed = false
+}
+
+// Status returns current decommission progress of the storage node.
+func (d *Decommissioner) Status() Status {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.status
+}
+
+// WithLogger returns option to set Logger of Decommissioner.
+func WithLogger(v *zap.Logger) Option {
+	return func(c *cfg) {
+		c.log = v
+	}
+}
+
+// WithLocalStorage returns option to set local object storage of
+// Decommissioner.
+func WithLocalStorage(v *engine.StorageEngine) Option {
+	return func(c *cfg) {
+		c.localStorage = v
+	}
+}
+
+// WithPolicer returns option to set Policer which checks and replicates the
+// local objects.
+func WithPolicer(v *policer.Policer) Option {
+	return func(c *cfg) {
+		c.drainer = v
+	}
+}
+
+// WithNetworkState returns option to set the source of the local node's
+// decommission state in the network map. If not set, the state is considered
+// published.
+func WithNetworkState(v NetworkState) Option {
+	return func(c *cfg) {
+		c.netState = v
+	}
+}
+
+// WithPassInterval returns option to set pause between the passes over the
+// local objects.
+func WithPassInterval(v time.Duration) Option {
+	return func(c *cfg) {
+		c.passInterval = v
+	}
+}
@@ -0,0 +1,135 @@
+package decommission
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"go.uber.org/zap"
+)
+
+func (d *Decommissioner) run(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+
+	for {
+		d.pass(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.passInterval):
+		}
+	}
+}
+
+// pass processes all local objects once, then the objects stored locally after
+// the pass started, and submits the results to the status. Pass is discarded
+// if it was interrupted.
+func (d *Decommissioner) pass(ctx context.Context) {
+	var (
+		prm    engine.ListWithCursorPrm
+		cursor *engine.Cursor
+		res    Status
+	)
+
+	// copies handed over before the other nodes see the decommission may be
+	// removed by them as redundant, so such pass can not complete it
+	published := d.netState == nil || d.netState.IsLocalNodeDecommissioning()
+	if !published {
+		d.log.Info("decommission is not published in the network map yet, pass can not complete it")
+	}
+
+	d.mtx.Lock()
+	d.status.Processed = 0
+	d.stored = nil
+	d.mtx.Unlock()
+
+	prm.WithCount(d.batchSize)
+
+	for {
+		prm.WithCursor(cursor)
+
+		lst, err := d.localStorage.ListWithCursor(prm)
+		if err != nil {
+			if errors.Is(err, engine.ErrEndOfListing) {
+				break
+			}
+
+			d.log.Warn("failure at object select for decommission, pass aborted", zap.Error(err))
+
+			return
+		}
+
+		addrs := lst.AddressList()
+		cursor = lst.Cursor()
+
+		for i := range addrs {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			d.processObject(ctx, addrs[i], &res)
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// listing could miss the objects stored after the pass started, so
+		// they are checked until no new ones appear
+		d.mtx.Lock()
+		stored := d.stored
+		d.stored = nil
+
+		if len(stored) == 0 {
+			d.status.Passes++
+			d.status.Completed = res.Failed == 0 && published
+			d.status.Objects = res.Objects
+			d.status.Replicated = res.Replicated
+			d.status.Failed = res.Failed
+			d.mtx.Unlock()
+
+			break
+		}
+
+		d.mtx.Unlock()
+
+		for addr, typ := range stored {
+			if ctx.Err() != nil {
+				return
+			}
+
+			d.processObject(ctx, objectcore.AddressWithType{Address: addr, Type: typ}, &res)
+		}
+	}
+
+	d.log.Info("decommission pass finished",
+		zap.Uint64("objects", res.Objects),
+		zap.Uint64("replicated", res.Replicated),
+		zap.Uint64("failed", res.Failed),
+	)
+}
+
+// processObject makes sure that the local object is stored on enough other
+// container nodes and accounts the result.
+func (d *Decommissioner) processObject(ctx context.Context, addr objectcore.AddressWithType, res *Status) {
+	switch d.drainer.Drain(ctx, addr) {
+	case policer.ObjectReplicated:
+		res.Replicated++
+	case policer.ObjectLacksReplicas:
+		res.Failed++
+	}
+
+	res.Objects++
+
+	d.mtx.Lock()
+	d.status.Processed++
+	d.mtx.Unlock()
+}
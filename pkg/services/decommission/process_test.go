@@ -0,0 +1,150 @@
+package decommission
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type epochState struct{}
+
+func (epochState) CurrentEpoch() uint64 {
+	return 0
+}
+
+func newTestStorage(t *testing.T) *engine.StorageEngine {
+	dir := t.TempDir()
+
+	e := engine.New()
+
+	_, err := e.AddShard(
+		shard.WithBlobStorOptions(blobstor.WithStorages([]blobstor.SubStorage{{
+			Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "fstree"))),
+		}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{}),
+		),
+		shard.WithPiloramaOptions(pilorama.WithPath(filepath.Join(dir, "pilorama"))),
+	)
+	require.NoError(t, err)
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	t.Cleanup(func() { _ = e.Close() })
+
+	return e
+}
+
+func putTestObject(t *testing.T, e *engine.StorageEngine) oid.Address {
+	payload := []byte("payload")
+	owner := usertest.ID(t)
+
+	obj := object.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetID(oidtest.ID())
+	obj.SetOwnerID(&owner)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(object.CalculatePayloadChecksum(payload))
+
+	require.NoError(t, engine.Put(e, obj))
+
+	return objectcore.AddressOf(obj)
+}
+
+// testDrainer reports objects as safe unless they are listed as lacking
+// replicas, and calls the handler on each drain.
+type testDrainer struct {
+	lacking map[oid.Address]bool
+	drained []oid.Address
+	handler func()
+}
+
+func (x *testDrainer) Drain(_ context.Context, addr objectcore.AddressWithType) policer.DrainResult {
+	x.drained = append(x.drained, addr.Address)
+
+	if x.handler != nil {
+		x.handler()
+	}
+
+	if x.lacking[addr.Address] {
+		return policer.ObjectLacksReplicas
+	}
+
+	return policer.ObjectSafe
+}
+
+type testNetworkState bool
+
+func (x testNetworkState) IsLocalNodeDecommissioning() bool {
+	return bool(x)
+}
+
+func TestDecommissioner_pass(t *testing.T) {
+	ls := newTestStorage(t)
+	listed := putTestObject(t, ls)
+	stored := oidtest.Address()
+
+	for _, tc := range []struct {
+		name        string
+		lacking     map[oid.Address]bool
+		unpublished bool
+		completed   bool
+		failed      uint64
+	}{
+		{
+			name:      "stored object is safe",
+			completed: true,
+		},
+		{
+			name:    "stored object lacks replicas",
+			lacking: map[oid.Address]bool{stored: true},
+			failed:  1,
+		},
+		{
+			name:        "decommission not published",
+			unpublished: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dr := &testDrainer{lacking: tc.lacking}
+
+			d := New(WithLogger(zap.NewNop()), WithLocalStorage(ls), WithNetworkState(testNetworkState(!tc.unpublished)))
+			d.drainer = dr
+			d.enabled.Store(true)
+
+			// object is stored while the pass is in progress
+			dr.handler = func() {
+				dr.handler = nil
+				d.HandleStoredObject(objectcore.AddressWithType{Address: stored, Type: object.TypeRegular})
+			}
+
+			d.pass(context.Background())
+
+			require.Equal(t, []oid.Address{listed, stored}, dr.drained)
+
+			st := d.Status()
+			require.Equal(t, tc.completed, st.Completed)
+			require.EqualValues(t, 1, st.Passes)
+			require.EqualValues(t, 2, st.Objects)
+			require.Equal(t, tc.failed, st.Failed)
+		})
+	}
+}
@@ -96,21 +96,29 @@ func (c *Checker) CheckBasicACL(info v2.RequestInfo) bool {
 
 // StickyBitCheck validates owner field in the request if sticky bit is enabled.
 func (c *Checker) StickyBitCheck(info v2.RequestInfo, owner user.ID) bool {
+	return CheckStickyBit(info.BasicACL(), info.RequestRole(), info.SenderKey(), owner)
+}
+
+// CheckStickyBit checks that the object with the given owner may be stored
+// in the container with the basic ACL by the request sender with the role
+// and the public key. Objects of any owner are allowed if sticky bit is not
+// set.
+func CheckStickyBit(basicACL acl.Basic, role acl.Role, senderKey []byte, owner user.ID) bool {
 	// According to NeoFS specification sticky bit has no effect on system nodes
 	// for correct intra-container work with objects (in particular, replication).
-	if info.RequestRole() == acl.RoleContainer {
+	if role == acl.RoleContainer {
 		return true
 	}
 
-	if !info.BasicACL().Sticky() {
+	if !basicACL.Sticky() {
 		return true
 	}
 
-	if len(info.SenderKey()) == 0 {
+	if len(senderKey) == 0 {
 		return false
 	}
 
-	return isOwnerFromKey(owner, info.SenderKey())
+	return isOwnerFromKey(owner, senderKey)
 }
 
 // CheckEACL is a main check function for extended ACL.
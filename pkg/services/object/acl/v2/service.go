@@ -9,12 +9,14 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -151,10 +153,9 @@ func (b Service) Get(request *objectV2.GetRequest, stream object.GetObjectStream
 
 	reqInfo.obj = obj
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+	err = b.checkAccess(stream.Context(), request, reqInfo)
+	if err != nil {
+		return err
 	}
 
 	return b.next.Get(request, &getStreamBasicChecker{
@@ -217,10 +218,9 @@ func (b Service) Head(
 
 	reqInfo.obj = obj
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+	err = b.checkAccess(ctx, request, reqInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := b.next.Head(ctx, request)
@@ -268,10 +268,9 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 		return err
 	}
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+	err = b.checkAccess(stream.Context(), request, reqInfo)
+	if err != nil {
+		return err
 	}
 
 	return b.next.Search(request, &searchStreamBasicChecker{
@@ -325,10 +324,9 @@ func (b Service) Delete(
 
 	reqInfo.obj = obj
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+	err = b.checkAccess(ctx, request, reqInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	return b.next.Delete(ctx, request)
@@ -376,10 +374,9 @@ func (b Service) GetRange(request *objectV2.GetRangeRequest, stream object.GetOb
 
 	reqInfo.obj = obj
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+	err = b.checkAccess(stream.Context(), request, reqInfo)
+	if err != nil {
+		return err
 	}
 
 	return b.next.GetRange(request, &rangeStreamBasicChecker{
@@ -433,15 +430,33 @@ func (b Service) GetRangeHash(
 
 	reqInfo.obj = obj
 
-	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
-	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+	err = b.checkAccess(ctx, request, reqInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	return b.next.GetRangeHash(ctx, request)
 }
 
+// checkAccess checks basic and extended ACL rules for the request.
+func (b Service) checkAccess(ctx context.Context, request any, reqInfo RequestInfo) (err error) {
+	_, span := tracing.StartSpan(ctx, "acl.CheckAccess",
+		attribute.Stringer("operation", reqInfo.operation),
+		attribute.Stringer("role", reqInfo.requestRole),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if !b.checker.CheckBasicACL(reqInfo) {
+		return basicACLErr(reqInfo)
+	}
+
+	if err = b.checker.CheckEACL(request, reqInfo); err != nil {
+		return eACLErr(reqInfo, err)
+	}
+
+	return nil
+}
+
 func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 	body := request.GetBody()
 	if body == nil {
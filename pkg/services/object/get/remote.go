@@ -1,11 +1,15 @@
 package getsvc
 
 import (
+	"encoding/hex"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -17,8 +21,18 @@ func (exec *execCtx) processNode(info client.NodeInfo) bool {
 		return true
 	}
 
+	// remote call is made within the context of the execution,
+	// so substitute it with the span one for the time of the call
+	ctx := exec.ctx
+	var span trace.Span
+	exec.ctx, span = tracing.StartSpan(ctx, "getService.processNode",
+		attribute.String("node", hex.EncodeToString(info.PublicKey())))
+
 	obj, err := client.getObject(exec, info)
 
+	exec.ctx = ctx
+	tracing.EndSpan(span, err)
+
 	var errSplitInfo *objectSDK.SplitInfoError
 
 	switch {
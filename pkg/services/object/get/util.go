@@ -199,6 +199,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var headPrm engine.HeadPrm
 		headPrm.WithAddress(exec.address())
 		headPrm.WithRaw(exec.isRaw())
+		headPrm.WithContext(exec.context())
 
 		r, err := e.engine.Head(headPrm)
 		if err != nil {
@@ -210,6 +211,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var getRange engine.RngPrm
 		getRange.WithAddress(exec.address())
 		getRange.WithPayloadRange(rng)
+		getRange.WithContext(exec.context())
 
 		r, err := e.engine.GetRange(getRange)
 		if err != nil {
@@ -220,6 +222,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	} else {
 		var getPrm engine.GetPrm
 		getPrm.WithAddress(exec.address())
+		getPrm.WithContext(exec.context())
 
 		r, err := e.engine.Get(getPrm)
 		if err != nil {
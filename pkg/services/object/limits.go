@@ -0,0 +1,264 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// ContainerSizes provides sizes of the containers in local storage.
+type ContainerSizes interface {
+	// ContainerSize returns total payload size of the container objects
+	// stored locally.
+	ContainerSize(cid.ID) (uint64, error)
+}
+
+// Limits is an Object API ServiceServer which applies request rate,
+// payload bandwidth and container quota limits of the node.
+//
+// Requests exceeding rate limits and objects not fitting into container
+// quotas are failed with util.ResourceExhaustedError which is responded
+// with the signed ACCESS_DENIED status carrying the limit error as a reason.
+// Rate limit rejections (ratelimit.ErrRateLimit) may be retried after a
+// backoff, quota rejections (ratelimit.ErrNodeQuotaExceeded) are not
+// retryable until the container data is removed from the node. Payload
+// transfer exceeding bandwidth limits is slowed down.
+//
+// Quotas are per node: they are checked against the size of the container
+// objects in the local storage, objects stored by other container nodes are
+// not taken into account.
+type Limits struct {
+	limiter *ratelimit.Limiter
+	sizes   ContainerSizes
+
+	nextHandler ServiceServer
+}
+
+// Init initializes the Limits instance.
+func (x *Limits) Init(limiter *ratelimit.Limiter, sizes ContainerSizes, nextHandler ServiceServer) {
+	x.limiter = limiter
+	x.sizes = sizes
+	x.nextHandler = nextHandler
+}
+
+func limitErr(err error) error {
+	return util.NewResourceExhaustedError(err)
+}
+
+// limitedRequest makes ratelimit.Request from the request parameters.
+// Invalid container ID is ignored since such requests are failed by
+// the next handlers anyway.
+func limitedRequest(method string, vh *sessionV2.RequestVerificationHeader, cnrV2 *refsV2.ContainerID) ratelimit.Request {
+	r := ratelimit.Request{Method: method}
+
+	for vh.GetOrigin() != nil {
+		vh = vh.GetOrigin()
+	}
+
+	r.Owner = vh.GetBodySignature().GetKey()
+
+	if cnrV2 != nil {
+		var cnr cid.ID
+		if cnr.ReadFromV2(*cnrV2) == nil {
+			r.Container = &cnr
+		}
+	}
+
+	return r
+}
+
+func (x *Limits) allow(r ratelimit.Request) error {
+	if err := x.limiter.Allow(r); err != nil {
+		return limitErr(err)
+	}
+
+	return nil
+}
+
+func (x *Limits) Get(req *objectV2.GetRequest, stream GetObjectStream) error {
+	r := limitedRequest(ratelimit.MethodObjectGet, req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return err
+	}
+
+	return x.nextHandler.Get(req, &getStreamLimits{
+		GetObjectStream: stream,
+		limiter:         x.limiter,
+		req:             r,
+	})
+}
+
+func (x *Limits) Put(ctx context.Context) (PutObjectStream, error) {
+	stream, err := x.nextHandler.Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &putStreamLimits{
+		ctx:    ctx,
+		limits: x,
+		next:   stream,
+	}, nil
+}
+
+func (x *Limits) Head(ctx context.Context, req *objectV2.HeadRequest) (*objectV2.HeadResponse, error) {
+	r := limitedRequest(ratelimit.MethodObjectHead, req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return nil, err
+	}
+
+	return x.nextHandler.Head(ctx, req)
+}
+
+func (x *Limits) Search(req *objectV2.SearchRequest, stream SearchStream) error {
+	r := limitedRequest(ratelimit.MethodObjectSearch, req.GetVerificationHeader(), req.GetBody().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return err
+	}
+
+	return x.nextHandler.Search(req, stream)
+}
+
+func (x *Limits) Delete(ctx context.Context, req *objectV2.DeleteRequest) (*objectV2.DeleteResponse, error) {
+	r := limitedRequest(ratelimit.MethodObjectDelete, req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return nil, err
+	}
+
+	return x.nextHandler.Delete(ctx, req)
+}
+
+func (x *Limits) GetRange(req *objectV2.GetRangeRequest, stream GetObjectRangeStream) error {
+	r := limitedRequest(ratelimit.MethodObjectRange, req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return err
+	}
+
+	return x.nextHandler.GetRange(req, &rangeStreamLimits{
+		GetObjectRangeStream: stream,
+		limiter:              x.limiter,
+		req:                  r,
+	})
+}
+
+func (x *Limits) GetRangeHash(ctx context.Context, req *objectV2.GetRangeHashRequest) (*objectV2.GetRangeHashResponse, error) {
+	r := limitedRequest(ratelimit.MethodObjectRangeHash, req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID())
+	if err := x.allow(r); err != nil {
+		return nil, err
+	}
+
+	return x.nextHandler.GetRangeHash(ctx, req)
+}
+
+type getStreamLimits struct {
+	GetObjectStream
+	limiter *ratelimit.Limiter
+	req     ratelimit.Request
+}
+
+func (s *getStreamLimits) Send(resp *objectV2.GetResponse) error {
+	if chunk, ok := resp.GetBody().GetObjectPart().(*objectV2.GetObjectPartChunk); ok {
+		if err := s.limiter.WaitBandwidth(s.Context(), s.req, len(chunk.GetChunk())); err != nil {
+			return err
+		}
+	}
+
+	return s.GetObjectStream.Send(resp)
+}
+
+type rangeStreamLimits struct {
+	GetObjectRangeStream
+	limiter *ratelimit.Limiter
+	req     ratelimit.Request
+}
+
+func (s *rangeStreamLimits) Send(resp *objectV2.GetRangeResponse) error {
+	if chunk, ok := resp.GetBody().GetRangePart().(*objectV2.GetRangePartChunk); ok {
+		if err := s.limiter.WaitBandwidth(s.Context(), s.req, len(chunk.GetChunk())); err != nil {
+			return err
+		}
+	}
+
+	return s.GetObjectRangeStream.Send(resp)
+}
+
+type putStreamLimits struct {
+	ctx    context.Context
+	limits *Limits
+	next   PutObjectStream
+
+	req ratelimit.Request
+
+	// quota is set if the container storage on the node is limited
+	quota bool
+	// used is a local container size before the object
+	used uint64
+	// received is a size of the received object payload
+	received uint64
+}
+
+func (s *putStreamLimits) Send(req *objectV2.PutRequest) error {
+	switch v := req.GetBody().GetObjectPart().(type) {
+	case *objectV2.PutObjectPartInit:
+		hdr := v.GetHeader()
+
+		s.req = limitedRequest(ratelimit.MethodObjectPut, req.GetVerificationHeader(), hdr.GetContainerID())
+		if err := s.limits.allow(s.req); err != nil {
+			return err
+		}
+
+		if s.req.Container != nil && s.limits.limiter.HasNodeQuota(*s.req.Container) {
+			used, err := s.limits.sizes.ContainerSize(*s.req.Container)
+			if err != nil {
+				return fmt.Errorf("could not get container size: %w", err)
+			}
+
+			s.quota = true
+			s.used = used
+
+			if err = s.checkQuota(hdr.GetPayloadLength()); err != nil {
+				return err
+			}
+		}
+	case *objectV2.PutObjectPartChunk:
+		if s.req.Method == "" {
+			// chunk before header, next handler will fail
+			break
+		}
+
+		n := len(v.GetChunk())
+
+		s.received += uint64(n)
+		if err := s.checkQuota(s.received); err != nil {
+			return err
+		}
+
+		if err := s.limits.limiter.WaitBandwidth(s.ctx, s.req, n); err != nil {
+			return err
+		}
+	}
+
+	return s.next.Send(req)
+}
+
+func (s *putStreamLimits) checkQuota(size uint64) error {
+	if !s.quota {
+		return nil
+	}
+
+	if err := s.limits.limiter.CheckNodeQuota(*s.req.Container, s.used, size); err != nil {
+		return limitErr(err)
+	}
+
+	return nil
+}
+
+func (s *putStreamLimits) CloseAndRecv() (*objectV2.PutResponse, error) {
+	return s.next.CloseAndRecv()
+}
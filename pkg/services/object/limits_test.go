@@ -0,0 +1,173 @@
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	refsV2 "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectService "github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testContainerSizes map[cid.ID]uint64
+
+func (x testContainerSizes) ContainerSize(id cid.ID) (uint64, error) {
+	return x[id], nil
+}
+
+// testService accepts all requests.
+type testService struct {
+	objectService.ServiceServer
+}
+
+func (testService) Head(context.Context, *objectV2.HeadRequest) (*objectV2.HeadResponse, error) {
+	return new(objectV2.HeadResponse), nil
+}
+
+func (testService) Put(context.Context) (objectService.PutObjectStream, error) {
+	return testPutStream{}, nil
+}
+
+type testPutStream struct{}
+
+func (testPutStream) Send(*objectV2.PutRequest) error { return nil }
+
+func (testPutStream) CloseAndRecv() (*objectV2.PutResponse, error) {
+	return new(objectV2.PutResponse), nil
+}
+
+func verificationHeader(key []byte) *sessionV2.RequestVerificationHeader {
+	var sig refsV2.Signature
+	sig.SetKey(key)
+
+	var vh sessionV2.RequestVerificationHeader
+	vh.SetBodySignature(&sig)
+
+	return &vh
+}
+
+func headRequest(key []byte, cnr cid.ID) *objectV2.HeadRequest {
+	var cnrV2 refsV2.ContainerID
+	cnr.WriteToV2(&cnrV2)
+
+	var addr refsV2.Address
+	addr.SetContainerID(&cnrV2)
+
+	var body objectV2.HeadRequestBody
+	body.SetAddress(&addr)
+
+	var req objectV2.HeadRequest
+	req.SetBody(&body)
+	req.SetVerificationHeader(verificationHeader(key))
+
+	return &req
+}
+
+func putInitRequest(cnr cid.ID, payloadLen uint64) *objectV2.PutRequest {
+	var cnrV2 refsV2.ContainerID
+	cnr.WriteToV2(&cnrV2)
+
+	var hdr objectV2.Header
+	hdr.SetContainerID(&cnrV2)
+	hdr.SetPayloadLength(payloadLen)
+
+	var init objectV2.PutObjectPartInit
+	init.SetHeader(&hdr)
+
+	var body objectV2.PutRequestBody
+	body.SetObjectPart(&init)
+
+	var req objectV2.PutRequest
+	req.SetBody(&body)
+	req.SetVerificationHeader(verificationHeader([]byte{1}))
+
+	return &req
+}
+
+func putChunkRequest(size int) *objectV2.PutRequest {
+	var chunk objectV2.PutObjectPartChunk
+	chunk.SetChunk(make([]byte, size))
+
+	var body objectV2.PutRequestBody
+	body.SetObjectPart(&chunk)
+
+	var req objectV2.PutRequest
+	req.SetBody(&body)
+
+	return &req
+}
+
+// requireLimitStatus checks that the limit error is responded with the
+// ACCESS_DENIED status carrying the limit error as a reason.
+func requireLimitStatus(t *testing.T, err error) {
+	var st *apistatus.ObjectAccessDenied
+	require.ErrorAs(t, apistatus.ErrorFromV2(apistatus.ErrorToV2(err)), &st)
+	require.Equal(t, err.Error(), st.Reason())
+}
+
+func TestLimits_RateLimit(t *testing.T) {
+	cnr := cidtest.ID()
+
+	var svc objectService.Limits
+	svc.Init(ratelimit.New(ratelimit.Config{
+		Owner: ratelimit.Limit{Rate: 1e-9, Burst: 1},
+	}), testContainerSizes{}, testService{})
+
+	_, err := svc.Head(context.Background(), headRequest([]byte{1}, cnr))
+	require.NoError(t, err)
+
+	_, err = svc.Head(context.Background(), headRequest([]byte{1}, cnr))
+	require.ErrorIs(t, err, ratelimit.ErrRateLimit)
+	requireLimitStatus(t, err)
+
+	_, err = svc.Head(context.Background(), headRequest([]byte{2}, cnr))
+	require.NoError(t, err)
+}
+
+func TestLimits_Quota(t *testing.T) {
+	cnr, other := cidtest.ID(), cidtest.ID()
+
+	var svc objectService.Limits
+	svc.Init(ratelimit.New(ratelimit.Config{
+		NodeQuotas: map[cid.ID]uint64{cnr: 100},
+	}), testContainerSizes{cnr: 60}, testService{})
+
+	put := func(cnr cid.ID, declared uint64, chunks ...int) error {
+		stream, err := svc.Put(context.Background())
+		require.NoError(t, err)
+
+		if err = stream.Send(putInitRequest(cnr, declared)); err != nil {
+			return err
+		}
+
+		for _, size := range chunks {
+			if err = stream.Send(putChunkRequest(size)); err != nil {
+				return err
+			}
+		}
+
+		_, err = stream.CloseAndRecv()
+		return err
+	}
+
+	require.NoError(t, put(cnr, 40, 20, 20))
+	require.NoError(t, put(other, 1000, 1000))
+
+	t.Run("declared size", func(t *testing.T) {
+		err := put(cnr, 41)
+		require.ErrorIs(t, err, ratelimit.ErrNodeQuotaExceeded)
+		requireLimitStatus(t, err)
+	})
+
+	t.Run("actual size", func(t *testing.T) {
+		err := put(cnr, 0, 20, 21)
+		require.ErrorIs(t, err, ratelimit.ErrNodeQuotaExceeded)
+		requireLimitStatus(t, err)
+	})
+}
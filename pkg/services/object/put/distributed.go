@@ -33,6 +33,9 @@ type distributedTarget struct {
 
 	isLocalKey func([]byte) bool
 
+	// exclude local node from the object placement
+	skipLocal bool
+
 	relay func(nodeDesc) error
 
 	fmt *object.FormatValidator
@@ -190,6 +193,10 @@ loop:
 				continue
 			}
 
+			if t.skipLocal && t.isLocalKey(addrs[i].PublicKey()) {
+				continue
+			}
+
 			wg.Add(1)
 
 			addr := addrs[i]
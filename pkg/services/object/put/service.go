@@ -20,6 +20,14 @@ type MaxSizeSource interface {
 	MaxObjectSize() uint64
 }
 
+// NodeState provides storage node state processed by PUT service.
+type NodeState interface {
+	// IsDecommissioning checks if the storage node is being decommissioned.
+	// Such node MUST NOT store new object replicas as a container node, except
+	// for TOMBSTONE and LOCK objects broadcast to all container nodes.
+	IsDecommissioning() bool
+}
+
 type Service struct {
 	*cfg
 }
@@ -53,6 +61,8 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	nodeState NodeState
+
 	log *zap.Logger
 }
 
@@ -141,6 +151,12 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+func WithNodeState(v NodeState) Option {
+	return func(c *cfg) {
+		c.nodeState = v
+	}
+}
+
 func WithLogger(l *zap.Logger) Option {
 	return func(c *cfg) {
 		c.log = l
@@ -10,6 +10,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -31,6 +32,10 @@ var errNotInit = errors.New("stream not initialized")
 
 var errInitRecall = errors.New("init recall")
 
+// errDecommissioning is a message of the status returned on local-only
+// requests to the node being decommissioned.
+const errDecommissioning = "node is being decommissioned and does not accept new objects"
+
 func (p *Streamer) Init(prm *PutInitPrm) error {
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
@@ -61,6 +66,16 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		return fmt.Errorf("(%T) could not prepare put parameters: %w", p, err)
 	}
 
+	if prm.common.LocalOnly() && p.skipLocal(prm.hdr.Type()) {
+		// placement consists of the local node only, so the object can not be
+		// stored anywhere. Local-only requests are sent by the replicators of
+		// other nodes, they should choose another container node.
+		var st apistatus.ServerInternal
+		st.SetMessage(errDecommissioning)
+
+		return st
+	}
+
 	p.maxPayloadSz = p.maxSizeSrc.MaxObjectSize()
 	if p.maxPayloadSz == 0 {
 		return fmt.Errorf("(%T) could not obtain max object size parameter", p)
@@ -226,6 +241,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
 			if node.local {
 				return &localTarget{
+					ctx:     p.ctx,
 					storage: p.localStore,
 				}
 			}
@@ -246,9 +262,18 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		log:   p.log,
 
 		isLocalKey: p.netmapKeys.IsLocalKey,
+		skipLocal:  p.skipLocal(typ),
 	}
 }
 
+// skipLocal checks whether the local node must be excluded from the placement
+// of the object with the given type. Decommissioned node stores only the
+// objects which must be on all container nodes.
+func (p *Streamer) skipLocal(typ object.Type) bool {
+	return p.nodeState != nil && p.nodeState.IsDecommissioning() &&
+		typ != object.TypeTombstone && typ != object.TypeLock
+}
+
 func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 	if p.target == nil {
 		return errNotInit
@@ -121,6 +121,7 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithContext(exec.context())
 
 	r, err := e.storage.Select(selectPrm)
 	if err != nil {
@@ -3,12 +3,12 @@ package policer
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
-	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
-	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -198,15 +198,29 @@ type processPlacementContext struct {
 
 	// caches nodes which has been already processed in previous iterations
 	checkedNodes *nodeCache
+
+	// whether the local replica must not be counted, i.e. the object must be
+	// stored on enough other container nodes (e.g. when the local node is being
+	// decommissioned). In this mode needLocalCopy is set if the object still
+	// lacks replicas on other nodes.
+	excludeLocal bool
+
+	// number of object replicas made during the check
+	replicated uint32
 }
 
 func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.NodeInfo, shortage uint32) {
-	prm := new(headsvc.RemoteHeadPrm).WithObjectAddress(ctx.object.Address)
-
 	// Number of copies that are stored on maintenance nodes.
 	var uncheckedCopies int
 
 	handleMaintenance := func(node netmap.NodeInfo) {
+		if ctx.excludeLocal {
+			// replicas on the nodes under maintenance can be neither checked
+			// nor created, so they are not counted when the local replica
+			// is going away
+			return
+		}
+
 		// consider remote nodes under maintenance as problem OK. Such
 		// nodes MAY not respond with object, however, this is how we
 		// prevent spam with new replicas.
@@ -226,7 +240,19 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 		// for correct object removal protection:
 		//   - `LOCK` objects are broadcast on their PUT requests;
 		//   - `LOCK` object removal is a prohibited action in the GC.
-		shortage = uint32(len(nodes))
+		// The drained local node and the nodes being decommissioned are not
+		// counted since they are going away.
+		shortage = 0
+
+		for i := range nodes {
+			if p.netmapKeys.IsLocalKey(nodes[i].PublicKey()) {
+				if !ctx.excludeLocal {
+					shortage++
+				}
+			} else if !netmapcore.IsDecommissioning(nodes[i]) {
+				shortage++
+			}
+		}
 	}
 
 	for i := 0; (!ctx.localNodeInContainer || shortage > 0) && i < len(nodes); i++ {
@@ -245,9 +271,17 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 		if shortage == 0 {
 			continue
 		} else if isLocalNode {
-			ctx.needLocalCopy = true
+			if !ctx.excludeLocal {
+				ctx.needLocalCopy = true
 
-			shortage--
+				shortage--
+			}
+		} else if netmapcore.IsDecommissioning(nodes[i]) {
+			// node hands its objects over to other nodes before the
+			// shutdown, so its replica is neither counted nor created
+			p.log.Debug("skip decommissioned node",
+				zap.String("node", netmap.StringifyPublicKey(nodes[i])),
+			)
 		} else if nodes[i].IsMaintenance() {
 			handleMaintenance(nodes[i])
 		} else {
@@ -262,9 +296,9 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 				continue
 			}
 
-			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+			callCtx, cancel := context.WithTimeout(ctx, time.Duration(p.headTimeout.Load()))
 
-			_, err := p.remoteHeader.Head(callCtx, prm.WithNodeInfo(nodes[i]))
+			err := p.remoteHeader.headObject(callCtx, nodes[i], ctx.object.Address)
 
 			cancel()
 
@@ -296,12 +330,26 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 			zap.Uint32("shortage", shortage),
 		)
 
-		var task replicator.Task
-		task.SetObjectAddress(ctx.object.Address)
-		task.SetNodes(nodes)
-		task.SetCopiesNumber(shortage)
+		if len(nodes) > 0 {
+			p.replicator.replicate(ctx, ctx.object.Address, nodes, shortage, ctx.checkedNodes)
+		}
+
+		var replicated uint32
+		for i := range nodes {
+			if ctx.checkedNodes.processStatus(nodes[i]) == 0 {
+				replicated++
+			}
+		}
+
+		ctx.replicated += replicated
 
-		p.replicator.HandleTask(ctx, task, ctx.checkedNodes)
+		if ctx.excludeLocal && replicated < shortage {
+			ctx.needLocalCopy = true
+			p.log.Info("object lacks replicas on other container nodes",
+				zap.Stringer("object", ctx.object.Address),
+				zap.Uint32("shortage", shortage-replicated),
+			)
+		}
 	} else if uncheckedCopies > 0 {
 		// If we have more copies than needed, but some of them are from the maintenance nodes,
 		// save the local copy.
@@ -0,0 +1,91 @@
+package policer
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"go.uber.org/zap"
+)
+
+// DrainResult is a result of the local object drain.
+type DrainResult uint8
+
+const (
+	// ObjectSafe means that the object is already stored on enough other
+	// container nodes.
+	ObjectSafe DrainResult = iota
+	// ObjectReplicated means that the object has been replicated to other
+	// container nodes and is now stored on enough of them.
+	ObjectReplicated
+	// ObjectLacksReplicas means that the object still lacks replicas on other
+	// container nodes.
+	ObjectLacksReplicas
+)
+
+// Drain checks that the local object is stored on enough container nodes
+// other than the local one according to the container storage policy, and
+// replicates it to the nodes lacking it otherwise. The local replica is not
+// counted and is kept as is. Replicas on the other nodes announcing the
+// decommission are not counted either. Objects of the removed containers are
+// considered safe.
+//
+// Drain is used to hand the objects over before the local node shutdown.
+func (p *Policer) Drain(ctx context.Context, addrWithType objectcore.AddressWithType) DrainResult {
+	addr := addrWithType.Address
+	idCnr := addr.Container()
+	idObj := addr.Object()
+
+	cnr, err := p.cnrSrc.Get(idCnr)
+	if err != nil {
+		if container.IsErrNotFound(err) {
+			// objects of the removed containers are garbage, no need to keep them
+			return ObjectSafe
+		}
+
+		p.log.Error("could not get container",
+			zap.Stringer("cid", idCnr),
+			zap.String("error", err.Error()),
+		)
+
+		return ObjectLacksReplicas
+	}
+
+	policy := cnr.Value.PlacementPolicy()
+
+	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
+	if err != nil {
+		p.log.Error("could not build placement vector for object",
+			zap.Stringer("cid", idCnr),
+			zap.String("error", err.Error()),
+		)
+
+		return ObjectLacksReplicas
+	}
+
+	c := &processPlacementContext{
+		Context:      ctx,
+		object:       addrWithType,
+		checkedNodes: newNodeCache(),
+		excludeLocal: true,
+	}
+
+	for i := range nn {
+		p.processNodes(c, nn[i], policy.ReplicaNumberByIndex(i))
+
+		if c.needLocalCopy {
+			return ObjectLacksReplicas
+		}
+	}
+
+	// processing is incomplete if context is done
+	if ctx.Err() != nil {
+		return ObjectLacksReplicas
+	}
+
+	if c.replicated > 0 {
+		return ObjectReplicated
+	}
+
+	return ObjectSafe
+}
@@ -0,0 +1,292 @@
+package policer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testContainerSource struct {
+	cnr *container.Container
+}
+
+func (x testContainerSource) Get(cid.ID) (*container.Container, error) {
+	if x.cnr == nil {
+		return nil, apistatus.ContainerNotFound{}
+	}
+
+	return x.cnr, nil
+}
+
+type testPlacementBuilder [][]netmap.NodeInfo
+
+func (x testPlacementBuilder) BuildPlacement(cid.ID, *oid.ID, netmap.PlacementPolicy) ([][]netmap.NodeInfo, error) {
+	// Policer modifies placement vectors
+	res := make([][]netmap.NodeInfo, len(x))
+	for i := range x {
+		res[i] = append([]netmap.NodeInfo(nil), x[i]...)
+	}
+
+	return res, nil
+}
+
+type testNetmapKeys []byte
+
+func (x testNetmapKeys) IsLocalKey(key []byte) bool {
+	return bytes.Equal(x, key)
+}
+
+// remote nodes are identified by the first byte of the public key.
+type testRemoteHeader map[byte]bool
+
+func (x testRemoteHeader) headObject(_ context.Context, node netmap.NodeInfo, _ oid.Address) error {
+	if !x[node.PublicKey()[0]] {
+		return apistatus.ObjectNotFound{}
+	}
+
+	return nil
+}
+
+type testReplicator struct {
+	// nodes accepting the objects
+	accept map[byte]bool
+	// nodes the objects were sent to
+	calls []byte
+	// if set, accepted objects are stored here
+	holders testRemoteHeader
+}
+
+func (x *testReplicator) replicate(_ context.Context, _ oid.Address, nodes []netmap.NodeInfo, copies uint32, res replicator.TaskResult) {
+	for i := 0; copies > 0 && i < len(nodes); i++ {
+		x.calls = append(x.calls, nodes[i].PublicKey()[0])
+
+		if x.accept[nodes[i].PublicKey()[0]] {
+			if x.holders != nil {
+				x.holders[nodes[i].PublicKey()[0]] = true
+			}
+
+			res.SubmitSuccessfulReplication(nodes[i])
+			copies--
+		}
+	}
+}
+
+func testNode(id byte) netmap.NodeInfo {
+	var n netmap.NodeInfo
+	n.SetPublicKey([]byte{id})
+	return n
+}
+
+func testDecommissionedNode(id byte) netmap.NodeInfo {
+	n := testNode(id)
+	netmapcore.SetDecommission(&n, true)
+	return n
+}
+
+func TestPolicer_Drain(t *testing.T) {
+	var rep netmap.ReplicaDescriptor
+	rep.SetNumberOfObjects(2)
+
+	var policy netmap.PlacementPolicy
+	policy.AddReplicas(rep)
+
+	var cnr container.Container
+	cnr.Value.Init()
+	cnr.Value.SetPlacementPolicy(policy)
+
+	const local = 0
+
+	addr := objectcore.AddressWithType{Address: oidtest.Address()}
+	placementVector := testPlacementBuilder{{testNode(local), testNode(1), testNode(2), testNode(3)}}
+
+	for _, tc := range []struct {
+		name      string
+		typ       object.Type
+		cnr       *container.Container
+		placement testPlacementBuilder
+		holders   map[byte]bool
+		accept    map[byte]bool
+		res       DrainResult
+		sent      []byte
+	}{
+		{
+			name:      "enough replicas",
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{1: true, 3: true},
+			res:       ObjectSafe,
+		},
+		{
+			name:      "replicated",
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{2: true},
+			accept:    map[byte]bool{1: true, 3: true},
+			res:       ObjectReplicated,
+			sent:      []byte{1},
+		},
+		{
+			name:      "replication failure",
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{2: true},
+			res:       ObjectLacksReplicas,
+			sent:      []byte{1, 3},
+		},
+		{
+			name:      "decommissioned holder",
+			cnr:       &cnr,
+			placement: testPlacementBuilder{{testNode(local), testDecommissionedNode(1), testNode(2), testNode(3)}},
+			holders:   map[byte]bool{1: true, 2: true},
+			accept:    map[byte]bool{1: true, 3: true},
+			res:       ObjectReplicated,
+			sent:      []byte{3},
+		},
+		{
+			name:      "LOCK on all other nodes",
+			typ:       object.TypeLock,
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{1: true, 2: true, 3: true},
+			res:       ObjectSafe,
+		},
+		{
+			name:      "LOCK replicated",
+			typ:       object.TypeLock,
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{1: true, 3: true},
+			accept:    map[byte]bool{2: true},
+			res:       ObjectReplicated,
+			sent:      []byte{2},
+		},
+		{
+			name:      "LOCK lacks replica",
+			typ:       object.TypeLock,
+			cnr:       &cnr,
+			placement: placementVector,
+			holders:   map[byte]bool{1: true, 3: true},
+			res:       ObjectLacksReplicas,
+			sent:      []byte{2},
+		},
+		{
+			name:      "LOCK with decommissioned node",
+			typ:       object.TypeLock,
+			cnr:       &cnr,
+			placement: testPlacementBuilder{{testNode(local), testNode(1), testDecommissionedNode(2), testNode(3)}},
+			holders:   map[byte]bool{1: true, 3: true},
+			res:       ObjectSafe,
+		},
+		{
+			name:      "local node only",
+			cnr:       &cnr,
+			placement: testPlacementBuilder{{testNode(local)}},
+			res:       ObjectLacksReplicas,
+		},
+		{
+			name: "removed container",
+			res:  ObjectSafe,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &testReplicator{accept: tc.accept}
+
+			p := New(WithLogger(zap.NewNop()))
+			p.cnrSrc = testContainerSource{cnr: tc.cnr}
+			p.placementBuilder = tc.placement
+			p.netmapKeys = testNetmapKeys{local}
+			p.remoteHeader = testRemoteHeader(tc.holders)
+			p.replicator = r
+
+			addr := addr
+			addr.Type = tc.typ
+
+			require.Equal(t, tc.res, p.Drain(context.Background(), addr))
+			require.Equal(t, tc.sent, r.calls)
+		})
+	}
+}
+
+func TestPolicer_DrainHandover(t *testing.T) {
+	var rep netmap.ReplicaDescriptor
+	rep.SetNumberOfObjects(2)
+
+	var policy netmap.PlacementPolicy
+	policy.AddReplicas(rep)
+
+	var cnr container.Container
+	cnr.Value.Init()
+	cnr.Value.SetPlacementPolicy(policy)
+
+	const drained, primary, secondary = 0, 1, 2
+
+	addr := objectcore.AddressWithType{Address: oidtest.Address()}
+
+	newPolicer := func(local byte, placement testPlacementBuilder, holders testRemoteHeader, removed *[]byte) *Policer {
+		p := New(
+			WithLogger(zap.NewNop()),
+			WithRedundantCopyCallback(func(oid.Address) {
+				*removed = append(*removed, local)
+			}),
+		)
+		p.cnrSrc = testContainerSource{cnr: &cnr}
+		p.placementBuilder = placement
+		p.netmapKeys = testNetmapKeys{local}
+		p.remoteHeader = holders
+		p.replicator = &testReplicator{
+			accept:  map[byte]bool{primary: true, secondary: true},
+			holders: holders,
+		}
+
+		return p
+	}
+
+	for _, tc := range []struct {
+		name         string
+		announced    bool
+		handoverSafe bool
+	}{
+		{name: "decommission announced", announced: true, handoverSafe: true},
+		{name: "decommission not announced", announced: false, handoverSafe: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			drainedNode := testNode(drained)
+			netmapcore.SetDecommission(&drainedNode, tc.announced)
+
+			placement := testPlacementBuilder{{drainedNode, testNode(primary), testNode(secondary)}}
+
+			// each node sees the others' replicas only, the local one is checked
+			// by the local storage
+			holders := map[byte]bool{drained: true, primary: true}
+			secondaryHolders := testRemoteHeader(holders)
+
+			var removed []byte
+
+			pDrained := newPolicer(drained, placement, holders, &removed)
+			require.Equal(t, ObjectReplicated, pDrained.Drain(context.Background(), addr))
+			require.True(t, holders[secondary], "object must be handed over to the secondary node")
+
+			pSecondary := newPolicer(secondary, placement, secondaryHolders, &removed)
+			pSecondary.processObject(context.Background(), addr)
+
+			if tc.handoverSafe {
+				require.Empty(t, removed, "handed over replica must be kept")
+			} else {
+				require.Equal(t, []byte{secondary}, removed)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package policer
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -11,6 +13,7 @@ import (
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
@@ -72,8 +75,20 @@ type Network interface {
 	IsLocalNodeInNetmap() bool
 }
 
+// remoteHeader checks presence of the object on the remote node. Returns
+// apistatus.ErrObjectNotFound if the node does not store the object.
+type remoteHeader interface {
+	headObject(ctx context.Context, node netmapSDK.NodeInfo, addr oid.Address) error
+}
+
+// objectReplicator sends local object to the given nodes until the specified
+// number of copies is made.
+type objectReplicator interface {
+	replicate(ctx context.Context, addr oid.Address, nodes []netmapSDK.NodeInfo, copies uint32, res replicator.TaskResult)
+}
+
 type cfg struct {
-	headTimeout time.Duration
+	headTimeout atomic.Int64 // time.Duration, can be changed at runtime
 
 	log *zap.Logger
 
@@ -83,11 +98,11 @@ type cfg struct {
 
 	placementBuilder placement.Builder
 
-	remoteHeader *headsvc.RemoteHeader
+	remoteHeader remoteHeader
 
 	netmapKeys netmap.AnnouncedKeys
 
-	replicator *replicator.Replicator
+	replicator objectReplicator
 
 	cbRedundantCopy RedundantCopyCallback
 
@@ -95,7 +110,7 @@ type cfg struct {
 
 	loader nodeLoader
 
-	maxCapacity int
+	maxCapacity atomic.Int64 // can be changed at runtime
 
 	batchSize, cacheSize uint32
 
@@ -133,15 +148,26 @@ func New(opts ...Option) *Policer {
 		cfg:   c,
 		cache: cache,
 		objsInWork: &objectsInWork{
-			objs: make(map[oid.Address]struct{}, c.maxCapacity),
+			objs: make(map[oid.Address]struct{}, c.maxCapacity.Load()),
 		},
 	}
 }
 
+// SetHeadTimeout changes Head timeout of Policer at runtime.
+func (p *Policer) SetHeadTimeout(v time.Duration) {
+	p.headTimeout.Store(int64(v))
+}
+
+// SetMaxCapacity changes max capacity that can be set to the pool at runtime.
+// New capacity is applied to the pool on the next rebalance tick.
+func (p *Policer) SetMaxCapacity(capacity int) {
+	p.maxCapacity.Store(int64(capacity))
+}
+
 // WithHeadTimeout returns option to set Head timeout of Policer.
 func WithHeadTimeout(v time.Duration) Option {
 	return func(c *cfg) {
-		c.headTimeout = v
+		c.headTimeout.Store(int64(v))
 	}
 }
 
@@ -176,7 +202,7 @@ func WithPlacementBuilder(v placement.Builder) Option {
 // WithRemoteHeader returns option to set object header receiver of Policer.
 func WithRemoteHeader(v *headsvc.RemoteHeader) Option {
 	return func(c *cfg) {
-		c.remoteHeader = v
+		c.remoteHeader = (*remoteHeaderAdapter)(v)
 	}
 }
 
@@ -190,7 +216,7 @@ func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 // WithReplicator returns option to set object replicator of Policer.
 func WithReplicator(v *replicator.Replicator) Option {
 	return func(c *cfg) {
-		c.replicator = v
+		c.replicator = (*replicatorAdapter)(v)
 	}
 }
 
@@ -207,7 +233,7 @@ func WithRedundantCopyCallback(cb RedundantCopyCallback) Option {
 // that can be set to the pool.
 func WithMaxCapacity(capacity int) Option {
 	return func(c *cfg) {
-		c.maxCapacity = capacity
+		c.maxCapacity.Store(int64(capacity))
 	}
 }
 
@@ -232,3 +258,21 @@ func WithNetwork(n Network) Option {
 		c.network = n
 	}
 }
+
+type remoteHeaderAdapter headsvc.RemoteHeader
+
+func (x *remoteHeaderAdapter) headObject(ctx context.Context, node netmapSDK.NodeInfo, addr oid.Address) error {
+	_, err := (*headsvc.RemoteHeader)(x).Head(ctx, new(headsvc.RemoteHeadPrm).WithNodeInfo(node).WithObjectAddress(addr))
+	return err
+}
+
+type replicatorAdapter replicator.Replicator
+
+func (x *replicatorAdapter) replicate(ctx context.Context, addr oid.Address, nodes []netmapSDK.NodeInfo, copies uint32, res replicator.TaskResult) {
+	var task replicator.Task
+	task.SetObjectAddress(addr)
+	task.SetNodes(nodes)
+	task.SetCopiesNumber(copies)
+
+	(*replicator.Replicator)(x).HandleTask(ctx, task, res)
+}
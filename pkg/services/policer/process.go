@@ -84,7 +84,7 @@ func (p *Policer) poolCapacityWorker(ctx context.Context) {
 			return
 		case <-ticker.C:
 			neofsSysLoad := p.loader.ObjectServiceLoad()
-			newCapacity := int((1.0 - neofsSysLoad) * float64(p.maxCapacity))
+			newCapacity := int((1.0 - neofsSysLoad) * float64(p.maxCapacity.Load()))
 			if newCapacity == 0 {
 				newCapacity++
 			}
@@ -2,6 +2,7 @@ package replicator
 
 import (
 	"context"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
@@ -52,7 +53,7 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 			zap.Stringer("object", task.addr),
 		)
 
-		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
+		callCtx, cancel := context.WithTimeout(ctx, time.Duration(p.putTimeout.Load()))
 
 		err := p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(task.nodes[i]))
 
@@ -1,6 +1,7 @@
 package replicator
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -18,7 +19,7 @@ type Replicator struct {
 type Option func(*cfg)
 
 type cfg struct {
-	putTimeout time.Duration
+	putTimeout atomic.Int64 // time.Duration, can be changed at runtime
 
 	log *zap.Logger
 
@@ -46,10 +47,15 @@ func New(opts ...Option) *Replicator {
 	}
 }
 
+// SetPutTimeout changes Put timeout of Replicator at runtime.
+func (p *Replicator) SetPutTimeout(v time.Duration) {
+	p.putTimeout.Store(int64(v))
+}
+
 // WithPutTimeout returns option to set Put timeout of Replicator.
 func WithPutTimeout(v time.Duration) Option {
 	return func(c *cfg) {
-		c.putTimeout = v
+		c.putTimeout.Store(int64(v))
 	}
 }
 
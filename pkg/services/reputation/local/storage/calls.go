@@ -10,7 +10,7 @@ import (
 
 // UpdatePrm groups the parameters of Storage's Update operation.
 type UpdatePrm struct {
-	sat bool
+	val reputation.TrustValue
 
 	epoch uint64
 
@@ -30,12 +30,32 @@ func (p *UpdatePrm) SetPeer(id apireputation.PeerID) {
 }
 
 // SetSatisfactory sets successful completion status.
+//
+// Satisfactory interaction is equivalent to the value
+// of reputation.TrustOne, unsatisfactory one is equivalent
+// to the reputation.TrustZero.
 func (p *UpdatePrm) SetSatisfactory(sat bool) {
-	p.sat = sat
+	if sat {
+		p.val = reputation.TrustOne
+	} else {
+		p.val = reputation.TrustZero
+	}
+}
+
+// SetValue sets the score of the interaction. Value
+// must be in [0; 1] range.
+func (p *UpdatePrm) SetValue(v reputation.TrustValue) {
+	p.val = v
 }
 
 type trustValue struct {
-	sat, all int
+	sum reputation.TrustValue
+
+	all int
+}
+
+func (v trustValue) average() reputation.TrustValue {
+	return v.sum.Div(reputation.TrustValueFromInt(v.all))
 }
 
 // EpochTrustValueStorage represents storage of
@@ -73,17 +93,15 @@ func (s *EpochTrustValueStorage) update(prm UpdatePrm) {
 			s.mItems[strID] = val
 		}
 
-		if prm.sat {
-			val.sat++
-		}
-
+		val.sum.Add(prm.val)
 		val.all++
 	}
 
 	s.mtx.Unlock()
 }
 
-// Update updates the number of satisfactory transactions with peer.
+// Update updates the number of satisfactory transactions with peer
+// or, in general, accumulates the score of the interaction with peer.
 func (s *Storage) Update(prm UpdatePrm) {
 	var trustStorage *EpochTrustValueStorage
 
@@ -142,10 +160,7 @@ func (s *EpochTrustValueStorage) Iterate(h reputation.TrustHandler) (err error)
 		// iterate first time to calculate normalizing divisor
 		for strID, val := range s.mItems {
 			if val.all > 0 {
-				num := reputation.TrustValueFromInt(val.sat)
-				denom := reputation.TrustValueFromInt(val.all)
-
-				v := num.Div(denom)
+				v := val.average()
 
 				mVals[strID] = v
 
@@ -173,3 +188,47 @@ func (s *EpochTrustValueStorage) Iterate(h reputation.TrustHandler) (err error)
 
 	return
 }
+
+// IterateRaw iterates over average interaction scores with
+// the peers and passes them to parameterized handler.
+//
+// Unlike Iterate, values are not normalized.
+func (s *EpochTrustValueStorage) IterateRaw(h reputation.TrustHandler) (err error) {
+	s.mtx.RLock()
+
+	for strID, val := range s.mItems {
+		if val.all == 0 {
+			continue
+		}
+
+		t := reputation.Trust{}
+
+		t.SetPeer(peerIDFromString(strID))
+		t.SetValue(val.average())
+
+		if err = h(t); err != nil {
+			break
+		}
+	}
+
+	s.mtx.RUnlock()
+
+	return
+}
+
+// IterateTrusts implements Source through iterating over the
+// average interaction scores collected during the epoch.
+//
+// If there is no data for the epoch, IterateTrusts does nothing.
+func (s *Storage) IterateTrusts(epoch uint64, h reputation.TrustHandler) error {
+	epochStorage, err := s.DataForEpoch(epoch)
+	if err != nil {
+		if errors.Is(err, ErrNoPositiveTrust) {
+			return nil
+		}
+
+		return err
+	}
+
+	return epochStorage.IterateRaw(h)
+}
@@ -0,0 +1,165 @@
+package truststorage
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
+	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
+)
+
+// Source is an interface of the local trust source.
+//
+// Source provides raw scores of the interactions with the
+// peers. Scores must be in [0; 1] range and are not required
+// to be normalized.
+type Source interface {
+	// IterateTrusts must pass raw local trusts of the epoch
+	// to the handler. Source may skip peers it has no data for.
+	IterateTrusts(epoch uint64, h reputation.TrustHandler) error
+}
+
+// WeightedSource groups local trust Source with its name
+// and weight in the resulting local trust.
+type WeightedSource struct {
+	// Name of the source. Must be unique within Sources.
+	Name string
+
+	// Weight of the source scores. Sources with
+	// zero weight are ignored.
+	Weight uint64
+
+	Source Source
+}
+
+// Sources is a weighted set of the local trust sources.
+//
+// Resulting local trust of the peer is a weighted average of
+// the scores provided by the sources having data for the peer.
+type Sources []WeightedSource
+
+// WithWeights returns a copy of the sources with weights replaced by the
+// given ones, e.g. the weights set for the whole network. Sources missing
+// in w keep their weights.
+func (x Sources) WithWeights(w map[string]uint64) Sources {
+	res := make(Sources, len(x))
+
+	for i := range x {
+		res[i] = x[i]
+
+		if v, ok := w[x[i].Name]; ok {
+			res[i].Weight = v
+		}
+	}
+
+	return res
+}
+
+// PeerTrust groups local trust of the particular peer with
+// the scores it is calculated from.
+type PeerTrust struct {
+	// Peer identifier.
+	Peer apireputation.PeerID
+
+	// Weighted average of the source scores.
+	Value reputation.TrustValue
+
+	// Source scores by the source names.
+	Scores map[string]reputation.TrustValue
+}
+
+// PeerTrusts collects local trusts of the epoch from all sources.
+//
+// Values are not normalized. Result is sorted by peer keys.
+func (x Sources) PeerTrusts(epoch uint64) ([]PeerTrust, error) {
+	type weightedTrust struct {
+		PeerTrust
+
+		weight uint64
+	}
+
+	mTrusts := make(map[string]*weightedTrust)
+
+	for _, src := range x {
+		if src.Weight == 0 {
+			continue
+		}
+
+		w := reputation.TrustValueFromFloat64(float64(src.Weight))
+
+		err := src.Source.IterateTrusts(epoch, func(t reputation.Trust) error {
+			strID := stringifyPeerID(t.Peer())
+
+			pt, ok := mTrusts[strID]
+			if !ok {
+				pt = &weightedTrust{
+					PeerTrust: PeerTrust{
+						Peer:   t.Peer(),
+						Scores: make(map[string]reputation.TrustValue, len(x)),
+					},
+				}
+				mTrusts[strID] = pt
+			}
+
+			v := t.Value()
+			pt.Scores[src.Name] = v
+
+			v.Mul(w)
+			pt.Value.Add(v)
+			pt.weight += src.Weight
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not iterate over trusts of the %s source: %w", src.Name, err)
+		}
+	}
+
+	res := make([]PeerTrust, 0, len(mTrusts))
+
+	for _, pt := range mTrusts {
+		pt.Value = pt.Value.Div(reputation.TrustValueFromFloat64(float64(pt.weight)))
+		res = append(res, pt.PeerTrust)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return stringifyPeerID(res[i].Peer) < stringifyPeerID(res[j].Peer)
+	})
+
+	return res, nil
+}
+
+// Iterate iterates over normalized local trusts of the epoch
+// and passes them to parameterized handler.
+//
+// Values are normalized according to http://ilpubs.stanford.edu:8090/562/1/2002-56.pdf Chapter 4.5.
+// If divisor in formula is zero, ErrNoPositiveTrust returns.
+func (x Sources) Iterate(epoch uint64, h reputation.TrustHandler) error {
+	trusts, err := x.PeerTrusts(epoch)
+	if err != nil {
+		return err
+	}
+
+	var sum reputation.TrustValue
+
+	for i := range trusts {
+		sum.Add(trusts[i].Value)
+	}
+
+	if sum.IsZero() {
+		return ErrNoPositiveTrust
+	}
+
+	for i := range trusts {
+		t := reputation.Trust{}
+
+		t.SetPeer(trusts[i].Peer)
+		t.SetValue(trusts[i].Value.Div(sum))
+
+		if err = h(t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package truststorage
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
+	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
+	"github.com/stretchr/testify/require"
+)
+
+func peerID(key byte) (id apireputation.PeerID) {
+	id.SetPublicKey([]byte{key})
+	return
+}
+
+func TestSources(t *testing.T) {
+	const epoch = 10
+
+	requests := New(Prm{})
+	latency := New(Prm{})
+
+	update := func(s *Storage, peer byte, v reputation.TrustValue) {
+		var prm UpdatePrm
+		prm.SetEpoch(epoch)
+		prm.SetPeer(peerID(peer))
+		prm.SetValue(v)
+
+		s.Update(prm)
+	}
+
+	// peer 1: 3/4 successful requests, latency score 0.5
+	update(requests, 1, 1)
+	update(requests, 1, 1)
+	update(requests, 1, 1)
+	update(requests, 1, 0)
+	update(latency, 1, 0.5)
+
+	// peer 2: all requests failed, no latency data
+	update(requests, 2, 0)
+
+	// peer 3: only latency data
+	update(latency, 3, 1)
+
+	srcs := Sources{
+		{Name: "requests", Weight: 3, Source: requests},
+		{Name: "latency", Weight: 1, Source: latency},
+		{Name: "disabled", Weight: 0, Source: latency},
+	}
+
+	trusts, err := srcs.PeerTrusts(epoch)
+	require.NoError(t, err)
+	require.Len(t, trusts, 3)
+
+	require.Equal(t, peerID(1), trusts[0].Peer)
+	require.InDelta(t, (3*0.75+0.5)/4, trusts[0].Value.Float64(), 1e-9)
+	require.Equal(t, map[string]reputation.TrustValue{"requests": 0.75, "latency": 0.5}, trusts[0].Scores)
+
+	require.Equal(t, peerID(2), trusts[1].Peer)
+	require.True(t, trusts[1].Value.IsZero())
+
+	require.Equal(t, peerID(3), trusts[2].Peer)
+	require.Equal(t, reputation.TrustOne, trusts[2].Value)
+
+	var sum reputation.TrustValue
+
+	require.NoError(t, srcs.Iterate(epoch, func(t reputation.Trust) error {
+		sum.Add(t.Value())
+		return nil
+	}))
+	require.InDelta(t, 1, sum.Float64(), 1e-9)
+
+	require.ErrorIs(t, srcs.Iterate(epoch+1, func(reputation.Trust) error { return nil }), ErrNoPositiveTrust)
+}
+
+func TestSources_WithWeights(t *testing.T) {
+	srcs := Sources{
+		{Name: "requests", Weight: 1},
+		{Name: "latency", Weight: 0},
+		{Name: "availability", Weight: 2},
+	}
+
+	res := srcs.WithWeights(map[string]uint64{
+		"latency":      3,
+		"availability": 0,
+		"unknown":      5,
+	})
+
+	require.Equal(t, Sources{
+		{Name: "requests", Weight: 1},
+		{Name: "latency", Weight: 3},
+		{Name: "availability", Weight: 0},
+	}, res)
+
+	// original sources are not changed
+	require.EqualValues(t, 0, srcs[1].Weight)
+	require.EqualValues(t, 2, srcs[2].Weight)
+}
@@ -0,0 +1,22 @@
+package tree
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
+	cidSDK "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// checkLimits checks whether verified request fits into the rate limits.
+// Returns util.ResourceExhaustedError (gRPC codes.ResourceExhausted) otherwise.
+func (s *Service) checkLimits(method string, req message, cid cidSDK.ID) error {
+	err := s.limiter.Allow(ratelimit.Request{
+		Method:    method,
+		Owner:     req.GetSignature().GetKey(),
+		Container: &cid,
+	})
+	if err != nil {
+		return util.NewResourceExhaustedError(err)
+	}
+
+	return nil
+}
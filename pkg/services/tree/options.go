@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"go.uber.org/zap"
 )
@@ -33,6 +34,7 @@ type cfg struct {
 	replicatorWorkerCount     int
 	replicatorTimeout         time.Duration
 	containerCacheSize        int
+	limiter                   *ratelimit.Limiter
 }
 
 // Option represents configuration option for a tree service.
@@ -116,3 +118,10 @@ func WithReplicationTimeout(t time.Duration) Option {
 		}
 	}
 }
+
+// WithRateLimiter sets request rate limiter for a tree service.
+func WithRateLimiter(l *ratelimit.Limiter) Option {
+	return func(c *cfg) {
+		c.limiter = l
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cidSDK "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -102,6 +103,11 @@ func (s *Service) Add(ctx context.Context, req *AddRequest) (*AddResponse, error
 		return nil, err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeAdd, req, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return nil, err
@@ -150,6 +156,11 @@ func (s *Service) AddByPath(ctx context.Context, req *AddByPathRequest) (*AddByP
 		return nil, err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeAddByPath, req, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return nil, err
@@ -210,6 +221,11 @@ func (s *Service) Remove(ctx context.Context, req *RemoveRequest) (*RemoveRespon
 		return nil, err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeRemove, req, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return nil, err
@@ -259,6 +275,11 @@ func (s *Service) Move(ctx context.Context, req *MoveRequest) (*MoveResponse, er
 		return nil, err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeMove, req, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return nil, err
@@ -307,6 +328,11 @@ func (s *Service) GetNodeByPath(ctx context.Context, req *GetNodeByPathRequest)
 		return nil, err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeGetNodeByPath, req, cid)
+	if err != nil {
+		return nil, err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return nil, err
@@ -383,6 +409,11 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 		return err
 	}
 
+	err = s.checkLimits(ratelimit.MethodTreeGetSubTree, req, cid)
+	if err != nil {
+		return err
+	}
+
 	ns, pos, err := s.getContainerNodes(cid)
 	if err != nil {
 		return err
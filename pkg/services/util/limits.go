@@ -0,0 +1,51 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// ResourceExhaustedError is returned by services rejecting the request
+// because of the node limits: request rate or storage quota of the node.
+//
+// NeoFS API has no dedicated status for this case, so SignService responds
+// with the signed ACCESS_DENIED status carrying the limit error as a reason.
+// Services without NeoFS API statuses (e.g. tree service) transmit it as
+// the gRPC error with codes.ResourceExhausted.
+type ResourceExhaustedError struct {
+	cause error
+}
+
+// NewResourceExhaustedError wraps the limit error into ResourceExhaustedError.
+func NewResourceExhaustedError(cause error) ResourceExhaustedError {
+	return ResourceExhaustedError{cause: cause}
+}
+
+func (x ResourceExhaustedError) Error() string {
+	return x.cause.Error()
+}
+
+// Is checks whether the limit error matches the target. The cause is not
+// exposed via Unwrap, since SignService unwraps errors down to the last one
+// before converting it into the response status.
+func (x ResourceExhaustedError) Is(target error) bool {
+	return errors.Is(x.cause, target)
+}
+
+// ErrorToV2 implements apistatus.StatusV2 used by SignService to write the
+// response status.
+func (x ResourceExhaustedError) ErrorToV2() *status.Status {
+	var st apistatus.ObjectAccessDenied
+	st.WriteReason(x.cause.Error())
+
+	return st.ErrorToV2()
+}
+
+// GRPCStatus implements interface used by gRPC to return the error status.
+func (x ResourceExhaustedError) GRPCStatus() *grpcstatus.Status {
+	return grpcstatus.New(codes.ResourceExhausted, x.cause.Error())
+}
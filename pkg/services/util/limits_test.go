@@ -0,0 +1,62 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-node/pkg/services/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSignService_ResourceExhausted(t *testing.T) {
+	pk, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var ver refs.Version
+	ver.SetMajor(2)
+	ver.SetMinor(14)
+
+	var meta session.RequestMetaHeader
+	meta.SetVersion(&ver)
+
+	req := new(objectV2.HeadRequest)
+	req.SetBody(new(objectV2.HeadRequestBody))
+	req.SetMetaHeader(&meta)
+	require.NoError(t, signature.SignServiceMessage(&pk.PrivateKey, req))
+
+	svc := util.NewUnarySignService(&pk.PrivateKey)
+
+	handle := func(handlerErr error) (util.ResponseMessage, error) {
+		return svc.HandleUnaryRequest(context.Background(), req,
+			func(context.Context, any) (util.ResponseMessage, error) {
+				return nil, handlerErr
+			},
+			func() util.ResponseMessage {
+				return new(objectV2.HeadResponse)
+			},
+		)
+	}
+
+	limitErr := errors.New("rate limit exceeded")
+
+	resp, err := handle(fmt.Errorf("wrapped: %w", util.NewResourceExhaustedError(limitErr)))
+	require.NoError(t, err)
+	require.NoError(t, signature.VerifyServiceMessage(resp))
+
+	var st *apistatus.ObjectAccessDenied
+	require.ErrorAs(t, apistatus.ErrorFromV2(resp.GetMetaHeader().GetStatus()), &st)
+	require.Equal(t, limitErr.Error(), st.Reason())
+
+	// services without API statuses return gRPC error
+	require.Equal(t, codes.ResourceExhausted, status.Code(util.NewResourceExhaustedError(limitErr)))
+}
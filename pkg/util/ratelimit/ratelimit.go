@@ -0,0 +1,314 @@
+// Package ratelimit provides request rate, payload bandwidth and container
+// storage quota limits for the NeoFS storage node services.
+package ratelimit
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"golang.org/x/time/rate"
+)
+
+// Names of the limited RPC types.
+const (
+	MethodObjectGet       = "get"
+	MethodObjectPut       = "put"
+	MethodObjectHead      = "head"
+	MethodObjectSearch    = "search"
+	MethodObjectDelete    = "delete"
+	MethodObjectRange     = "range"
+	MethodObjectRangeHash = "rangehash"
+
+	MethodTreeAdd           = "tree_add"
+	MethodTreeAddByPath     = "tree_add_by_path"
+	MethodTreeRemove        = "tree_remove"
+	MethodTreeMove          = "tree_move"
+	MethodTreeGetNodeByPath = "tree_get_node_by_path"
+	MethodTreeGetSubTree    = "tree_get_subtree"
+)
+
+// Methods lists all limited RPC types.
+var Methods = []string{
+	MethodObjectGet,
+	MethodObjectPut,
+	MethodObjectHead,
+	MethodObjectSearch,
+	MethodObjectDelete,
+	MethodObjectRange,
+	MethodObjectRangeHash,
+	MethodTreeAdd,
+	MethodTreeAddByPath,
+	MethodTreeRemove,
+	MethodTreeMove,
+	MethodTreeGetNodeByPath,
+	MethodTreeGetSubTree,
+}
+
+// DefaultCacheSize is a default number of tracked request owners and
+// containers per limit.
+const DefaultCacheSize = 10000
+
+var (
+	// ErrRateLimit is returned when request exceeds one of the rate limits.
+	ErrRateLimit = errors.New("rate limit exceeded")
+
+	// ErrNodeQuotaExceeded is returned when object does not fit into
+	// the container quota of the node.
+	ErrNodeQuotaExceeded = errors.New("container quota of the node exceeded")
+)
+
+// Limit describes a token bucket: Rate events per second with bursts of
+// up to Burst events. Zero Rate means no limit. Non-positive Burst is
+// replaced with the Rate rounded up.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) enabled() bool {
+	return l.Rate > 0
+}
+
+func (l Limit) limiter() *rate.Limiter {
+	burst := l.Burst
+	if burst <= 0 {
+		burst = int(math.Ceil(l.Rate))
+	}
+
+	return rate.NewLimiter(rate.Limit(l.Rate), burst)
+}
+
+// Config groups Limiter parameters. Zero values disable corresponding limits.
+type Config struct {
+	// Owner limits requests signed by a single key.
+	Owner Limit
+	// Container limits requests to a single container.
+	Container Limit
+	// Methods limits requests of the RPC type regardless of the
+	// owner and the container. Keys are from Methods list.
+	Methods map[string]Limit
+
+	// OwnerBandwidth limits payload bytes per second transferred
+	// within requests signed by a single key.
+	OwnerBandwidth uint64
+	// ContainerBandwidth limits payload bytes per second transferred
+	// within requests to a single container.
+	ContainerBandwidth uint64
+
+	// NodeQuotas limits total payload size of the container objects
+	// stored by this node. Quotas are per node: objects stored by other
+	// container nodes are not taken into account.
+	NodeQuotas map[cid.ID]uint64
+
+	// CacheSize is a number of tracked owners and containers per limit.
+	// DefaultCacheSize is used if not positive.
+	CacheSize int
+}
+
+// Request describes limited request.
+type Request struct {
+	// Method is a type of the request from Methods list.
+	Method string
+	// Owner is a public key of the original request sender, if any.
+	Owner []byte
+	// Container is an identifier of the requested container, if any.
+	Container *cid.ID
+}
+
+// Limiter checks request rates, throttles payload transfer and
+// enforces container quotas of the node. Nil Limiter allows everything.
+type Limiter struct {
+	methods map[string]*rate.Limiter
+
+	owners, containers     *keyed
+	ownersBW, containersBW *keyed
+	quotas                 map[cid.ID]uint64
+}
+
+// New creates Limiter from the given configuration.
+func New(cfg Config) *Limiter {
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = DefaultCacheSize
+	}
+
+	l := &Limiter{
+		methods:      make(map[string]*rate.Limiter, len(cfg.Methods)),
+		owners:       newKeyed(cfg.Owner, cfg.CacheSize),
+		containers:   newKeyed(cfg.Container, cfg.CacheSize),
+		ownersBW:     newKeyed(bandwidthLimit(cfg.OwnerBandwidth), cfg.CacheSize),
+		containersBW: newKeyed(bandwidthLimit(cfg.ContainerBandwidth), cfg.CacheSize),
+		quotas:       cfg.NodeQuotas,
+	}
+
+	for m, lim := range cfg.Methods {
+		if lim.enabled() {
+			l.methods[m] = lim.limiter()
+		}
+	}
+
+	return l
+}
+
+func bandwidthLimit(bps uint64) Limit {
+	if bps > math.MaxInt32 {
+		bps = math.MaxInt32
+	}
+
+	return Limit{Rate: float64(bps), Burst: int(bps)}
+}
+
+// Allow checks whether the request fits into all rate limits. Tokens are
+// taken from the limits only if the request is allowed. Returns an error
+// wrapping ErrRateLimit and describing the exceeded limit otherwise.
+func (l *Limiter) Allow(r Request) error {
+	if l == nil {
+		return nil
+	}
+
+	var (
+		// reservations are cancelled at the moment they are made,
+		// so tokens are returned to the buckets
+		now      = time.Now()
+		reserved []*rate.Reservation
+	)
+
+	reserve := func(lim *rate.Limiter, name string) error {
+		if lim == nil {
+			return nil
+		}
+
+		res := lim.ReserveN(now, 1)
+		if !res.OK() || res.DelayFrom(now) > 0 {
+			res.CancelAt(now)
+			for i := range reserved {
+				reserved[i].CancelAt(now)
+			}
+
+			return fmt.Errorf("%w: %s", ErrRateLimit, name)
+		}
+
+		reserved = append(reserved, res)
+
+		return nil
+	}
+
+	err := reserve(l.methods[r.Method], "method "+r.Method)
+	if err == nil && r.Owner != nil {
+		err = reserve(l.owners.get(hex.EncodeToString(r.Owner)), "owner "+hex.EncodeToString(r.Owner))
+	}
+	if err == nil && r.Container != nil {
+		err = reserve(l.containers.get(r.Container.EncodeToString()), "container "+r.Container.EncodeToString())
+	}
+
+	return err
+}
+
+// WaitBandwidth blocks until n payload bytes can be transferred within the
+// request according to the bandwidth limits or the context is done.
+func (l *Limiter) WaitBandwidth(ctx context.Context, r Request, n int) error {
+	if l == nil || n <= 0 {
+		return nil
+	}
+
+	if r.Owner != nil {
+		if err := waitN(ctx, l.ownersBW.get(hex.EncodeToString(r.Owner)), n); err != nil {
+			return err
+		}
+	}
+
+	if r.Container != nil {
+		return waitN(ctx, l.containersBW.get(r.Container.EncodeToString()), n)
+	}
+
+	return nil
+}
+
+func waitN(ctx context.Context, lim *rate.Limiter, n int) error {
+	if lim == nil {
+		return nil
+	}
+
+	// WaitN fails for n exceeding burst, so wait in burst-sized parts
+	for b := lim.Burst(); n > 0; n -= b {
+		if err := lim.WaitN(ctx, min(n, b)); err != nil {
+			return fmt.Errorf("wait for bandwidth: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// CheckNodeQuota checks whether size more payload bytes can be stored in the
+// container which objects already take used bytes locally. Returns an error
+// wrapping ErrNodeQuotaExceeded otherwise.
+func (l *Limiter) CheckNodeQuota(cnr cid.ID, used, size uint64) error {
+	if l == nil {
+		return nil
+	}
+
+	quota, ok := l.quotas[cnr]
+	if ok && (used > quota || size > quota-used) {
+		return fmt.Errorf("%w: %s uses %d of %d bytes", ErrNodeQuotaExceeded, cnr, used, quota)
+	}
+
+	return nil
+}
+
+// HasNodeQuota checks whether the container storage on the node is limited.
+func (l *Limiter) HasNodeQuota(cnr cid.ID) bool {
+	if l == nil {
+		return false
+	}
+
+	_, ok := l.quotas[cnr]
+	return ok
+}
+
+// keyed is a set of independent limiters for different keys. Limiters for
+// the least recently used keys are dropped if there are too many keys.
+type keyed struct {
+	limit Limit
+	cache *lru.Cache[string, *rate.Limiter]
+}
+
+func newKeyed(l Limit, size int) *keyed {
+	if !l.enabled() {
+		return nil
+	}
+
+	cache, _ := lru.New[string, *rate.Limiter](size) // returns error only if size is not positive
+
+	return &keyed{
+		limit: l,
+		cache: cache,
+	}
+}
+
+func (k *keyed) get(key string) *rate.Limiter {
+	if k == nil {
+		return nil
+	}
+
+	lim, ok := k.cache.Get(key)
+	if !ok {
+		lim = k.limit.limiter()
+		if prev, ok, _ := k.cache.PeekOrAdd(key, lim); ok {
+			lim = prev
+		}
+	}
+
+	return lim
+}
@@ -0,0 +1,90 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/ratelimit"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+	owner1, owner2 := []byte{1}, []byte{2}
+
+	t.Run("nil", func(t *testing.T) {
+		var l *ratelimit.Limiter
+		require.NoError(t, l.Allow(ratelimit.Request{Method: ratelimit.MethodObjectGet, Owner: owner1, Container: &cnr1}))
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		l := ratelimit.New(ratelimit.Config{Owner: ratelimit.Limit{Rate: 1e-9, Burst: 2}})
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, l.Allow(ratelimit.Request{Owner: owner1, Container: &cnr1}))
+		}
+		require.ErrorIs(t, l.Allow(ratelimit.Request{Owner: owner1, Container: &cnr2}), ratelimit.ErrRateLimit)
+		require.NoError(t, l.Allow(ratelimit.Request{Owner: owner2, Container: &cnr1}))
+		require.NoError(t, l.Allow(ratelimit.Request{Container: &cnr1}))
+	})
+
+	t.Run("method", func(t *testing.T) {
+		l := ratelimit.New(ratelimit.Config{Methods: map[string]ratelimit.Limit{
+			ratelimit.MethodObjectPut: {Rate: 1e-9, Burst: 1},
+		}})
+
+		require.NoError(t, l.Allow(ratelimit.Request{Method: ratelimit.MethodObjectPut, Owner: owner1}))
+		require.ErrorIs(t, l.Allow(ratelimit.Request{Method: ratelimit.MethodObjectPut, Owner: owner2}), ratelimit.ErrRateLimit)
+		require.NoError(t, l.Allow(ratelimit.Request{Method: ratelimit.MethodObjectGet, Owner: owner1}))
+	})
+
+	t.Run("rejected request does not take tokens", func(t *testing.T) {
+		l := ratelimit.New(ratelimit.Config{
+			Owner:     ratelimit.Limit{Rate: 1e-9, Burst: 1},
+			Container: ratelimit.Limit{Rate: 1e-9, Burst: 1},
+		})
+
+		require.NoError(t, l.Allow(ratelimit.Request{Owner: owner1, Container: &cnr1}))
+		// owner fits, but container does not
+		require.ErrorIs(t, l.Allow(ratelimit.Request{Owner: owner2, Container: &cnr1}), ratelimit.ErrRateLimit)
+		// so owner2 still has its token
+		require.NoError(t, l.Allow(ratelimit.Request{Owner: owner2, Container: &cnr2}))
+	})
+}
+
+func TestLimiter_WaitBandwidth(t *testing.T) {
+	cnr := cidtest.ID()
+	r := ratelimit.Request{Owner: []byte{1}, Container: &cnr}
+
+	l := ratelimit.New(ratelimit.Config{OwnerBandwidth: 100})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// initial burst is available immediately
+	require.NoError(t, l.WaitBandwidth(ctx, r, 100))
+	// but the next second of the traffic is not
+	require.Error(t, l.WaitBandwidth(ctx, r, 100))
+	// other owners are not affected
+	require.NoError(t, l.WaitBandwidth(ctx, ratelimit.Request{Owner: []byte{2}}, 50))
+	// no limit for containers
+	require.NoError(t, l.WaitBandwidth(ctx, ratelimit.Request{Container: &cnr}, 1000))
+}
+
+func TestLimiter_CheckNodeQuota(t *testing.T) {
+	cnr, other := cidtest.ID(), cidtest.ID()
+
+	l := ratelimit.New(ratelimit.Config{NodeQuotas: map[cid.ID]uint64{cnr: 100}})
+
+	require.True(t, l.HasNodeQuota(cnr))
+	require.False(t, l.HasNodeQuota(other))
+
+	require.NoError(t, l.CheckNodeQuota(cnr, 0, 100))
+	require.NoError(t, l.CheckNodeQuota(cnr, 60, 40))
+	require.ErrorIs(t, l.CheckNodeQuota(cnr, 60, 41), ratelimit.ErrNodeQuotaExceeded)
+	require.ErrorIs(t, l.CheckNodeQuota(cnr, 101, 0), ratelimit.ErrNodeQuotaExceeded)
+	require.NoError(t, l.CheckNodeQuota(other, 1000, 1000))
+}
@@ -65,6 +65,38 @@ func (p PersistentStorage) UInt32(key []byte) (n uint32, err error) {
 	return
 }
 
+// SetBytes sets a byte slice value in the storage.
+func (p PersistentStorage) SetBytes(key []byte, value []byte) error {
+	return p.db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(stateBucket)
+		if err != nil {
+			return fmt.Errorf("can't create state bucket in state persistent storage: %w", err)
+		}
+
+		return b.Put(key, value)
+	})
+}
+
+// Bytes returns a byte slice value from persistent storage. If the value does
+// not exist, returns nil.
+func (p PersistentStorage) Bytes(key []byte) (res []byte, err error) {
+	err = p.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(stateBucket)
+		if b == nil {
+			return nil
+		}
+
+		if v := b.Get(key); v != nil {
+			res = make([]byte, len(v))
+			copy(res, v)
+		}
+
+		return nil
+	})
+
+	return
+}
+
 // Close closes persistent database instance.
 func (p PersistentStorage) Close() error {
 	return p.db.Close()
@@ -24,3 +24,20 @@ func TestPersistentStorage_UInt32(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, 10, n)
 }
+
+func TestPersistentStorage_Bytes(t *testing.T) {
+	storage, err := state.NewPersistentStorage(filepath.Join(t.TempDir(), ".storage"))
+	require.NoError(t, err)
+	defer storage.Close()
+
+	b, err := storage.Bytes([]byte("unset-value"))
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	err = storage.SetBytes([]byte("foo"), []byte("bar"))
+	require.NoError(t, err)
+
+	b, err = storage.Bytes([]byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), b)
+}
@@ -0,0 +1,223 @@
+// Package tlscert provides TLS certificates loaded from the files which can be
+// replaced at runtime, e.g. on file changes.
+package tlscert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// Certificate is a TLS key pair read from the certificate and key files.
+//
+// Certificate must be created via New.
+type Certificate struct {
+	filesMtx          sync.Mutex
+	certFile, keyFile string
+	filesChanged      chan struct{}
+
+	v atomic.Pointer[tls.Certificate]
+}
+
+// New reads key pair from the given files and returns Certificate serving it.
+func New(certFile, keyFile string) (*Certificate, error) {
+	c := &Certificate{
+		filesChanged: make(chan struct{}, 1),
+	}
+
+	_, err := c.Load(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Load reads key pair from the given files and replaces the current one with
+// it. The files are used by the subsequent Reload and Watch calls. Returns true
+// if served certificate has changed. On error, current key pair and files are
+// kept.
+func (c *Certificate) Load(certFile, keyFile string) (bool, error) {
+	c.filesMtx.Lock()
+	defer c.filesMtx.Unlock()
+
+	changed, err := c.load(certFile, keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	if c.certFile != certFile || c.keyFile != keyFile {
+		c.certFile, c.keyFile = certFile, keyFile
+
+		select {
+		case c.filesChanged <- struct{}{}:
+		default:
+		}
+	}
+
+	return changed, nil
+}
+
+// Reload rereads key pair from the current files. Returns true if served
+// certificate has changed.
+func (c *Certificate) Reload() (bool, error) {
+	c.filesMtx.Lock()
+	defer c.filesMtx.Unlock()
+
+	return c.load(c.certFile, c.keyFile)
+}
+
+func (c *Certificate) load(certFile, keyFile string) (bool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return false, fmt.Errorf("parse certificate: %w", err)
+	}
+
+	prev := c.v.Swap(&cert)
+
+	return prev == nil || !bytes.Equal(prev.Certificate[0], cert.Certificate[0]), nil
+}
+
+// GetCertificate returns current key pair. It is intended to be used as
+// tls.Config.GetCertificate.
+func (c *Certificate) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return c.v.Load(), nil
+}
+
+// NotAfter returns expiration time of the current certificate.
+func (c *Certificate) NotAfter() time.Time {
+	return c.v.Load().Leaf.NotAfter
+}
+
+// ReadCertPool reads PEM-encoded CA certificates from the file.
+func ReadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+
+	return pool, nil
+}
+
+func (c *Certificate) files() (string, string) {
+	c.filesMtx.Lock()
+	defer c.filesMtx.Unlock()
+
+	return c.certFile, c.keyFile
+}
+
+// Watch reloads key pair on any change in the directories of the certificate
+// and key files until ctx is done. Directories are watched instead of the files
+// to handle replacement of the files via renaming (including symbolic links
+// swapping used by Kubernetes secrets). Reload errors are logged and do not
+// stop watching since the files can be changed non-atomically. If served
+// certificate changes, onChange is called (if set). Watch must not be called
+// concurrently.
+//
+// Returns an error if watching could not be started.
+func (c *Certificate) Watch(ctx context.Context, l *zap.Logger, onChange func()) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create file watcher: %w", err)
+	}
+	defer w.Close()
+
+	watched := make(map[string]struct{})
+
+	watch := func() error {
+		certFile, keyFile := c.files()
+
+		dirs := map[string]struct{}{
+			filepath.Dir(certFile): {},
+			filepath.Dir(keyFile):  {},
+		}
+
+		for dir := range watched {
+			if _, ok := dirs[dir]; !ok {
+				_ = w.Remove(dir)
+				delete(watched, dir)
+			}
+		}
+
+		for dir := range dirs {
+			if _, ok := watched[dir]; ok {
+				continue
+			}
+
+			err := w.Add(dir)
+			if err != nil {
+				return fmt.Errorf("watch directory %s: %w", dir, err)
+			}
+
+			watched[dir] = struct{}{}
+		}
+
+		return nil
+	}
+
+	err = watch()
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.filesChanged:
+			err = watch()
+			if err != nil {
+				l.Error("could not watch new TLS certificate files", zap.Error(err))
+			}
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+
+			changed, err := c.Reload()
+			if err != nil {
+				l.Warn("could not reload TLS certificate",
+					zap.String("event", ev.String()),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			if changed {
+				l.Info("TLS certificate has been reloaded",
+					zap.Time("not_after", c.NotAfter()),
+				)
+
+				if onChange != nil {
+					onChange()
+				}
+			}
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+
+			l.Warn("TLS certificate files watcher failure", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package tlscert_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tlscert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// writeKeyPair writes self-signed certificate expiring at notAfter and its key
+// to the files in dir.
+func writeKeyPair(t *testing.T, dir string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	// write via renaming as real tools do to prevent reading partially written files
+	write := func(name string, b *pem.Block) {
+		tmp := name + ".tmp"
+		require.NoError(t, os.WriteFile(tmp, pem.EncodeToMemory(b), 0600))
+		require.NoError(t, os.Rename(tmp, name))
+	}
+
+	write(keyFile, &pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey})
+	write(certFile, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	return certFile, keyFile
+}
+
+func TestCertificate(t *testing.T) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	certFile, keyFile := writeKeyPair(t, dir, notAfter)
+
+	_, err := tlscert.New(filepath.Join(dir, "missing"), keyFile)
+	require.Error(t, err)
+
+	c, err := tlscert.New(certFile, keyFile)
+	require.NoError(t, err)
+	require.True(t, c.NotAfter().Equal(notAfter))
+
+	cert, err := c.GetCertificate(nil)
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+
+	changed, err := c.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	t.Run("load", func(t *testing.T) {
+		otherDir := t.TempDir()
+		otherNotAfter := notAfter.Add(time.Hour)
+
+		otherCert, otherKey := writeKeyPair(t, otherDir, otherNotAfter)
+
+		_, err := c.Load(otherCert, keyFile)
+		require.Error(t, err)
+		require.True(t, c.NotAfter().Equal(notAfter))
+
+		changed, err := c.Load(otherCert, otherKey)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.True(t, c.NotAfter().Equal(otherNotAfter))
+
+		changed, err = c.Load(certFile, keyFile)
+		require.NoError(t, err)
+		require.True(t, changed)
+		require.True(t, c.NotAfter().Equal(notAfter))
+	})
+}
+
+func TestCertificate_Watch(t *testing.T) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	certFile, keyFile := writeKeyPair(t, dir, notAfter)
+
+	c, err := tlscert.New(certFile, keyFile)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan time.Time, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- c.Watch(ctx, zap.NewNop(), func() {
+			changes <- c.NotAfter()
+		})
+	}()
+
+	// wait for the watch to be set up: rewrite the files until the change is caught
+	newNotAfter := notAfter.Add(time.Hour)
+	require.Eventually(t, func() bool {
+		writeKeyPair(t, dir, newNotAfter)
+
+		select {
+		case v := <-changes:
+			return v.Equal(newNotAfter)
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.True(t, c.NotAfter().Equal(newNotAfter))
+
+	t.Run("new files", func(t *testing.T) {
+		otherDir := t.TempDir()
+		otherCert, otherKey := writeKeyPair(t, otherDir, notAfter)
+
+		_, err := c.Load(otherCert, otherKey)
+		require.NoError(t, err)
+
+		otherNotAfter := notAfter.Add(2 * time.Hour)
+		require.Eventually(t, func() bool {
+			writeKeyPair(t, otherDir, otherNotAfter)
+
+			for {
+				select {
+				case v := <-changes:
+					if v.Equal(otherNotAfter) {
+						return true
+					}
+				case <-time.After(100 * time.Millisecond):
+					return false
+				}
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	cancel()
+	require.NoError(t, <-done)
+}
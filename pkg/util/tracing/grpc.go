@@ -0,0 +1,213 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const rpcMethodKey = attribute.Key("rpc.method")
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectGRPC returns context with the span context from ctx attached to
+// the outgoing gRPC metadata. It allows to continue the trace on the
+// remote side when the gRPC connection can not be configured with
+// client interceptors.
+func InjectGRPC(ctx context.Context) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	propagator.Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// ExtractGRPC returns context with the remote span context read from the
+// incoming gRPC metadata, if any.
+func ExtractGRPC(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, metadataCarrier(md))
+}
+
+// startServerSpan starts server span for the gRPC call. Clients of the
+// public API are not trusted, so the span starts a new trace linked to the
+// remote span instead of continuing it: otherwise any client could force
+// sampling of its requests.
+func startServerSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcMethodKey.String(method)),
+		trace.WithNewRoot(),
+	}
+
+	if remote := trace.SpanContextFromContext(ExtractGRPC(ctx)); remote.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: remote}))
+	}
+
+	return startSpan(ctx, method, opts...)
+}
+
+// UnaryServerInterceptor returns gRPC interceptor starting server span for
+// each unary call.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+		EndSpan(span, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns gRPC interceptor starting server span for
+// each streaming call.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		EndSpan(span, err)
+
+		return err
+	}
+}
+
+// serverStream overrides context of the wrapped stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientInterceptor returns gRPC interceptor starting client span for
+// each unary call and propagating it to the server.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startSpan(ctx, method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(rpcMethodKey.String(method)))
+
+		err := invoker(InjectGRPC(ctx), method, req, reply, cc, opts...)
+		EndSpan(span, err)
+
+		return err
+	}
+}
+
+// StreamClientInterceptor returns gRPC interceptor starting client span for
+// each streaming call and propagating it to the server. The span is ended
+// when the stream is finished: the server closes it, an error is received
+// or the call context is done.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startSpan(ctx, method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(rpcMethodKey.String(method)))
+
+		s, err := streamer(InjectGRPC(ctx), desc, cc, method, opts...)
+		if err != nil {
+			EndSpan(span, err)
+			return s, err
+		}
+
+		cs := &clientStream{
+			ClientStream:  s,
+			serverStreams: desc.ServerStreams,
+			span:          span,
+			done:          make(chan struct{}),
+		}
+
+		go func() {
+			select {
+			case <-ctx.Done():
+				cs.end(ctx.Err())
+			case <-cs.done:
+			}
+		}()
+
+		return cs, nil
+	}
+}
+
+// clientStream ends the span of the wrapped stream when the stream is
+// finished.
+type clientStream struct {
+	grpc.ClientStream
+
+	serverStreams bool
+
+	span trace.Span
+	once sync.Once
+	done chan struct{}
+}
+
+func (s *clientStream) end(err error) {
+	s.once.Do(func() {
+		EndSpan(s.span, err)
+		close(s.done)
+	})
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		s.end(nil)
+	case err != nil:
+		s.end(err)
+	case !s.serverStreams:
+		// the only response has been received
+		s.end(nil)
+	}
+
+	return err
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil {
+		s.end(err)
+	}
+
+	return md, err
+}
@@ -0,0 +1,114 @@
+// Package tracing provides OpenTelemetry tracing for NeoFS applications.
+//
+// Spans are created with the globally registered tracer provider. It is
+// a no-op until Setup (or otel.SetTracerProvider) is called, so
+// instrumented code can be used without any tracing configuration.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/nspcc-dev/neofs-node"
+
+// Config groups tracing parameters.
+type Config struct {
+	// Endpoint is an address of OTLP gRPC collector.
+	Endpoint string
+	// Insecure disables TLS for collector connection.
+	Insecure bool
+	// SamplingRatio is a fraction of traces to be sampled. Spans with a local
+	// parent follow the parent's decision, sampling decisions of remote
+	// parents are not trusted, the ratio is applied to them too.
+	SamplingRatio float64
+	// Service is a name of the traced application.
+	Service string
+	// Version is a version of the traced application.
+	Version string
+}
+
+// propagator transfers span context between applications.
+var propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+// Setup creates OTLP gRPC exporter and registers global tracer provider
+// sending spans to it. Returned function must be called on application
+// shutdown to flush the remaining spans.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("empty collector endpoint")
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exp, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP exporter: %w", err)
+	}
+
+	tp := NewTracerProvider(sdktrace.NewBatchSpanProcessor(exp), cfg)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider returns tracer provider passing spans sampled
+// according to the configuration to the given processor. It is
+// used by Setup and allows to use arbitrary exporters in tests,
+// e.g. sdktrace.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter()).
+func NewTracerProvider(sp sdktrace.SpanProcessor, cfg Config) *sdktrace.TracerProvider {
+	attrs := []attribute.KeyValue{semconv.ServiceName(cfg.Service)}
+	if cfg.Version != "" {
+		attrs = append(attrs, semconv.ServiceVersion(cfg.Version))
+	}
+
+	ratio := sdktrace.TraceIDRatioBased(cfg.SamplingRatio)
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sp),
+		sdktrace.WithSampler(sdktrace.ParentBased(ratio,
+			sdktrace.WithRemoteParentSampled(ratio),
+			sdktrace.WithRemoteParentNotSampled(ratio),
+		)),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attrs...)),
+	)
+}
+
+// StartSpan starts a new internal span with the given name and attributes.
+// The span is a child of the span from ctx if there is one. Nil ctx is
+// treated as context.Background.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, name, trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(attrs...))
+}
+
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// EndSpan ends the span, marking it as failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,204 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func setupExporter(t *testing.T, ratio float64) *tracetest.InMemoryExporter {
+	exp := tracetest.NewInMemoryExporter()
+	tp := tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exp), tracing.Config{
+		SamplingRatio: ratio,
+		Service:       "test",
+	})
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+
+	return exp
+}
+
+func TestStartSpan(t *testing.T) {
+	t.Run("sampled", func(t *testing.T) {
+		exp := setupExporter(t, 1)
+
+		ctx, parent := tracing.StartSpan(context.Background(), "parent")
+		_, child := tracing.StartSpan(ctx, "child")
+		tracing.EndSpan(child, errors.New("some error"))
+		tracing.EndSpan(parent, nil)
+
+		spans := exp.GetSpans()
+		require.Len(t, spans, 2)
+		require.Equal(t, "child", spans[0].Name)
+		require.Equal(t, codes.Error, spans[0].Status.Code)
+		require.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+		require.Equal(t, "parent", spans[1].Name)
+		require.Equal(t, codes.Unset, spans[1].Status.Code)
+	})
+
+	t.Run("not sampled", func(t *testing.T) {
+		exp := setupExporter(t, 0)
+
+		//nolint:staticcheck // nil context is explicitly supported
+		ctx, parent := tracing.StartSpan(nil, "parent")
+		_, child := tracing.StartSpan(ctx, "child")
+		child.End()
+		parent.End()
+
+		require.Empty(t, exp.GetSpans())
+	})
+
+	t.Run("sampled remote parent", func(t *testing.T) {
+		// remote sampling decision is not trusted
+		exp := setupExporter(t, 0)
+
+		ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		}))
+
+		_, span := tracing.StartSpan(ctx, "child")
+		span.End()
+
+		require.Empty(t, exp.GetSpans())
+	})
+}
+
+func newTestConn(t *testing.T) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor()),
+	)
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	cc, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cc.Close() })
+
+	return cc
+}
+
+func TestGRPCPropagation(t *testing.T) {
+	exp := setupExporter(t, 1)
+	cc := newTestConn(t)
+
+	ctx, root := tracing.StartSpan(context.Background(), "root")
+	_, err := grpc_health_v1.NewHealthClient(cc).Check(ctx, new(grpc_health_v1.HealthCheckRequest))
+	require.NoError(t, err)
+	root.End()
+
+	const method = "/grpc.health.v1.Health/Check"
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 3)
+
+	byKind := make(map[trace.SpanKind]tracetest.SpanStub)
+	for _, s := range spans {
+		byKind[s.SpanKind] = s
+	}
+
+	client, server := byKind[trace.SpanKindClient], byKind[trace.SpanKindServer]
+	require.Equal(t, method, client.Name)
+	require.Equal(t, method, server.Name)
+	require.Equal(t, root.SpanContext().TraceID(), client.SpanContext.TraceID())
+	require.Equal(t, root.SpanContext().SpanID(), client.Parent.SpanID())
+
+	// server starts a new trace linked to the client one
+	require.NotEqual(t, root.SpanContext().TraceID(), server.SpanContext.TraceID())
+	require.False(t, server.Parent.IsValid())
+	require.Len(t, server.Links, 1)
+	require.Equal(t, client.SpanContext.TraceID(), server.Links[0].SpanContext.TraceID())
+	require.Equal(t, client.SpanContext.SpanID(), server.Links[0].SpanContext.SpanID())
+	require.True(t, server.Links[0].SpanContext.IsRemote())
+}
+
+func TestGRPCUntrustedSampling(t *testing.T) {
+	exp := setupExporter(t, 0)
+	cc := newTestConn(t)
+
+	// client forces sampling of its request
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	_, err := grpc_health_v1.NewHealthClient(cc).Check(ctx, new(grpc_health_v1.HealthCheckRequest))
+	require.NoError(t, err)
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 1)
+	require.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	exp := setupExporter(t, 1)
+	cc := newTestConn(t)
+
+	clientSpans := func() []tracetest.SpanStub {
+		var res []tracetest.SpanStub
+		for _, s := range exp.GetSpans() {
+			if s.SpanKind == trace.SpanKindClient {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := grpc_health_v1.NewHealthClient(cc).Watch(ctx, new(grpc_health_v1.HealthCheckRequest))
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	// stream is still open
+	require.Empty(t, clientSpans())
+
+	cancel()
+
+	require.Eventually(t, func() bool { return len(clientSpans()) == 1 }, time.Second, 10*time.Millisecond)
+
+	span := clientSpans()[0]
+	require.Equal(t, "/grpc.health.v1.Health/Watch", span.Name)
+	require.Equal(t, codes.Error, span.Status.Code)
+
+	// span is ended once
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Len(t, clientSpans(), 1)
+}